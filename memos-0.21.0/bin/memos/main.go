@@ -7,8 +7,11 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
+	"time"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
@@ -39,6 +42,17 @@ var (
 	dsn             string
 	serveFrontend   bool
 	allowedOrigins  []string
+	rateLimit       float64
+	rateLimitBurst  int
+	loginAttempts   int
+	loginLockout    time.Duration
+	ipProxies       []string
+	authProxyHeader string
+	trustedProxies  []string
+	proxyAutoCreate bool
+	auditLogFile    string
+	masterKeyFile   string
+	prevKeyFile     string
 	instanceProfile *profile.Profile
 
 	rootCmd = &cobra.Command{
@@ -87,6 +101,8 @@ var (
 
 			// update (pre-sign) object storage links if applicable
 			go jobs.RunPreSignLinks(ctx, storeInstance)
+			// report the tasks whose due date has passed
+			go jobs.RunOverdueTasks(ctx, storeInstance)
 
 			if err := s.Start(ctx); err != nil {
 				if err != http.ErrServerClosed {
@@ -99,6 +115,44 @@ var (
 			<-ctx.Done()
 		},
 	}
+
+	newMasterKeyFile string
+
+	rotateKeyCmd = &cobra.Command{
+		Use:   "rotate-key",
+		Short: `Re-encrypt the secrets stored in the database with a new master key, read from --new-master-key-file or MEMOS_NEW_MASTER_KEY.`,
+		// Errors are returned so that a failed rotation exits with a non-zero status.
+		SilenceUsage: true,
+		RunE: func(_cmd *cobra.Command, _args []string) error {
+			ctx := context.Background()
+			newMasterKey := viper.GetString("new-master-key")
+			if newMasterKeyFile != "" {
+				var err error
+				if newMasterKey, err = profile.ReadKeyFile(newMasterKeyFile); err != nil {
+					return errors.Wrap(err, "failed to read new master key")
+				}
+			}
+			if newMasterKey == "" {
+				return errors.New("new master key is required")
+			}
+
+			dbDriver, err := db.NewDBDriver(instanceProfile)
+			if err != nil {
+				return errors.Wrap(err, "failed to create db driver")
+			}
+			defer dbDriver.Close()
+			if err := dbDriver.Migrate(ctx); err != nil {
+				return errors.Wrap(err, "failed to migrate database")
+			}
+
+			storeInstance := store.New(dbDriver, instanceProfile)
+			if err := storeInstance.RotateMasterKey(ctx, newMasterKey); err != nil {
+				return errors.Wrap(err, "failed to rotate master key, run the rotation again or start memos with the new master key and --previous-master-key-file set to the current one")
+			}
+			fmt.Println("The secrets have been re-encrypted, restart memos with the new master key.")
+			return nil
+		},
+	}
 )
 
 func Execute() error {
@@ -116,6 +170,19 @@ func init() {
 	rootCmd.PersistentFlags().StringVarP(&dsn, "dsn", "", "", "database source name(aka. DSN)")
 	rootCmd.PersistentFlags().BoolVarP(&serveFrontend, "frontend", "", true, "serve frontend files")
 	rootCmd.PersistentFlags().StringArrayVarP(&allowedOrigins, "origins", "", []string{}, "CORS allowed domain origins")
+	rootCmd.PersistentFlags().Float64VarP(&rateLimit, "rate-limit", "", 30, "requests per second allowed for each client IP, user and access token, 0 to disable")
+	rootCmd.PersistentFlags().IntVarP(&rateLimitBurst, "rate-limit-burst", "", 100, "requests allowed to exceed the rate limit at once")
+	rootCmd.PersistentFlags().IntVarP(&loginAttempts, "login-max-attempts", "", 10, "failed sign-ins before a client IP or username is locked out, 0 to disable")
+	rootCmd.PersistentFlags().DurationVarP(&loginLockout, "login-lockout-duration", "", 15*time.Minute, "how long a client IP or username stays locked out")
+	rootCmd.PersistentFlags().StringSliceVarP(&ipProxies, "trusted-proxies", "", []string{}, "IPs or CIDRs of the reverse proxies whose X-Forwarded-For header gives the client IP")
+	rootCmd.PersistentFlags().StringVarP(&authProxyHeader, "auth-proxy-header", "", "", "header carrying the username authenticated by a reverse proxy, e.g. Remote-User")
+	rootCmd.PersistentFlags().StringSliceVarP(&trustedProxies, "auth-proxy-trusted-proxies", "", []string{}, "IPs or CIDRs of the reverse proxies allowed to set the auth proxy header")
+	rootCmd.PersistentFlags().BoolVarP(&proxyAutoCreate, "auth-proxy-auto-create", "", false, "create the users authenticated by the reverse proxy if they do not exist")
+	rootCmd.PersistentFlags().StringVarP(&auditLogFile, "audit-log-file", "", "", "file to append the audit logs to as JSON lines, empty to disable")
+	rootCmd.PersistentFlags().StringVarP(&masterKeyFile, "master-key-file", "", "", "file holding the master key encrypting the secrets in the database, MEMOS_MASTER_KEY can be used instead")
+	rootCmd.PersistentFlags().StringVarP(&prevKeyFile, "previous-master-key-file", "", "", "file holding the previous master key, to read the secrets an interrupted rotation has not re-encrypted yet")
+	rotateKeyCmd.Flags().StringVarP(&newMasterKeyFile, "new-master-key-file", "", "", "file holding the new master key")
+	rootCmd.AddCommand(rotateKeyCmd)
 
 	err := viper.BindPFlag("mode", rootCmd.PersistentFlags().Lookup("mode"))
 	if err != nil {
@@ -149,6 +216,50 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+	err = viper.BindPFlag("rate-limit", rootCmd.PersistentFlags().Lookup("rate-limit"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("rate-limit-burst", rootCmd.PersistentFlags().Lookup("rate-limit-burst"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("login-max-attempts", rootCmd.PersistentFlags().Lookup("login-max-attempts"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("login-lockout-duration", rootCmd.PersistentFlags().Lookup("login-lockout-duration"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("trusted-proxies", rootCmd.PersistentFlags().Lookup("trusted-proxies"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("auth-proxy-header", rootCmd.PersistentFlags().Lookup("auth-proxy-header"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("auth-proxy-trusted-proxies", rootCmd.PersistentFlags().Lookup("auth-proxy-trusted-proxies"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("auth-proxy-auto-create", rootCmd.PersistentFlags().Lookup("auth-proxy-auto-create"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("audit-log-file", rootCmd.PersistentFlags().Lookup("audit-log-file"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("master-key-file", rootCmd.PersistentFlags().Lookup("master-key-file"))
+	if err != nil {
+		panic(err)
+	}
+	err = viper.BindPFlag("previous-master-key-file", rootCmd.PersistentFlags().Lookup("previous-master-key-file"))
+	if err != nil {
+		panic(err)
+	}
 
 	viper.SetDefault("mode", "demo")
 	viper.SetDefault("driver", "sqlite")
@@ -156,7 +267,23 @@ func init() {
 	viper.SetDefault("port", 8081)
 	viper.SetDefault("frontend", true)
 	viper.SetDefault("origins", []string{})
+	viper.SetDefault("rate-limit", 30)
+	viper.SetDefault("rate-limit-burst", 100)
+	viper.SetDefault("login-max-attempts", 10)
+	viper.SetDefault("login-lockout-duration", 15*time.Minute)
+	viper.SetDefault("trusted-proxies", []string{})
+	viper.SetDefault("auth-proxy-header", "")
+	viper.SetDefault("auth-proxy-trusted-proxies", []string{})
+	viper.SetDefault("auth-proxy-auto-create", false)
+	viper.SetDefault("audit-log-file", "")
+	viper.SetDefault("master-key", "")
+	viper.SetDefault("master-key-file", "")
+	viper.SetDefault("previous-master-key", "")
+	viper.SetDefault("previous-master-key-file", "")
+	viper.SetDefault("new-master-key", "")
 	viper.SetEnvPrefix("memos")
+	// Allow flags like --rate-limit to be set with MEMOS_RATE_LIMIT.
+	viper.SetEnvKeyReplacer(strings.NewReplacer("-", "_"))
 }
 
 func initConfig() {
@@ -0,0 +1,117 @@
+// Package envelope validates the content of the memos encrypted end-to-end by the clients.
+// The server never sees the passphrase nor the key, it only checks the envelope is well-formed
+// so that the clients can decrypt it with the key derived from the parameters it carries.
+package envelope
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Version is the only supported version of the envelope format.
+	Version = 1
+
+	// KDFPBKDF2SHA256 derives the key with PBKDF2 and HMAC-SHA256.
+	KDFPBKDF2SHA256 = "PBKDF2-SHA256"
+	// KDFArgon2id derives the key with Argon2id.
+	KDFArgon2id = "ARGON2ID"
+	// CipherAES256GCM encrypts the content with AES-256 in GCM mode.
+	CipherAES256GCM = "AES-256-GCM"
+
+	// minPBKDF2Iterations is the minimum number of PBKDF2 iterations accepted.
+	minPBKDF2Iterations = 100000
+	// minArgon2idMemory is the minimum Argon2id memory accepted, in KiB.
+	minArgon2idMemory = 19 * 1024
+	minSaltSize       = 16
+	gcmNonceSize      = 12
+	gcmTagSize        = 16
+)
+
+// Envelope is the JSON content of an encrypted memo, e.g.
+//
+//	{
+//	  "version": 1,
+//	  "kdf": {"name": "PBKDF2-SHA256", "salt": "<base64>", "iterations": 600000},
+//	  "cipher": {"name": "AES-256-GCM", "iv": "<base64>"},
+//	  "ciphertext": "<base64>"
+//	}
+type Envelope struct {
+	Version    int    `json:"version"`
+	KDF        KDF    `json:"kdf"`
+	Cipher     Cipher `json:"cipher"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// KDF is the key-derivation function turning the passphrase of the user into the key.
+type KDF struct {
+	Name       string `json:"name"`
+	Salt       string `json:"salt"`
+	Iterations int    `json:"iterations"`
+	// Memory in KiB and Parallelism are the additional parameters of Argon2id.
+	Memory      int `json:"memory,omitempty"`
+	Parallelism int `json:"parallelism,omitempty"`
+}
+
+// Cipher is the authenticated cipher encrypting the content.
+type Cipher struct {
+	Name string `json:"name"`
+	IV   string `json:"iv"`
+}
+
+// Parse parses and validates the envelope of an encrypted memo.
+func Parse(content string) (*Envelope, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(content)))
+	decoder.DisallowUnknownFields()
+	envelope := &Envelope{}
+	if err := decoder.Decode(envelope); err != nil {
+		return nil, errors.Wrap(err, "malformed envelope")
+	}
+	if decoder.More() {
+		return nil, errors.New("malformed envelope: unexpected data after the envelope")
+	}
+	if err := envelope.Validate(); err != nil {
+		return nil, err
+	}
+	return envelope, nil
+}
+
+// Validate checks the version, the key-derivation parameters and the cipher of the envelope.
+func (e *Envelope) Validate() error {
+	if e.Version != Version {
+		return errors.Errorf("unsupported envelope version %d", e.Version)
+	}
+
+	switch e.KDF.Name {
+	case KDFPBKDF2SHA256:
+		if e.KDF.Iterations < minPBKDF2Iterations {
+			return errors.Errorf("kdf iterations must be at least %d", minPBKDF2Iterations)
+		}
+	case KDFArgon2id:
+		if e.KDF.Iterations < 1 || e.KDF.Parallelism < 1 {
+			return errors.New("kdf iterations and parallelism must be positive")
+		}
+		if e.KDF.Memory < minArgon2idMemory {
+			return errors.Errorf("kdf memory must be at least %d KiB", minArgon2idMemory)
+		}
+	default:
+		return errors.Errorf("unsupported kdf %q", e.KDF.Name)
+	}
+	if salt, err := base64.StdEncoding.DecodeString(e.KDF.Salt); err != nil || len(salt) < minSaltSize {
+		return errors.Errorf("kdf salt must be at least %d base64 encoded bytes", minSaltSize)
+	}
+
+	if e.Cipher.Name != CipherAES256GCM {
+		return errors.Errorf("unsupported cipher %q", e.Cipher.Name)
+	}
+	if iv, err := base64.StdEncoding.DecodeString(e.Cipher.IV); err != nil || len(iv) != gcmNonceSize {
+		return errors.Errorf("cipher iv must be %d base64 encoded bytes", gcmNonceSize)
+	}
+	if ciphertext, err := base64.StdEncoding.DecodeString(e.Ciphertext); err != nil || len(ciphertext) < gcmTagSize {
+		return errors.New("ciphertext must be base64 encoded and include the authentication tag")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package envelope
+
+import (
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		content string
+		valid   bool
+	}{
+		{
+			content: `{"version":1,"kdf":{"name":"PBKDF2-SHA256","salt":"AAAAAAAAAAAAAAAAAAAAAA==","iterations":600000},"cipher":{"name":"AES-256-GCM","iv":"AAAAAAAAAAAAAAAA"},"ciphertext":"AAAAAAAAAAAAAAAAAAAAAAAA"}`,
+			valid:   true,
+		},
+		{
+			content: `{"version":1,"kdf":{"name":"ARGON2ID","salt":"AAAAAAAAAAAAAAAAAAAAAA==","iterations":2,"memory":19456,"parallelism":1},"cipher":{"name":"AES-256-GCM","iv":"AAAAAAAAAAAAAAAA"},"ciphertext":"AAAAAAAAAAAAAAAAAAAAAAAA"}`,
+			valid:   true,
+		},
+		{
+			content: "#hello world",
+			valid:   false,
+		},
+		{
+			// Unsupported version.
+			content: `{"version":2,"kdf":{"name":"PBKDF2-SHA256","salt":"AAAAAAAAAAAAAAAAAAAAAA==","iterations":600000},"cipher":{"name":"AES-256-GCM","iv":"AAAAAAAAAAAAAAAA"},"ciphertext":"AAAAAAAAAAAAAAAAAAAAAAAA"}`,
+			valid:   false,
+		},
+		{
+			// Too few iterations.
+			content: `{"version":1,"kdf":{"name":"PBKDF2-SHA256","salt":"AAAAAAAAAAAAAAAAAAAAAA==","iterations":1000},"cipher":{"name":"AES-256-GCM","iv":"AAAAAAAAAAAAAAAA"},"ciphertext":"AAAAAAAAAAAAAAAAAAAAAAAA"}`,
+			valid:   false,
+		},
+		{
+			// Short salt.
+			content: `{"version":1,"kdf":{"name":"PBKDF2-SHA256","salt":"AAAA","iterations":600000},"cipher":{"name":"AES-256-GCM","iv":"AAAAAAAAAAAAAAAA"},"ciphertext":"AAAAAAAAAAAAAAAAAAAAAAAA"}`,
+			valid:   false,
+		},
+		{
+			// Wrong iv size.
+			content: `{"version":1,"kdf":{"name":"PBKDF2-SHA256","salt":"AAAAAAAAAAAAAAAAAAAAAA==","iterations":600000},"cipher":{"name":"AES-256-GCM","iv":"AAAA"},"ciphertext":"AAAAAAAAAAAAAAAAAAAAAAAA"}`,
+			valid:   false,
+		},
+		{
+			// Plaintext leaked next to the ciphertext.
+			content: `{"version":1,"kdf":{"name":"PBKDF2-SHA256","salt":"AAAAAAAAAAAAAAAAAAAAAA==","iterations":600000},"cipher":{"name":"AES-256-GCM","iv":"AAAAAAAAAAAAAAAA"},"ciphertext":"AAAAAAAAAAAAAAAAAAAAAAAA","content":"secret"}`,
+			valid:   false,
+		},
+	}
+	for _, test := range tests {
+		_, err := Parse(test.content)
+		if valid := err == nil; valid != test.valid {
+			t.Errorf("Parse(%s): got valid %v, want %v, error: %v", test.content, valid, test.valid, err)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// RunOverdueTasks is a background job that reports the tasks whose due date has passed.
+// Each overdue task is reported once into the inboxes of the creator of its memo and of its assignee, if they can see the memo.
+func RunOverdueTasks(ctx context.Context, dataStore *store.Store) {
+	for {
+		if err := reportOverdueTasks(ctx, dataStore, time.Now()); err != nil {
+			slog.Error("failed to report overdue tasks", slog.Any("error", err))
+		} else {
+			slog.Debug("reported overdue tasks")
+		}
+		select {
+		case <-time.After(time.Hour):
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+func reportOverdueTasks(ctx context.Context, dataStore *store.Store, now time.Time) error {
+	// A task is overdue once the whole due date has passed.
+	dueTsBefore := now.Add(-24 * time.Hour).Unix()
+	completed, overdueNotified := false, false
+	normalStatus := store.Normal
+	tasks, err := dataStore.ListMemoTasks(ctx, &store.FindMemoTask{
+		Completed:       &completed,
+		DueTsBefore:     &dueTsBefore,
+		OverdueNotified: &overdueNotified,
+		RowStatus:       &normalStatus,
+	})
+	if err != nil {
+		return errors.Wrap(err, "list overdue tasks")
+	}
+
+	for _, task := range tasks {
+		memo, err := dataStore.GetMemo(ctx, &store.FindMemo{ID: &task.MemoID})
+		if err != nil {
+			return errors.Wrapf(err, "get memo %d", task.MemoID)
+		}
+		if memo == nil {
+			continue
+		}
+		activity, err := dataStore.CreateActivity(ctx, &store.Activity{
+			CreatorID: store.SystemBotID,
+			Type:      store.ActivityTypeTaskOverdue,
+			Level:     store.ActivityLevelInfo,
+			Payload: &storepb.ActivityPayload{
+				TaskOverdue: &storepb.ActivityTaskOverduePayload{
+					MemoId:   task.MemoID,
+					Position: task.Position,
+					Content:  task.Content,
+					DueTs:    task.DueTs,
+				},
+			},
+		})
+		if err != nil {
+			return errors.Wrap(err, "create activity")
+		}
+		receiverIDs := []int32{memo.CreatorID}
+		if task.AssigneeID != 0 && task.AssigneeID != memo.CreatorID {
+			// The inbox shows the content of the task, which must not reach an assignee who cannot see the memo.
+			visibleMemo, err := dataStore.GetMemo(ctx, &store.FindMemo{ID: &memo.ID, VisibleToUserID: &task.AssigneeID})
+			if err != nil {
+				return errors.Wrapf(err, "get memo %d", task.MemoID)
+			}
+			if visibleMemo != nil {
+				receiverIDs = append(receiverIDs, task.AssigneeID)
+			}
+		}
+		for _, receiverID := range receiverIDs {
+			if _, err := dataStore.CreateInbox(ctx, &store.Inbox{
+				SenderID:   store.SystemBotID,
+				ReceiverID: receiverID,
+				Status:     store.UNREAD,
+				Message: &storepb.InboxMessage{
+					Type:       storepb.InboxMessage_TYPE_TASK_OVERDUE,
+					ActivityId: &activity.ID,
+				},
+			}); err != nil {
+				return errors.Wrap(err, "create inbox")
+			}
+		}
+		notified := true
+		if err := dataStore.UpdateMemoTask(ctx, &store.UpdateMemoTask{
+			MemoID:          task.MemoID,
+			Position:        task.Position,
+			OverdueNotified: &notified,
+		}); err != nil {
+			return errors.Wrap(err, "update memo task")
+		}
+	}
+	return nil
+}
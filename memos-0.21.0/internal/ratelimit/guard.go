@@ -0,0 +1,188 @@
+package ratelimit
+
+import (
+	"math"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// freeAttempts is the number of failed attempts allowed before delays kick in.
+	freeAttempts = 3
+	// baseDelay is the delay after the first failed attempt beyond the free ones, doubled for each further failure.
+	baseDelay = time.Second
+)
+
+// Lockout describes the failed sign-in attempts recorded for a key.
+type Lockout struct {
+	Key            string
+	FailedAttempts int
+	LastFailure    time.Time
+	LockedUntil    time.Time
+}
+
+// LoginGuard protects sign-ins against brute force by delaying and then locking out
+// keys, such as client IPs or usernames, after repeated failures.
+type LoginGuard struct {
+	mu              sync.Mutex
+	maxAttempts     int
+	lockoutDuration time.Duration
+	records         map[string]*Lockout
+}
+
+// NewLoginGuard returns a guard locking a key out for lockoutDuration after maxAttempts consecutive failures.
+// A non-positive maxAttempts disables the guard.
+func NewLoginGuard(maxAttempts int, lockoutDuration time.Duration) *LoginGuard {
+	return &LoginGuard{
+		maxAttempts:     maxAttempts,
+		lockoutDuration: lockoutDuration,
+		records:         map[string]*Lockout{},
+	}
+}
+
+// Check returns how long the caller has to wait before the next attempt is allowed for all the keys.
+func (g *LoginGuard) Check(keys ...string) time.Duration {
+	if g == nil || g.maxAttempts <= 0 {
+		return 0
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := time.Now()
+	var wait time.Duration
+	for _, key := range keys {
+		record := g.getRecord(key, now)
+		if record == nil {
+			continue
+		}
+		var allowedAt time.Time
+		if record.LockedUntil.After(now) {
+			allowedAt = record.LockedUntil
+		} else {
+			allowedAt = record.LastFailure.Add(g.delay(record.FailedAttempts))
+		}
+		if d := allowedAt.Sub(now); d > wait {
+			wait = d
+		}
+	}
+	return wait
+}
+
+// Fail records a failed attempt for the keys and returns the lockouts started by it.
+func (g *LoginGuard) Fail(keys ...string) []*Lockout {
+	if g == nil || g.maxAttempts <= 0 {
+		return nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := time.Now()
+	lockouts := []*Lockout{}
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		record := g.getRecord(key, now)
+		if record == nil {
+			record = &Lockout{Key: key}
+			g.records[key] = record
+		}
+		record.FailedAttempts++
+		record.LastFailure = now
+		if record.FailedAttempts >= g.maxAttempts && !record.LockedUntil.After(now) {
+			record.LockedUntil = now.Add(g.lockoutDuration)
+			lockout := *record
+			lockouts = append(lockouts, &lockout)
+		}
+	}
+	return lockouts
+}
+
+// Succeed clears the failed attempts of the keys.
+func (g *LoginGuard) Succeed(keys ...string) {
+	if g == nil {
+		return
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	for _, key := range keys {
+		delete(g.records, key)
+	}
+}
+
+// ListLockouts returns the keys with recorded failed attempts, locked out keys first.
+func (g *LoginGuard) ListLockouts() []*Lockout {
+	if g == nil {
+		return nil
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	now := time.Now()
+	lockouts := []*Lockout{}
+	for key := range g.records {
+		if record := g.getRecord(key, now); record != nil {
+			lockout := *record
+			lockouts = append(lockouts, &lockout)
+		}
+	}
+	sort.Slice(lockouts, func(i, j int) bool {
+		if !lockouts[i].LockedUntil.Equal(lockouts[j].LockedUntil) {
+			return lockouts[i].LockedUntil.After(lockouts[j].LockedUntil)
+		}
+		return lockouts[i].Key < lockouts[j].Key
+	})
+	return lockouts
+}
+
+// Clear removes the failed attempts and lockout of the key and returns whether there was any.
+func (g *LoginGuard) Clear(key string) bool {
+	if g == nil {
+		return false
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	_, ok := g.records[key]
+	delete(g.records, key)
+	return ok
+}
+
+// getRecord returns the record of the key, dropping it once its lockout or delay has long passed.
+// The caller must hold the lock.
+func (g *LoginGuard) getRecord(key string, now time.Time) *Lockout {
+	record, ok := g.records[key]
+	if !ok {
+		return nil
+	}
+	var expired bool
+	if !record.LockedUntil.IsZero() {
+		expired = !record.LockedUntil.After(now)
+	} else {
+		expired = now.Sub(record.LastFailure) > g.lockoutDuration
+	}
+	if expired {
+		delete(g.records, key)
+		return nil
+	}
+	return record
+}
+
+// delay returns the progressive delay imposed after the given number of failed attempts.
+func (g *LoginGuard) delay(failedAttempts int) time.Duration {
+	if failedAttempts < freeAttempts {
+		return 0
+	}
+	delay := time.Duration(float64(baseDelay) * math.Pow(2, float64(failedAttempts-freeAttempts)))
+	if delay > g.lockoutDuration {
+		return g.lockoutDuration
+	}
+	return delay
+}
@@ -0,0 +1,83 @@
+package ratelimit
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLoginGuard(t *testing.T) {
+	guard := NewLoginGuard(5, time.Minute)
+	keys := []string{"ip:127.0.0.1", "user:test"}
+
+	for i := 0; i < freeAttempts-1; i++ {
+		guard.Fail(keys...)
+	}
+	if wait := guard.Check(keys...); wait != 0 {
+		t.Errorf("got wait %v after free attempts, want 0", wait)
+	}
+
+	guard.Fail(keys...)
+	if wait := guard.Check(keys...); wait <= 0 || wait > baseDelay {
+		t.Errorf("got wait %v after %d failures, want a delay up to %v", wait, freeAttempts, baseDelay)
+	}
+
+	guard.Fail(keys...)
+	lockouts := guard.Fail(keys...)
+	if len(lockouts) != 2 {
+		t.Fatalf("got %d lockouts, want 2", len(lockouts))
+	}
+	if wait := guard.Check("user:test"); wait <= 2*baseDelay {
+		t.Errorf("got wait %v for a locked out key, want the lockout duration", wait)
+	}
+	// A new failure during the lockout must not start another one.
+	if lockouts := guard.Fail(keys...); len(lockouts) != 0 {
+		t.Errorf("got %d lockouts during a lockout, want 0", len(lockouts))
+	}
+
+	guard.Succeed("user:test")
+	if wait := guard.Check("user:test"); wait != 0 {
+		t.Errorf("got wait %v after success, want 0", wait)
+	}
+	if wait := guard.Check(keys...); wait == 0 {
+		t.Error("got no wait for the locked out ip, want a wait")
+	}
+	if len(guard.ListLockouts()) != 1 {
+		t.Errorf("got %d lockouts, want 1", len(guard.ListLockouts()))
+	}
+	if !guard.Clear("ip:127.0.0.1") {
+		t.Error("failed to clear the lockout of the ip")
+	}
+	if guard.Clear("ip:127.0.0.1") {
+		t.Error("cleared a lockout twice")
+	}
+	if wait := guard.Check(keys...); wait != 0 {
+		t.Errorf("got wait %v after clear, want 0", wait)
+	}
+}
+
+func TestLimiter(t *testing.T) {
+	limiter := NewLimiter(1, 2)
+	for i := 0; i < 2; i++ {
+		if !limiter.Allow("ip:127.0.0.1") {
+			t.Fatalf("request %d denied within the burst", i)
+		}
+	}
+	if limiter.Allow("ip:127.0.0.1", "user:test") {
+		t.Error("request allowed beyond the burst")
+	}
+	if !limiter.Allow("ip:127.0.0.2") {
+		t.Error("request of another key denied")
+	}
+	// A request denied by one key does not take a token from the others.
+	if limiter.Allow("ip:127.0.0.2", "ip:127.0.0.1") {
+		t.Error("request allowed beyond the burst of one of its keys")
+	}
+	if !limiter.Allow("ip:127.0.0.2") {
+		t.Error("request denied after a denied request took a token of its key")
+	}
+
+	var disabled *Limiter
+	if !disabled.Allow("ip:127.0.0.1") || !NewLimiter(0, 0).Allow("ip:127.0.0.1") {
+		t.Error("disabled limiter denied a request")
+	}
+}
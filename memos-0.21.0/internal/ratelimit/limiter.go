@@ -0,0 +1,83 @@
+// Package ratelimit provides in-memory request rate limiting and brute-force protection for sign-ins.
+package ratelimit
+
+import (
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// limiterExpiresIn is how long an idle key is kept before its bucket is dropped.
+const limiterExpiresIn = 3 * time.Minute
+
+// Limiter is a token bucket rate limiter keyed by arbitrary strings, such as client IPs, users or access tokens.
+type Limiter struct {
+	mu          sync.Mutex
+	rate        rate.Limit
+	burst       int
+	visitors    map[string]*visitor
+	lastCleanup time.Time
+}
+
+type visitor struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// NewLimiter returns a limiter allowing rps requests per second with the given burst for each key.
+// A non-positive rps disables the limiter.
+func NewLimiter(rps float64, burst int) *Limiter {
+	if burst <= 0 {
+		burst = 1
+	}
+	return &Limiter{
+		rate:        rate.Limit(rps),
+		burst:       burst,
+		visitors:    map[string]*visitor{},
+		lastCleanup: time.Now(),
+	}
+}
+
+// Allow returns true if a request identified by every one of the keys is allowed. Empty keys are ignored.
+func (l *Limiter) Allow(keys ...string) bool {
+	if l == nil || l.rate <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.lastCleanup) > limiterExpiresIn {
+		for key, v := range l.visitors {
+			if now.Sub(v.lastSeen) > limiterExpiresIn {
+				delete(l.visitors, key)
+			}
+		}
+		l.lastCleanup = now
+	}
+
+	// Check every bucket before taking a token from any of them, so that a request denied by one key
+	// does not use up the budget of the others.
+	visitors := []*visitor{}
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		v, ok := l.visitors[key]
+		if !ok {
+			v = &visitor{limiter: rate.NewLimiter(l.rate, l.burst)}
+			l.visitors[key] = v
+		}
+		v.lastSeen = now
+		if v.limiter.TokensAt(now) < 1 {
+			return false
+		}
+		visitors = append(visitors, v)
+	}
+	for _, v := range visitors {
+		v.limiter.AllowN(now, 1)
+	}
+	return true
+}
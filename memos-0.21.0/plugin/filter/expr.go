@@ -0,0 +1,87 @@
+package filter
+
+// Operator is the operator of a comparison.
+type Operator string
+
+const (
+	OperatorEqual          Operator = "="
+	OperatorNotEqual       Operator = "!="
+	OperatorLess           Operator = "<"
+	OperatorLessOrEqual    Operator = "<="
+	OperatorGreater        Operator = ">"
+	OperatorGreaterOrEqual Operator = ">="
+)
+
+// Expr is a condition on the fields of the rows of a table.
+// The fields are named after the store, the values are the ones stored in the columns.
+type Expr interface {
+	isExpr()
+}
+
+// AndExpr is true if all its expressions are.
+type AndExpr struct {
+	Exprs []Expr
+}
+
+// OrExpr is true if any of its expressions is.
+type OrExpr struct {
+	Exprs []Expr
+}
+
+// NotExpr negates its expression.
+type NotExpr struct {
+	Expr Expr
+}
+
+// CompareExpr compares a field with a value.
+type CompareExpr struct {
+	Field    string
+	Operator Operator
+	Value    any
+}
+
+// InExpr is true if a field is equal to any of the values.
+type InExpr struct {
+	Field  string
+	Values []any
+}
+
+// MatchKind is the way a string field matches the value of a MatchExpr.
+type MatchKind string
+
+const (
+	MatchContains   MatchKind = "contains"
+	MatchStartsWith MatchKind = "startsWith"
+	MatchEndsWith   MatchKind = "endsWith"
+)
+
+// MatchExpr matches a string field with a part of it.
+type MatchExpr struct {
+	Field string
+	Kind  MatchKind
+	Value string
+}
+
+func (*AndExpr) isExpr()     {}
+func (*OrExpr) isExpr()      {}
+func (*NotExpr) isExpr()     {}
+func (*CompareExpr) isExpr() {}
+func (*InExpr) isExpr()      {}
+func (*MatchExpr) isExpr()   {}
+
+// And returns the conjunction of the expressions, skipping the nil ones.
+func And(exprs ...Expr) Expr {
+	list := []Expr{}
+	for _, expr := range exprs {
+		if expr != nil {
+			list = append(list, expr)
+		}
+	}
+	if len(list) == 0 {
+		return nil
+	}
+	if len(list) == 1 {
+		return list[0]
+	}
+	return &AndExpr{Exprs: list}
+}
@@ -0,0 +1,190 @@
+package filter
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/stretchr/testify/require"
+)
+
+var testFields = []*Field{
+	{Name: "content", Type: cel.StringType},
+	{Name: "visibility", Type: cel.StringType},
+	{Name: "creator", Type: cel.StringType, Column: "creator_id", Convert: func(value any) (any, error) {
+		return strings.TrimPrefix(value.(string), "users/"), nil
+	}},
+	{Name: "pinned", Type: cel.BoolType},
+	{Name: "size", Type: cel.IntType},
+	{Name: "create_time", Type: cel.TimestampType, Column: "created_ts", Convert: func(value any) (any, error) {
+		return value.(time.Time).Unix(), nil
+	}},
+	{Name: "tag", Type: cel.StringType},
+	{Name: "property", Type: cel.MapType(cel.StringType, cel.DynType), Keyed: true},
+	{Name: "limit", Type: cel.IntType, Option: true},
+}
+
+var testColumns = map[string]string{
+	"content":    "content",
+	"visibility": "visibility",
+	"creator_id": "creator_id",
+	"pinned":     "pinned",
+	"size":       "size",
+	"created_ts": "created_ts",
+	"tag":        "EXISTS (SELECT 1 FROM memo_tag WHERE memo_tag.memo_id = memo.id AND memo_tag.tag %s)",
+	"property.*": "EXISTS (SELECT 1 FROM memo_property WHERE memo_property.memo_id = memo.id AND memo_property.key = %s AND memo_property.value %s)",
+}
+
+func TestConvertToSQL(t *testing.T) {
+	tests := []struct {
+		filter    string
+		condition string
+		args      []any
+	}{
+		{
+			filter:    `visibility == "PUBLIC"`,
+			condition: "visibility = ?",
+			args:      []any{"PUBLIC"},
+		},
+		{
+			filter:    `visibility != "PRIVATE" && size > 10`,
+			condition: "(visibility != ? AND size > ?)",
+			args:      []any{"PRIVATE", int64(10)},
+		},
+		{
+			filter:    `10 <= size`,
+			condition: "size >= ?",
+			args:      []any{int64(10)},
+		},
+		{
+			filter:    `visibility == "PUBLIC" || (creator == "users/1" && !pinned)`,
+			condition: "(visibility = ? OR (creator_id = ? AND NOT (pinned = ?)))",
+			args:      []any{"PUBLIC", "1", 1},
+		},
+		{
+			filter:    `visibility in ["PUBLIC", "PROTECTED"]`,
+			condition: "visibility IN (?, ?)",
+			args:      []any{"PUBLIC", "PROTECTED"},
+		},
+		{
+			filter:    `content.contains("50%") || content.startsWith("#todo")`,
+			condition: `(content LIKE ? OR content LIKE ?)`,
+			args:      []any{`%50\%%`, "#todo%"},
+		},
+		{
+			filter:    `tag in ["work", "todo"] && !tag.startsWith("archive")`,
+			condition: "(EXISTS (SELECT 1 FROM memo_tag WHERE memo_tag.memo_id = memo.id AND memo_tag.tag IN (?, ?)) AND NOT (EXISTS (SELECT 1 FROM memo_tag WHERE memo_tag.memo_id = memo.id AND memo_tag.tag LIKE ?)))",
+			args:      []any{"work", "todo", "archive%"},
+		},
+		{
+			filter:    `create_time >= timestamp("2024-01-01T00:00:00Z")`,
+			condition: "created_ts >= ?",
+			args:      []any{int64(1704067200)},
+		},
+		{
+			filter:    `property.status == "done" && 2 <= property["priority"]`,
+			condition: "(EXISTS (SELECT 1 FROM memo_property WHERE memo_property.memo_id = memo.id AND memo_property.key = ? AND memo_property.value = ?) AND EXISTS (SELECT 1 FROM memo_property WHERE memo_property.memo_id = memo.id AND memo_property.key = ? AND memo_property.value >= ?))",
+			args:      []any{"status", "done", "priority", int64(2)},
+		},
+		{
+			filter:    `has(property.url) && !property.archived`,
+			condition: "(EXISTS (SELECT 1 FROM memo_property WHERE memo_property.memo_id = memo.id AND memo_property.key = ? AND memo_property.value IS NOT NULL) AND NOT (EXISTS (SELECT 1 FROM memo_property WHERE memo_property.memo_id = memo.id AND memo_property.key = ? AND memo_property.value = ?)))",
+			args:      []any{"url", "archived", 1},
+		},
+	}
+	for _, test := range tests {
+		filter, err := Parse(test.filter, testFields)
+		require.NoError(t, err, test.filter)
+		condition, args, err := MySQL.ConvertToSQL(filter.Expr, testColumns, 0)
+		require.NoError(t, err, test.filter)
+		require.Equal(t, test.condition, condition, test.filter)
+		require.Equal(t, test.args, args, test.filter)
+	}
+}
+
+func TestConvertToSQLDialects(t *testing.T) {
+	filter, err := Parse(`visibility == "PUBLIC" && content.endsWith("done")`, testFields)
+	require.NoError(t, err)
+
+	condition, _, err := SQLite.ConvertToSQL(filter.Expr, testColumns, 0)
+	require.NoError(t, err)
+	require.Equal(t, `(visibility = ? AND content LIKE ? ESCAPE '\')`, condition)
+
+	condition, args, err := Postgres.ConvertToSQL(filter.Expr, testColumns, 2)
+	require.NoError(t, err)
+	require.Equal(t, "(visibility = $3 AND content LIKE $4)", condition)
+	require.Equal(t, []any{"PUBLIC", "%done"}, args)
+}
+
+func TestParseOptions(t *testing.T) {
+	filter, err := Parse(`limit == 5 && pinned`, testFields)
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"limit": int64(5)}, filter.Options)
+	require.Equal(t, &CompareExpr{Field: "pinned", Operator: OperatorEqual, Value: true}, filter.Expr)
+
+	filter, err = Parse(`limit == 5`, testFields)
+	require.NoError(t, err)
+	require.Nil(t, filter.Expr)
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []string{
+		// Unknown variable.
+		`email == "a@b.c"`,
+		// Not a boolean.
+		`size + 1`,
+		// Options only at the top level.
+		`pinned || limit == 5`,
+		// Comparisons of two variables.
+		`content == visibility`,
+		// Unsupported functions.
+		`content.matches("^a")`,
+		`size(content) > 1`,
+		// Keyed variables compared as a whole or by nested keys.
+		`property == {}`,
+		`property.link.host == "example.com"`,
+		`property.priority + 1`,
+	}
+	for _, test := range tests {
+		_, err := Parse(test, testFields)
+		require.Error(t, err, test)
+	}
+}
+
+func TestConvertToSQLUnsupportedField(t *testing.T) {
+	_, _, err := SQLite.ConvertToSQL(&CompareExpr{Field: "email", Operator: OperatorEqual, Value: "a@b.c"}, testColumns, 0)
+	require.Error(t, err)
+}
+
+func TestResolveRelativeTimes(t *testing.T) {
+	now := time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		expression string
+		want       string
+	}{
+		{
+			expression: `create_time > now - 7d`,
+			want:       `create_time > timestamp("2024-03-03T12:00:00Z")`,
+		},
+		{
+			expression: `create_time <= now&&create_time>now-2h`,
+			want:       `create_time <= timestamp("2024-03-10T12:00:00Z")&&create_time>timestamp("2024-03-10T10:00:00Z")`,
+		},
+		{
+			expression: `create_time < now + 1w && content.contains("now - 7d")`,
+			want:       `create_time < timestamp("2024-03-17T12:00:00Z") && content.contains("now - 7d")`,
+		},
+		{
+			expression: `content.contains('it\'s now') || content.contains(r"\") || snow == known`,
+			want:       `content.contains('it\'s now') || content.contains(r"\") || snow == known`,
+		},
+	}
+	for _, test := range tests {
+		require.Equal(t, test.want, ResolveRelativeTimes(test.expression, now), test.expression)
+	}
+
+	filter, err := Parse(ResolveRelativeTimes(`create_time >= now - 1d`, now), testFields)
+	require.NoError(t, err)
+	require.Equal(t, &CompareExpr{Field: "created_ts", Operator: OperatorGreaterOrEqual, Value: now.Add(-24 * time.Hour).Unix()}, filter.Expr)
+}
@@ -0,0 +1,424 @@
+package filter
+
+import (
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/operators"
+	"github.com/google/cel-go/common/overloads"
+	"github.com/pkg/errors"
+	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// Field is a CEL variable the filters can use.
+type Field struct {
+	// Name is the name of the variable in the CEL expressions.
+	Name string
+	// Type is the CEL type of the variable.
+	Type *cel.Type
+	// Column is the name of the field in the store, default to Name.
+	Column string
+	// Option is true if the variable is not a condition but an option of the query, such as a limit.
+	// The options are only compared with == at the top level of the expression.
+	Option bool
+	// Convert converts the constants compared with the variable to the values stored in the column,
+	// for example a resource name to an id, or a timestamp to a unix timestamp.
+	Convert func(value any) (any, error)
+	// Rewrite replaces the conditions on the variable, for the variables which are not columns.
+	Rewrite func(expr Expr) (Expr, error)
+	// Keyed is true if the variable is a map whose values are compared by key, such as property.priority or property["priority"].
+	// The conditions on a key are on the field Column.key, and has(property.priority) is true if the key is set.
+	Keyed bool
+}
+
+// Filter is a parsed CEL filter.
+type Filter struct {
+	// Expr is nil if the filter only sets options.
+	Expr Expr
+	// Options are the values of the option variables set by the filter.
+	Options map[string]any
+}
+
+// Parse compiles the CEL expression with the fields as variables, and converts it into an expression on the columns.
+// The errors describe the expressions which are invalid or not supported.
+func Parse(expression string, fields []*Field) (*Filter, error) {
+	envOptions := []cel.EnvOption{}
+	fieldMap := map[string]*Field{}
+	for _, field := range fields {
+		envOptions = append(envOptions, cel.Variable(field.Name, field.Type))
+		fieldMap[field.Name] = field
+	}
+	env, err := cel.NewEnv(envOptions...)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	// The values of the keyed variables are dynamic, and so are the expressions made of one of them.
+	if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, errors.Errorf("filter must be a boolean expression, got %s", ast.OutputType())
+	}
+	parsedExpr, err := cel.AstToParsedExpr(ast)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{
+		fields: fieldMap,
+		filter: &Filter{
+			Options: map[string]any{},
+		},
+	}
+	conditions := []Expr{}
+	for _, e := range splitConjunction(parsedExpr.GetExpr()) {
+		ok, err := p.parseOption(e)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			continue
+		}
+		condition, err := p.parse(e)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, condition)
+	}
+	p.filter.Expr = And(conditions...)
+	return p.filter, nil
+}
+
+type parser struct {
+	fields map[string]*Field
+	filter *Filter
+}
+
+// splitConjunction returns the operands of the top level && operators.
+func splitConjunction(e *expr.Expr) []*expr.Expr {
+	callExpr := e.GetCallExpr()
+	if callExpr == nil || callExpr.Function != operators.LogicalAnd {
+		return []*expr.Expr{e}
+	}
+	list := []*expr.Expr{}
+	for _, arg := range callExpr.Args {
+		list = append(list, splitConjunction(arg)...)
+	}
+	return list
+}
+
+// parseOption sets the option if the expression is an option compared with a constant.
+func (p *parser) parseOption(e *expr.Expr) (bool, error) {
+	callExpr := e.GetCallExpr()
+	if callExpr == nil || callExpr.Function != operators.Equals {
+		return false, nil
+	}
+	field := p.getField(callExpr.Args[0])
+	if field == nil || !field.Option {
+		return false, nil
+	}
+	value, err := getConstValue(callExpr.Args[1])
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid value of %s", field.Name)
+	}
+	p.filter.Options[field.Name] = value
+	return true, nil
+}
+
+func (p *parser) parse(e *expr.Expr) (Expr, error) {
+	switch kind := e.ExprKind.(type) {
+	case *expr.Expr_IdentExpr:
+		// A boolean variable on its own is true if it is true.
+		field, err := p.getConditionField(e)
+		if err != nil {
+			return nil, err
+		}
+		return p.newCondition(field, &CompareExpr{Operator: OperatorEqual}, true)
+	case *expr.Expr_SelectExpr:
+		if kind.SelectExpr.TestOnly {
+			// has(property.priority) is true if the key is set.
+			field, err := p.getConditionField(&expr.Expr{
+				ExprKind: &expr.Expr_SelectExpr{
+					SelectExpr: &expr.Expr_Select{Operand: kind.SelectExpr.Operand, Field: kind.SelectExpr.Field},
+				},
+			})
+			if err != nil {
+				return nil, err
+			}
+			return p.newCondition(field, &CompareExpr{Operator: OperatorNotEqual}, nil)
+		}
+		field, err := p.getConditionField(e)
+		if err != nil {
+			return nil, err
+		}
+		return p.newCondition(field, &CompareExpr{Operator: OperatorEqual}, true)
+	case *expr.Expr_CallExpr:
+		if kind.CallExpr.Function == operators.Index {
+			field, err := p.getConditionField(e)
+			if err != nil {
+				return nil, err
+			}
+			return p.newCondition(field, &CompareExpr{Operator: OperatorEqual}, true)
+		}
+		return p.parseCall(kind.CallExpr)
+	default:
+		return nil, errors.Errorf("unsupported expression in filter")
+	}
+}
+
+func (p *parser) parseCall(callExpr *expr.Expr_Call) (Expr, error) {
+	switch callExpr.Function {
+	case operators.LogicalAnd, operators.LogicalOr:
+		exprs := []Expr{}
+		for _, arg := range callExpr.Args {
+			e, err := p.parse(arg)
+			if err != nil {
+				return nil, err
+			}
+			exprs = append(exprs, e)
+		}
+		if callExpr.Function == operators.LogicalAnd {
+			return &AndExpr{Exprs: exprs}, nil
+		}
+		return &OrExpr{Exprs: exprs}, nil
+	case operators.LogicalNot:
+		e, err := p.parse(callExpr.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		return &NotExpr{Expr: e}, nil
+	case operators.Equals, operators.NotEquals, operators.Less, operators.LessEquals, operators.Greater, operators.GreaterEquals:
+		return p.parseComparison(callExpr)
+	case operators.In:
+		field, err := p.getConditionField(callExpr.Args[1])
+		if err == nil {
+			return nil, errors.Errorf("unsupported use of %s with in, the variable must be on the left", field.Name)
+		}
+		field, err = p.getConditionField(callExpr.Args[0])
+		if err != nil {
+			return nil, err
+		}
+		value, err := getConstValue(callExpr.Args[1])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value of %s", field.Name)
+		}
+		values, ok := value.([]any)
+		if !ok {
+			return nil, errors.Errorf("the value of %s must be a list", field.Name)
+		}
+		return p.newCondition(field, &InExpr{}, values)
+	case overloads.Contains, overloads.StartsWith, overloads.EndsWith:
+		if callExpr.Target == nil || len(callExpr.Args) != 1 {
+			return nil, errors.Errorf("unsupported use of %s in filter", callExpr.Function)
+		}
+		field, err := p.getConditionField(callExpr.Target)
+		if err != nil {
+			return nil, err
+		}
+		value, err := getConstValue(callExpr.Args[0])
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value of %s", field.Name)
+		}
+		return p.newCondition(field, &MatchExpr{Kind: MatchKind(callExpr.Function)}, value)
+	default:
+		return nil, errors.Errorf("unsupported function %s in filter", callExpr.Function)
+	}
+}
+
+// flippedOperators are the operators of the comparisons with the variable on the right.
+var flippedOperators = map[string]Operator{
+	operators.Equals:        OperatorEqual,
+	operators.NotEquals:     OperatorNotEqual,
+	operators.Less:          OperatorGreater,
+	operators.LessEquals:    OperatorGreaterOrEqual,
+	operators.Greater:       OperatorLess,
+	operators.GreaterEquals: OperatorLessOrEqual,
+}
+
+var comparisonOperators = map[string]Operator{
+	operators.Equals:        OperatorEqual,
+	operators.NotEquals:     OperatorNotEqual,
+	operators.Less:          OperatorLess,
+	operators.LessEquals:    OperatorLessOrEqual,
+	operators.Greater:       OperatorGreater,
+	operators.GreaterEquals: OperatorGreaterOrEqual,
+}
+
+func (p *parser) parseComparison(callExpr *expr.Expr_Call) (Expr, error) {
+	left, right := callExpr.Args[0], callExpr.Args[1]
+	operator := comparisonOperators[callExpr.Function]
+	if p.getField(left) == nil {
+		left, right = right, left
+		operator = flippedOperators[callExpr.Function]
+	}
+	field, err := p.getConditionField(left)
+	if err != nil {
+		return nil, err
+	}
+	value, err := getConstValue(right)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid value of %s", field.Name)
+	}
+	return p.newCondition(field, &CompareExpr{Operator: operator}, value)
+}
+
+// newCondition sets the column and the converted value of the condition on the field.
+func (p *parser) newCondition(field *Field, condition Expr, value any) (Expr, error) {
+	column := field.Column
+	if column == "" {
+		column = field.Name
+	}
+	convert := func(value any) (any, error) {
+		if field.Convert == nil {
+			return value, nil
+		}
+		converted, err := field.Convert(value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid value of %s", field.Name)
+		}
+		return converted, nil
+	}
+
+	switch condition := condition.(type) {
+	case *CompareExpr:
+		converted, err := convert(value)
+		if err != nil {
+			return nil, err
+		}
+		condition.Field, condition.Value = column, converted
+	case *InExpr:
+		condition.Field = column
+		for _, v := range value.([]any) {
+			converted, err := convert(v)
+			if err != nil {
+				return nil, err
+			}
+			condition.Values = append(condition.Values, converted)
+		}
+	case *MatchExpr:
+		converted, err := convert(value)
+		if err != nil {
+			return nil, err
+		}
+		s, ok := converted.(string)
+		if !ok {
+			return nil, errors.Errorf("the value of %s must be a string", field.Name)
+		}
+		condition.Field, condition.Value = column, s
+	}
+	if field.Rewrite != nil {
+		rewritten, err := field.Rewrite(condition)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid condition on %s", field.Name)
+		}
+		return rewritten, nil
+	}
+	return condition, nil
+}
+
+func (p *parser) getField(e *expr.Expr) *Field {
+	if identExpr := e.GetIdentExpr(); identExpr != nil {
+		field := p.fields[identExpr.Name]
+		if field == nil || field.Keyed {
+			return nil
+		}
+		return field
+	}
+
+	// The keys of the keyed variables are selected as property.priority or indexed as property["priority"].
+	var operand *expr.Expr
+	key := ""
+	if selectExpr := e.GetSelectExpr(); selectExpr != nil && !selectExpr.TestOnly {
+		operand, key = selectExpr.Operand, selectExpr.Field
+	} else if callExpr := e.GetCallExpr(); callExpr != nil && callExpr.Function == operators.Index && len(callExpr.Args) == 2 {
+		value, err := getConstValue(callExpr.Args[1])
+		if err != nil {
+			return nil
+		}
+		s, ok := value.(string)
+		if !ok {
+			return nil
+		}
+		operand, key = callExpr.Args[0], s
+	}
+	identExpr := operand.GetIdentExpr()
+	if identExpr == nil {
+		return nil
+	}
+	field := p.fields[identExpr.Name]
+	if field == nil || !field.Keyed {
+		return nil
+	}
+	column := field.Column
+	if column == "" {
+		column = field.Name
+	}
+	return &Field{
+		Name:    field.Name + "." + key,
+		Type:    field.Type,
+		Column:  column + "." + key,
+		Convert: field.Convert,
+		Rewrite: field.Rewrite,
+	}
+}
+
+// getConditionField returns the field the expression is, which must not be an option.
+func (p *parser) getConditionField(e *expr.Expr) (*Field, error) {
+	field := p.getField(e)
+	if field == nil {
+		return nil, errors.Errorf("unsupported expression in filter, the conditions must compare a variable with a constant")
+	}
+	if field.Option {
+		return nil, errors.Errorf("%s can only be compared with == at the top level of the filter", field.Name)
+	}
+	return field, nil
+}
+
+// getConstValue returns the value of a constant, a list of constants, or a timestamp("...") call.
+func getConstValue(e *expr.Expr) (any, error) {
+	switch kind := e.ExprKind.(type) {
+	case *expr.Expr_ConstExpr:
+		switch constant := kind.ConstExpr.ConstantKind.(type) {
+		case *expr.Constant_StringValue:
+			return constant.StringValue, nil
+		case *expr.Constant_Int64Value:
+			return constant.Int64Value, nil
+		case *expr.Constant_Uint64Value:
+			return int64(constant.Uint64Value), nil
+		case *expr.Constant_DoubleValue:
+			return constant.DoubleValue, nil
+		case *expr.Constant_BoolValue:
+			return constant.BoolValue, nil
+		case *expr.Constant_NullValue:
+			return nil, nil
+		}
+	case *expr.Expr_ListExpr:
+		values := []any{}
+		for _, element := range kind.ListExpr.Elements {
+			value, err := getConstValue(element)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case *expr.Expr_CallExpr:
+		if kind.CallExpr.Function == overloads.TypeConvertTimestamp && len(kind.CallExpr.Args) == 1 {
+			value, err := getConstValue(kind.CallExpr.Args[0])
+			if err != nil {
+				return nil, err
+			}
+			s, ok := value.(string)
+			if !ok {
+				return nil, errors.New("timestamp must be created from a string")
+			}
+			t, err := time.Parse(time.RFC3339, s)
+			if err != nil {
+				return nil, errors.Wrap(err, "invalid timestamp")
+			}
+			return t, nil
+		}
+	}
+	return nil, errors.New("must be a constant")
+}
@@ -0,0 +1,99 @@
+package filter
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// relativeTimeOffsetRegexp matches the offset following now in a relative time, such as " - 7d".
+var relativeTimeOffsetRegexp = regexp.MustCompile(`^\s*([+-])\s*(\d+)([smhdw])\b`)
+
+var relativeTimeUnits = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// ResolveRelativeTimes replaces the relative times of the expression, such as `now` or `now - 7d`, with the timestamps
+// they are at the given time, so that the saved filters can be compared with the times of the rows when they are used.
+// The units of the offsets are s, m, h, d and w. The string literals of the expression are left as they are.
+func ResolveRelativeTimes(expression string, now time.Time) string {
+	var builder strings.Builder
+	for i := 0; i < len(expression); {
+		c := expression[i]
+		switch {
+		case c == '"' || c == '\'':
+			end := skipStringLiteral(expression, i)
+			builder.WriteString(expression[i:end])
+			i = end
+		case isIdentifierStart(c):
+			end := i
+			for end < len(expression) && isIdentifierPart(expression[end]) {
+				end++
+			}
+			word := expression[i:end]
+			if word != "now" || isMemberAccess(expression, i) {
+				builder.WriteString(word)
+				i = end
+				continue
+			}
+			t := now
+			if match := relativeTimeOffsetRegexp.FindStringSubmatch(expression[end:]); match != nil {
+				amount, err := strconv.Atoi(match[2])
+				if err == nil {
+					offset := time.Duration(amount) * relativeTimeUnits[match[3]]
+					if match[1] == "-" {
+						offset = -offset
+					}
+					t = t.Add(offset)
+					end += len(match[0])
+				}
+			}
+			builder.WriteString(fmt.Sprintf("timestamp(%q)", t.UTC().Format(time.RFC3339)))
+			i = end
+		default:
+			builder.WriteByte(c)
+			i++
+		}
+	}
+	return builder.String()
+}
+
+// skipStringLiteral returns the index following the string literal starting with the quote at start,
+// which can be a triple quoted string, and a raw string if it is prefixed with r.
+func skipStringLiteral(expression string, start int) int {
+	quote := expression[start : start+1]
+	if strings.HasPrefix(expression[start:], strings.Repeat(quote, 3)) {
+		quote = strings.Repeat(quote, 3)
+	}
+	raw := start > 0 && (expression[start-1] == 'r' || expression[start-1] == 'R') && (start < 2 || !isIdentifierPart(expression[start-2]))
+	for i := start + len(quote); i < len(expression); i++ {
+		if expression[i] == '\\' && !raw {
+			i++
+			continue
+		}
+		if strings.HasPrefix(expression[i:], quote) {
+			return i + len(quote)
+		}
+	}
+	return len(expression)
+}
+
+// isMemberAccess returns true if the identifier starting at start follows a dot, such as a field or a method.
+func isMemberAccess(expression string, start int) bool {
+	trimmed := strings.TrimRight(expression[:start], " \t\r\n")
+	return strings.HasSuffix(trimmed, ".")
+}
+
+func isIdentifierStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentifierPart(c byte) bool {
+	return isIdentifierStart(c) || (c >= '0' && c <= '9')
+}
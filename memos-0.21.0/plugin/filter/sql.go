@@ -0,0 +1,173 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Dialect is the SQL dialect the expressions are converted into.
+type Dialect struct {
+	// Placeholder returns the placeholder of the nth argument of the statement, starting from 1.
+	Placeholder func(n int) string
+	// LikeEscape is appended to the LIKE conditions to escape the wildcards with a backslash,
+	// in the databases where it is not the default escape character.
+	LikeEscape string
+}
+
+var (
+	SQLite = &Dialect{
+		Placeholder: func(int) string { return "?" },
+		LikeEscape:  ` ESCAPE '\'`,
+	}
+	MySQL = &Dialect{
+		Placeholder: func(int) string { return "?" },
+	}
+	Postgres = &Dialect{
+		Placeholder: func(n int) string { return "$" + strconv.Itoa(n) },
+	}
+)
+
+// ConvertToSQL converts the expression into a SQL condition with the columns mapping the fields to their SQL expressions.
+// The fields stored in related rows are mapped to a condition on the related rows with a %s verb in place of the predicate
+// on the column, such as "EXISTS (SELECT 1 FROM memo_tag WHERE memo_tag.memo_id = memo.id AND memo_tag.tag %s)".
+// The fields of the keys of a keyed variable, such as property.priority, are mapped by the column of property.* with a %s verb
+// in place of the key before the one of the predicate.
+// The arguments of the condition are numbered after the argOffset arguments already in the statement.
+func (d *Dialect) ConvertToSQL(e Expr, columns map[string]string, argOffset int) (string, []any, error) {
+	c := &converter{
+		dialect: d,
+		columns: columns,
+		offset:  argOffset,
+	}
+	condition, err := c.convert(e)
+	if err != nil {
+		return "", nil, err
+	}
+	return condition, c.args, nil
+}
+
+type converter struct {
+	dialect *Dialect
+	columns map[string]string
+	offset  int
+	args    []any
+}
+
+func (c *converter) addArg(value any) string {
+	// The booleans are stored as integers in all the databases.
+	if b, ok := value.(bool); ok {
+		if b {
+			value = 1
+		} else {
+			value = 0
+		}
+	}
+	c.args = append(c.args, value)
+	return c.dialect.Placeholder(c.offset + len(c.args))
+}
+
+// newCondition returns the condition that the column of the field matches the predicate.
+// The predicate is built after the column, so that the arguments are in the order of the statement.
+func (c *converter) newCondition(field string, predicate func() string) (string, error) {
+	column, ok := c.columns[field]
+	if !ok {
+		prefix, key, found := strings.Cut(field, ".")
+		if column, ok = c.columns[prefix+".*"]; !found || !ok {
+			return "", errors.Errorf("unsupported field %s", field)
+		}
+		keyHolder := c.addArg(key)
+		return fmt.Sprintf(column, keyHolder, predicate()), nil
+	}
+	if strings.Contains(column, "%s") {
+		return fmt.Sprintf(column, predicate()), nil
+	}
+	return column + " " + predicate(), nil
+}
+
+// newPredicate returns the predicate as it is.
+func newPredicate(predicate string) func() string {
+	return func() string { return predicate }
+}
+
+func (c *converter) convert(e Expr) (string, error) {
+	switch e := e.(type) {
+	case *AndExpr:
+		if len(e.Exprs) == 0 {
+			return "1 = 1", nil
+		}
+		return c.convertList(e.Exprs, " AND ")
+	case *OrExpr:
+		if len(e.Exprs) == 0 {
+			return "1 = 0", nil
+		}
+		return c.convertList(e.Exprs, " OR ")
+	case *NotExpr:
+		condition, err := c.convert(e.Expr)
+		if err != nil {
+			return "", err
+		}
+		return "NOT (" + condition + ")", nil
+	case *CompareExpr:
+		if e.Value == nil {
+			switch e.Operator {
+			case OperatorEqual:
+				return c.newCondition(e.Field, newPredicate("IS NULL"))
+			case OperatorNotEqual:
+				return c.newCondition(e.Field, newPredicate("IS NOT NULL"))
+			default:
+				return "", errors.Errorf("unsupported comparison of %s with null", e.Field)
+			}
+		}
+		return c.newCondition(e.Field, func() string {
+			return string(e.Operator) + " " + c.addArg(e.Value)
+		})
+	case *InExpr:
+		if len(e.Values) == 0 {
+			return "1 = 0", nil
+		}
+		return c.newCondition(e.Field, func() string {
+			holders := []string{}
+			for _, value := range e.Values {
+				holders = append(holders, c.addArg(value))
+			}
+			return "IN (" + strings.Join(holders, ", ") + ")"
+		})
+	case *MatchExpr:
+		pattern := escapeLikePattern(e.Value)
+		switch e.Kind {
+		case MatchContains:
+			pattern = "%" + pattern + "%"
+		case MatchStartsWith:
+			pattern += "%"
+		case MatchEndsWith:
+			pattern = "%" + pattern
+		}
+		return c.newCondition(e.Field, func() string {
+			return "LIKE " + c.addArg(pattern) + c.dialect.LikeEscape
+		})
+	default:
+		return "", errors.Errorf("unsupported expression %T", e)
+	}
+}
+
+func (c *converter) convertList(exprs []Expr, separator string) (string, error) {
+	conditions := []string{}
+	for _, e := range exprs {
+		condition, err := c.convert(e)
+		if err != nil {
+			return "", err
+		}
+		conditions = append(conditions, condition)
+	}
+	return "(" + strings.Join(conditions, separator) + ")", nil
+}
+
+var likePatternReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+
+// escapeLikePattern escapes the wildcards of the LIKE patterns, so that the value is matched as it is.
+func escapeLikePattern(value string) string {
+	return likePatternReplacer.Replace(value)
+}
@@ -0,0 +1,30 @@
+// Package mail sends the emails of the workspace, e.g. the password reset links,
+// through a pluggable sender, the SMTP server configured in the workspace settings by default.
+package mail
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Message is a plain text email.
+type Message struct {
+	To      string
+	Subject string
+	Body    string
+}
+
+// Sender sends the emails.
+type Sender interface {
+	Send(ctx context.Context, message *Message) error
+}
+
+// validateHeader rejects the values containing line breaks, which would inject headers into the email.
+func validateHeader(name, value string) error {
+	if strings.ContainsAny(value, "\r\n") {
+		return errors.Errorf("invalid %s: line breaks are not allowed", name)
+	}
+	return nil
+}
@@ -0,0 +1,194 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Security is how the connection to the SMTP server is secured.
+type Security string
+
+const (
+	// SecurityStartTLS upgrades the plain connection to TLS, usually on port 587.
+	SecurityStartTLS Security = "STARTTLS"
+	// SecurityTLS connects with implicit TLS, usually on port 465.
+	SecurityTLS Security = "TLS"
+	// SecurityNone sends the emails over a plain connection, e.g. to a local relay.
+	SecurityNone Security = "NONE"
+)
+
+var (
+	// timeout is the timeout for sending an email. Default to 30 seconds.
+	timeout = 30 * time.Second
+)
+
+// SMTPConfig is the configuration of the SMTP server.
+type SMTPConfig struct {
+	Host      string
+	Port      int
+	Username  string
+	Password  string
+	FromEmail string
+	FromName  string
+	Security  Security
+}
+
+// Validate checks the SMTP server can be connected to.
+func (c *SMTPConfig) Validate() error {
+	if c.Host == "" {
+		return errors.New("host is required")
+	}
+	if c.Port <= 0 || c.Port > 65535 {
+		return errors.Errorf("invalid port %d", c.Port)
+	}
+	if _, err := mail.ParseAddress(c.FromEmail); err != nil {
+		return errors.Wrapf(err, "invalid from email %q", c.FromEmail)
+	}
+	if err := validateHeader("from name", c.FromName); err != nil {
+		return err
+	}
+	switch c.Security {
+	case SecurityStartTLS, SecurityTLS, SecurityNone:
+	default:
+		return errors.Errorf("unsupported security %q", c.Security)
+	}
+	return nil
+}
+
+// SMTPSender sends the emails through an SMTP server.
+type SMTPSender struct {
+	config *SMTPConfig
+}
+
+// NewSMTPSender returns a sender for the SMTP server.
+func NewSMTPSender(config *SMTPConfig) (*SMTPSender, error) {
+	if err := config.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid smtp config")
+	}
+	return &SMTPSender{
+		config: config,
+	}, nil
+}
+
+func (s *SMTPSender) Send(ctx context.Context, message *Message) error {
+	if _, err := mail.ParseAddress(message.To); err != nil {
+		return errors.Wrapf(err, "invalid recipient %q", message.To)
+	}
+	data, err := s.buildMessage(message)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+	address := net.JoinHostPort(s.config.Host, strconv.Itoa(s.config.Port))
+	tlsConfig := &tls.Config{
+		ServerName: s.config.Host,
+		MinVersion: tls.VersionTLS12,
+	}
+	var conn net.Conn
+	if s.config.Security == SecurityTLS {
+		dialer := &tls.Dialer{Config: tlsConfig}
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	} else {
+		dialer := &net.Dialer{}
+		conn, err = dialer.DialContext(ctx, "tcp", address)
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to connect to smtp server %s", address)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			conn.Close()
+			return errors.Wrap(err, "failed to set deadline")
+		}
+	}
+
+	client, err := smtp.NewClient(conn, s.config.Host)
+	if err != nil {
+		conn.Close()
+		return errors.Wrap(err, "failed to create smtp client")
+	}
+	defer client.Close()
+	if s.config.Security == SecurityStartTLS {
+		if ok, _ := client.Extension("STARTTLS"); !ok {
+			return errors.New("smtp server does not support STARTTLS")
+		}
+		if err := client.StartTLS(tlsConfig); err != nil {
+			return errors.Wrap(err, "failed to start tls")
+		}
+	}
+	if s.config.Username != "" {
+		// PlainAuth refuses to send the credentials over a plain connection, except to localhost.
+		if err := client.Auth(smtp.PlainAuth("", s.config.Username, s.config.Password, s.config.Host)); err != nil {
+			return errors.Wrap(err, "failed to authenticate")
+		}
+	}
+	if err := client.Mail(s.config.FromEmail); err != nil {
+		return errors.Wrap(err, "failed to set sender")
+	}
+	if err := client.Rcpt(message.To); err != nil {
+		return errors.Wrap(err, "failed to set recipient")
+	}
+	writer, err := client.Data()
+	if err != nil {
+		return errors.Wrap(err, "failed to start data")
+	}
+	if _, err := writer.Write(data); err != nil {
+		return errors.Wrap(err, "failed to write message")
+	}
+	if err := writer.Close(); err != nil {
+		return errors.Wrap(err, "failed to send message")
+	}
+	return client.Quit()
+}
+
+// buildMessage builds the RFC 5322 message with a quoted-printable UTF-8 body.
+func (s *SMTPSender) buildMessage(message *Message) ([]byte, error) {
+	if err := validateHeader("subject", message.Subject); err != nil {
+		return nil, err
+	}
+	messageID := make([]byte, 16)
+	if _, err := rand.Read(messageID); err != nil {
+		return nil, errors.Wrap(err, "failed to generate message id")
+	}
+	from := &mail.Address{Name: s.config.FromName, Address: s.config.FromEmail}
+	to := &mail.Address{Address: message.To}
+
+	buffer := &bytes.Buffer{}
+	headers := [][2]string{
+		{"From", from.String()},
+		{"To", to.String()},
+		{"Subject", mime.QEncoding.Encode("utf-8", message.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"Message-ID", fmt.Sprintf("<%s@%s>", hex.EncodeToString(messageID), s.config.Host)},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "text/plain; charset=UTF-8"},
+		{"Content-Transfer-Encoding", "quoted-printable"},
+	}
+	for _, header := range headers {
+		fmt.Fprintf(buffer, "%s: %s\r\n", header[0], header[1])
+	}
+	buffer.WriteString("\r\n")
+	writer := quotedprintable.NewWriter(buffer)
+	if _, err := writer.Write([]byte(message.Body)); err != nil {
+		return nil, errors.Wrap(err, "failed to encode body")
+	}
+	if err := writer.Close(); err != nil {
+		return nil, errors.Wrap(err, "failed to encode body")
+	}
+	return buffer.Bytes(), nil
+}
@@ -0,0 +1,155 @@
+package mail
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"mime"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// smtpSink is a local SMTP server keeping the messages it receives.
+type smtpSink struct {
+	listener net.Listener
+	mutex    sync.Mutex
+	messages []string
+}
+
+func newSMTPSink(t *testing.T) *smtpSink {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	sink := &smtpSink{listener: listener}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go sink.serve(conn)
+		}
+	}()
+	t.Cleanup(func() { listener.Close() })
+	return sink
+}
+
+func (s *smtpSink) serve(conn net.Conn) {
+	defer conn.Close()
+	reader := bufio.NewReader(conn)
+	reply := func(line string) {
+		io.WriteString(conn, line+"\r\n")
+	}
+	reply("220 localhost ESMTP sink")
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return
+		}
+		command := strings.ToUpper(strings.TrimSpace(line))
+		switch {
+		case strings.HasPrefix(command, "EHLO"), strings.HasPrefix(command, "HELO"):
+			reply("250 localhost")
+		case command == "DATA":
+			reply("354 end data with <CR><LF>.<CR><LF>")
+			data := &strings.Builder{}
+			for {
+				line, err := reader.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				data.WriteString(strings.TrimPrefix(line, "."))
+			}
+			s.mutex.Lock()
+			s.messages = append(s.messages, data.String())
+			s.mutex.Unlock()
+			reply("250 OK")
+		case command == "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
+
+func (s *smtpSink) config() *SMTPConfig {
+	addr := s.listener.Addr().(*net.TCPAddr)
+	return &SMTPConfig{
+		Host:      "127.0.0.1",
+		Port:      addr.Port,
+		FromEmail: "memos@example.com",
+		FromName:  "Memos",
+		Security:  SecurityNone,
+	}
+}
+
+func TestSMTPSender(t *testing.T) {
+	sink := newSMTPSink(t)
+	sender, err := NewSMTPSender(sink.config())
+	require.NoError(t, err)
+
+	message, err := Render(TemplatePasswordReset, "zh-Hans", &TemplateData{
+		Nickname:       "Steven",
+		Link:           "https://memos.example.com/auth/reset-password?token=abc",
+		ExpiresInHours: 1,
+	})
+	require.NoError(t, err)
+	message.To = "steven@example.com"
+	require.NoError(t, sender.Send(context.Background(), message))
+
+	sink.mutex.Lock()
+	defer sink.mutex.Unlock()
+	require.Equal(t, 1, len(sink.messages))
+	received, err := mail.ReadMessage(strings.NewReader(sink.messages[0]))
+	require.NoError(t, err)
+	require.Equal(t, `"Memos" <memos@example.com>`, received.Header.Get("From"))
+	require.Equal(t, "<steven@example.com>", received.Header.Get("To"))
+	subject, err := new(mime.WordDecoder).DecodeHeader(received.Header.Get("Subject"))
+	require.NoError(t, err)
+	require.Equal(t, "重置你的 Memos 密码", subject)
+	body, err := io.ReadAll(quotedprintable.NewReader(received.Body))
+	require.NoError(t, err)
+	require.Contains(t, string(body), "https://memos.example.com/auth/reset-password?token=abc")
+	require.Contains(t, string(body), "1 小时")
+}
+
+func TestSMTPSenderRejectsHeaderInjection(t *testing.T) {
+	sink := newSMTPSink(t)
+	sender, err := NewSMTPSender(sink.config())
+	require.NoError(t, err)
+	err = sender.Send(context.Background(), &Message{
+		To:      "steven@example.com",
+		Subject: "Hello\r\nBcc: eve@example.com",
+		Body:    "Hello",
+	})
+	require.Error(t, err)
+	err = sender.Send(context.Background(), &Message{
+		To:      "steven@example.com\r\nBcc: eve@example.com",
+		Subject: "Hello",
+		Body:    "Hello",
+	})
+	require.Error(t, err)
+}
+
+func TestRender(t *testing.T) {
+	data := &TemplateData{Nickname: "Steven", Link: "https://memos.example.com", ExpiresInHours: 24}
+	message, err := Render(TemplateEmailVerification, "en-GB", data)
+	require.NoError(t, err)
+	require.Equal(t, "Verify your email for Memos", message.Subject)
+	require.Contains(t, message.Body, "24 hours")
+	// The locales without translation fall back to English.
+	message, err = Render(TemplateEmailChange, "fr", data)
+	require.NoError(t, err)
+	require.Equal(t, "Confirm your new email for Memos", message.Subject)
+	_, err = Render("unknown", "en", data)
+	require.Error(t, err)
+}
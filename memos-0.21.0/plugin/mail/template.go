@@ -0,0 +1,88 @@
+package mail
+
+import (
+	"bytes"
+	"embed"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+)
+
+// Template is the name of an email template.
+type Template string
+
+const (
+	// TemplatePasswordReset is the email with the link to reset the password.
+	TemplatePasswordReset Template = "password_reset"
+	// TemplateEmailVerification is the email with the link to verify the email of a new user.
+	TemplateEmailVerification Template = "email_verification"
+	// TemplateEmailChange is the email with the link to confirm the new email of a user.
+	TemplateEmailChange Template = "email_change"
+
+	// DefaultLocale is the locale of the emails for the users without a translation of their locale.
+	DefaultLocale = "en"
+)
+
+// TemplateData is the data the email templates are rendered with.
+type TemplateData struct {
+	// Nickname is the name of the user receiving the email.
+	Nickname string
+	// Link is the link to open to reset the password or confirm the email.
+	Link string
+	// ExpiresInHours is how long the link is valid.
+	ExpiresInHours int
+}
+
+//go:embed templates
+var templateFS embed.FS
+
+// Every template file defines a "subject" and a "body" template.
+var templates = map[string]*template.Template{}
+
+func init() {
+	entries, err := templateFS.ReadDir("templates")
+	if err != nil {
+		panic(err)
+	}
+	for _, entry := range entries {
+		files, err := templateFS.ReadDir("templates/" + entry.Name())
+		if err != nil {
+			panic(err)
+		}
+		for _, file := range files {
+			path := "templates/" + entry.Name() + "/" + file.Name()
+			name := strings.TrimSuffix(file.Name(), ".tmpl")
+			templates[entry.Name()+"/"+name] = template.Must(template.ParseFS(templateFS, path))
+		}
+	}
+}
+
+// Render renders the email template in the locale of the user, falling back to the language
+// without its region, e.g. "en" for "en-GB", then to the default locale.
+func Render(name Template, locale string, data *TemplateData) (*Message, error) {
+	candidates := []string{locale}
+	if language, _, ok := strings.Cut(locale, "-"); ok {
+		candidates = append(candidates, language)
+	}
+	candidates = append(candidates, DefaultLocale)
+
+	for _, candidate := range candidates {
+		tmpl, ok := templates[candidate+"/"+string(name)]
+		if !ok {
+			continue
+		}
+		subject, body := &bytes.Buffer{}, &bytes.Buffer{}
+		if err := tmpl.ExecuteTemplate(subject, "subject", data); err != nil {
+			return nil, errors.Wrapf(err, "failed to render subject of %s", name)
+		}
+		if err := tmpl.ExecuteTemplate(body, "body", data); err != nil {
+			return nil, errors.Wrapf(err, "failed to render body of %s", name)
+		}
+		return &Message{
+			Subject: strings.TrimSpace(subject.String()),
+			Body:    strings.TrimSpace(body.String()) + "\n",
+		}, nil
+	}
+	return nil, errors.Errorf("template %s not found", name)
+}
@@ -107,22 +107,83 @@ func (client *Client) UploadFile(ctx context.Context, filename string, fileType
 // If the link does not belong to the configured storage endpoint, it is returned as-is.
 // If the link belongs to the storage, the function generates a pre-signed URL using the AWS S3 client.
 func (client *Client) PreSignLink(ctx context.Context, sourceLink string) (string, error) {
+	filename, ok, err := client.getObjectKey(sourceLink)
+	if err != nil {
+		return "", err
+	}
+	if !ok {
+		return sourceLink, nil
+	}
+
+	req, err := awss3.NewPresignClient(client.Client).PresignGetObject(ctx, &awss3.GetObjectInput{
+		Bucket: aws.String(client.Config.Bucket),
+		Key:    aws.String(filename),
+	}, awss3.WithPresignExpires(LinkLifetime))
+	if err != nil {
+		return "", errors.Wrapf(err, "pre-sign link")
+	}
+	return req.URL, nil
+}
+
+// ReadFile returns the content of the object the link points to.
+// It returns false if the link does not belong to the storage.
+func (client *Client) ReadFile(ctx context.Context, link string) ([]byte, bool, error) {
+	filename, ok, err := client.getObjectKey(link)
+	if err != nil || !ok {
+		return nil, ok, err
+	}
+
+	output, err := client.Client.GetObject(ctx, &awss3.GetObjectInput{
+		Bucket: aws.String(client.Config.Bucket),
+		Key:    aws.String(filename),
+	})
+	if err != nil {
+		return nil, true, errors.Wrapf(err, "get object %q", filename)
+	}
+	defer output.Body.Close()
+	content, err := io.ReadAll(output.Body)
+	if err != nil {
+		return nil, true, errors.Wrapf(err, "read object %q", filename)
+	}
+	return content, true, nil
+}
+
+// DeleteFile deletes the object the link points to.
+// It returns false if the link does not belong to the storage.
+func (client *Client) DeleteFile(ctx context.Context, link string) (bool, error) {
+	filename, ok, err := client.getObjectKey(link)
+	if err != nil || !ok {
+		return ok, err
+	}
+
+	if _, err := client.Client.DeleteObject(ctx, &awss3.DeleteObjectInput{
+		Bucket: aws.String(client.Config.Bucket),
+		Key:    aws.String(filename),
+	}); err != nil {
+		return true, errors.Wrapf(err, "delete object %q", filename)
+	}
+	return true, nil
+}
+
+// getObjectKey returns the key of the object the link points to.
+// It returns false if the link does not belong to the configured storage endpoint.
+func (client *Client) getObjectKey(sourceLink string) (string, bool, error) {
 	u, err := url.Parse(sourceLink)
 	if err != nil {
-		return "", errors.Wrapf(err, "parse URL")
+		return "", false, errors.Wrapf(err, "parse URL")
 	}
 	// if link doesn't belong to storage, then return as-is.
 	// the empty hostname is corner-case for AWS native endpoint.
 	endpointURL, err := url.Parse(client.Config.EndPoint)
 	if err != nil {
-		return "", errors.Wrapf(err, "parse Endpoint URL")
+		return "", false, errors.Wrapf(err, "parse Endpoint URL")
 	}
 	endpointHost := endpointURL.Hostname()
 	if client.Config.Bucket != "" && !strings.Contains(endpointHost, client.Config.Bucket) {
 		endpointHost = fmt.Sprintf("%s.%s", client.Config.Bucket, endpointHost)
 	}
 	if client.Config.EndPoint != "" && !strings.Contains(endpointHost, u.Hostname()) {
-		return sourceLink, nil
+		return "", false, nil
 	}
 
 	filename := u.Path
@@ -136,13 +197,5 @@ func (client *Client) PreSignLink(ctx context.Context, sourceLink string) (strin
 	if strings.HasPrefix(filename, client.Config.Bucket) {
 		filename = strings.Trim(filename[len(client.Config.Bucket):], "/")
 	}
-
-	req, err := awss3.NewPresignClient(client.Client).PresignGetObject(ctx, &awss3.GetObjectInput{
-		Bucket: aws.String(client.Config.Bucket),
-		Key:    aws.String(filename),
-	}, awss3.WithPresignExpires(LinkLifetime))
-	if err != nil {
-		return "", errors.Wrapf(err, "pre-sign link")
-	}
-	return req.URL, nil
+	return filename, true, nil
 }
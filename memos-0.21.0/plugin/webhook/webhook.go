@@ -27,6 +27,7 @@ type Memo struct {
 	Pinned       bool            `json:"pinned"`
 	ResourceList []*Resource     `json:"resourceList"`
 	RelationList []*MemoRelation `json:"relationList"`
+	PropertyList []*MemoProperty `json:"propertyList"`
 }
 
 type Resource struct {
@@ -52,6 +53,12 @@ type MemoRelation struct {
 	Type          string `json:"type"`
 }
 
+type MemoProperty struct {
+	Key   string `json:"key"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
 // WebhookPayload is the payload of webhook request.
 // nolint
 type WebhookPayload struct {
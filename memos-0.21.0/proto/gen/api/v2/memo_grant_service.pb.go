@@ -0,0 +1,230 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.31.0
+// 	protoc        (unknown)
+// source: api/v2/memo_grant_service.proto
+
+package apiv2
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type MemoGrant_Role int32
+
+const (
+	MemoGrant_ROLE_UNSPECIFIED MemoGrant_Role = 0
+	// VIEWER can read the memo.
+	MemoGrant_VIEWER MemoGrant_Role = 1
+	// COMMENTER can read and comment on the memo.
+	MemoGrant_COMMENTER MemoGrant_Role = 2
+	// EDITOR can read, comment on and edit the memo.
+	MemoGrant_EDITOR MemoGrant_Role = 3
+)
+
+// Enum value maps for MemoGrant_Role.
+var (
+	MemoGrant_Role_name = map[int32]string{
+		0: "ROLE_UNSPECIFIED",
+		1: "VIEWER",
+		2: "COMMENTER",
+		3: "EDITOR",
+	}
+	MemoGrant_Role_value = map[string]int32{
+		"ROLE_UNSPECIFIED": 0,
+		"VIEWER":           1,
+		"COMMENTER":        2,
+		"EDITOR":           3,
+	}
+)
+
+func (x MemoGrant_Role) Enum() *MemoGrant_Role {
+	p := new(MemoGrant_Role)
+	*p = x
+	return p
+}
+
+func (x MemoGrant_Role) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MemoGrant_Role) Descriptor() protoreflect.EnumDescriptor {
+	return file_api_v2_memo_grant_service_proto_enumTypes[0].Descriptor()
+}
+
+func (MemoGrant_Role) Type() protoreflect.EnumType {
+	return &file_api_v2_memo_grant_service_proto_enumTypes[0]
+}
+
+func (x MemoGrant_Role) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MemoGrant_Role.Descriptor instead.
+func (MemoGrant_Role) EnumDescriptor() ([]byte, []int) {
+	return file_api_v2_memo_grant_service_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type MemoGrant struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The name of the user or group the memo is shared with.
+	// Format: users/{id} or groups/{id}
+	Grantee string         `protobuf:"bytes,1,opt,name=grantee,proto3" json:"grantee,omitempty"`
+	Role    MemoGrant_Role `protobuf:"varint,2,opt,name=role,proto3,enum=memos.api.v2.MemoGrant_Role" json:"role,omitempty"`
+}
+
+func (x *MemoGrant) Reset() {
+	*x = MemoGrant{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_v2_memo_grant_service_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemoGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemoGrant) ProtoMessage() {}
+
+func (x *MemoGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v2_memo_grant_service_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemoGrant.ProtoReflect.Descriptor instead.
+func (*MemoGrant) Descriptor() ([]byte, []int) {
+	return file_api_v2_memo_grant_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MemoGrant) GetGrantee() string {
+	if x != nil {
+		return x.Grantee
+	}
+	return ""
+}
+
+func (x *MemoGrant) GetRole() MemoGrant_Role {
+	if x != nil {
+		return x.Role
+	}
+	return MemoGrant_ROLE_UNSPECIFIED
+}
+
+var File_api_v2_memo_grant_service_proto protoreflect.FileDescriptor
+
+var file_api_v2_memo_grant_service_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x5f, 0x67, 0x72,
+	0x61, 0x6e, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0c, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x76, 0x32, 0x22,
+	0x9c, 0x01, 0x0a, 0x09, 0x4d, 0x65, 0x6d, 0x6f, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x67, 0x72, 0x61, 0x6e, 0x74, 0x65, 0x65, 0x12, 0x30, 0x0a, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x76, 0x32, 0x2e, 0x4d, 0x65, 0x6d, 0x6f, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x2e, 0x52,
+	0x6f, 0x6c, 0x65, 0x52, 0x04, 0x72, 0x6f, 0x6c, 0x65, 0x22, 0x43, 0x0a, 0x04, 0x52, 0x6f, 0x6c,
+	0x65, 0x12, 0x14, 0x0a, 0x10, 0x52, 0x4f, 0x4c, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43,
+	0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x56, 0x49, 0x45, 0x57, 0x45,
+	0x52, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x4d, 0x4d, 0x45, 0x4e, 0x54, 0x45, 0x52,
+	0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x45, 0x44, 0x49, 0x54, 0x4f, 0x52, 0x10, 0x03, 0x42, 0xad,
+	0x01, 0x0a, 0x10, 0x63, 0x6f, 0x6d, 0x2e, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x76, 0x32, 0x42, 0x15, 0x4d, 0x65, 0x6d, 0x6f, 0x47, 0x72, 0x61, 0x6e, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x30, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x75, 0x73, 0x65, 0x6d, 0x65, 0x6d, 0x6f,
+	0x73, 0x2f, 0x6d, 0x65, 0x6d, 0x6f, 0x73, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x65,
+	0x6e, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x76, 0x32, 0x3b, 0x61, 0x70, 0x69, 0x76, 0x32, 0xa2, 0x02,
+	0x03, 0x4d, 0x41, 0x58, 0xaa, 0x02, 0x0c, 0x4d, 0x65, 0x6d, 0x6f, 0x73, 0x2e, 0x41, 0x70, 0x69,
+	0x2e, 0x56, 0x32, 0xca, 0x02, 0x0c, 0x4d, 0x65, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x70, 0x69, 0x5c,
+	0x56, 0x32, 0xe2, 0x02, 0x18, 0x4d, 0x65, 0x6d, 0x6f, 0x73, 0x5c, 0x41, 0x70, 0x69, 0x5c, 0x56,
+	0x32, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x0e,
+	0x4d, 0x65, 0x6d, 0x6f, 0x73, 0x3a, 0x3a, 0x41, 0x70, 0x69, 0x3a, 0x3a, 0x56, 0x32, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_api_v2_memo_grant_service_proto_rawDescOnce sync.Once
+	file_api_v2_memo_grant_service_proto_rawDescData = file_api_v2_memo_grant_service_proto_rawDesc
+)
+
+func file_api_v2_memo_grant_service_proto_rawDescGZIP() []byte {
+	file_api_v2_memo_grant_service_proto_rawDescOnce.Do(func() {
+		file_api_v2_memo_grant_service_proto_rawDescData = protoimpl.X.CompressGZIP(file_api_v2_memo_grant_service_proto_rawDescData)
+	})
+	return file_api_v2_memo_grant_service_proto_rawDescData
+}
+
+var file_api_v2_memo_grant_service_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_api_v2_memo_grant_service_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_api_v2_memo_grant_service_proto_goTypes = []interface{}{
+	(MemoGrant_Role)(0), // 0: memos.api.v2.MemoGrant.Role
+	(*MemoGrant)(nil),   // 1: memos.api.v2.MemoGrant
+}
+var file_api_v2_memo_grant_service_proto_depIdxs = []int32{
+	0, // 0: memos.api.v2.MemoGrant.role:type_name -> memos.api.v2.MemoGrant.Role
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_v2_memo_grant_service_proto_init() }
+func file_api_v2_memo_grant_service_proto_init() {
+	if File_api_v2_memo_grant_service_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_api_v2_memo_grant_service_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemoGrant); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_api_v2_memo_grant_service_proto_rawDesc,
+			NumEnums:      1,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_api_v2_memo_grant_service_proto_goTypes,
+		DependencyIndexes: file_api_v2_memo_grant_service_proto_depIdxs,
+		EnumInfos:         file_api_v2_memo_grant_service_proto_enumTypes,
+		MessageInfos:      file_api_v2_memo_grant_service_proto_msgTypes,
+	}.Build()
+	File_api_v2_memo_grant_service_proto = out.File
+	file_api_v2_memo_grant_service_proto_rawDesc = nil
+	file_api_v2_memo_grant_service_proto_goTypes = nil
+	file_api_v2_memo_grant_service_proto_depIdxs = nil
+}
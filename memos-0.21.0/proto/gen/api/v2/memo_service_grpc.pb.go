@@ -19,23 +19,31 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	MemoService_CreateMemo_FullMethodName         = "/memos.api.v2.MemoService/CreateMemo"
-	MemoService_ListMemos_FullMethodName          = "/memos.api.v2.MemoService/ListMemos"
-	MemoService_SearchMemos_FullMethodName        = "/memos.api.v2.MemoService/SearchMemos"
-	MemoService_GetMemo_FullMethodName            = "/memos.api.v2.MemoService/GetMemo"
-	MemoService_UpdateMemo_FullMethodName         = "/memos.api.v2.MemoService/UpdateMemo"
-	MemoService_DeleteMemo_FullMethodName         = "/memos.api.v2.MemoService/DeleteMemo"
-	MemoService_ExportMemos_FullMethodName        = "/memos.api.v2.MemoService/ExportMemos"
-	MemoService_SetMemoResources_FullMethodName   = "/memos.api.v2.MemoService/SetMemoResources"
-	MemoService_ListMemoResources_FullMethodName  = "/memos.api.v2.MemoService/ListMemoResources"
-	MemoService_SetMemoRelations_FullMethodName   = "/memos.api.v2.MemoService/SetMemoRelations"
-	MemoService_ListMemoRelations_FullMethodName  = "/memos.api.v2.MemoService/ListMemoRelations"
-	MemoService_CreateMemoComment_FullMethodName  = "/memos.api.v2.MemoService/CreateMemoComment"
-	MemoService_ListMemoComments_FullMethodName   = "/memos.api.v2.MemoService/ListMemoComments"
-	MemoService_GetUserMemosStats_FullMethodName  = "/memos.api.v2.MemoService/GetUserMemosStats"
-	MemoService_ListMemoReactions_FullMethodName  = "/memos.api.v2.MemoService/ListMemoReactions"
-	MemoService_UpsertMemoReaction_FullMethodName = "/memos.api.v2.MemoService/UpsertMemoReaction"
-	MemoService_DeleteMemoReaction_FullMethodName = "/memos.api.v2.MemoService/DeleteMemoReaction"
+	MemoService_CreateMemo_FullMethodName          = "/memos.api.v2.MemoService/CreateMemo"
+	MemoService_ListMemos_FullMethodName           = "/memos.api.v2.MemoService/ListMemos"
+	MemoService_SearchMemos_FullMethodName         = "/memos.api.v2.MemoService/SearchMemos"
+	MemoService_GetMemoGraph_FullMethodName        = "/memos.api.v2.MemoService/GetMemoGraph"
+	MemoService_GetMemo_FullMethodName             = "/memos.api.v2.MemoService/GetMemo"
+	MemoService_UpdateMemo_FullMethodName          = "/memos.api.v2.MemoService/UpdateMemo"
+	MemoService_DeleteMemo_FullMethodName          = "/memos.api.v2.MemoService/DeleteMemo"
+	MemoService_ExportMemos_FullMethodName         = "/memos.api.v2.MemoService/ExportMemos"
+	MemoService_SetMemoResources_FullMethodName    = "/memos.api.v2.MemoService/SetMemoResources"
+	MemoService_ListMemoResources_FullMethodName   = "/memos.api.v2.MemoService/ListMemoResources"
+	MemoService_SetMemoRelations_FullMethodName    = "/memos.api.v2.MemoService/SetMemoRelations"
+	MemoService_ListMemoRelations_FullMethodName   = "/memos.api.v2.MemoService/ListMemoRelations"
+	MemoService_ListMemoBacklinks_FullMethodName   = "/memos.api.v2.MemoService/ListMemoBacklinks"
+	MemoService_SetMemoGrants_FullMethodName       = "/memos.api.v2.MemoService/SetMemoGrants"
+	MemoService_ListMemoGrants_FullMethodName      = "/memos.api.v2.MemoService/ListMemoGrants"
+	MemoService_CreateMemoShareLink_FullMethodName = "/memos.api.v2.MemoService/CreateMemoShareLink"
+	MemoService_ListMemoShareLinks_FullMethodName  = "/memos.api.v2.MemoService/ListMemoShareLinks"
+	MemoService_DeleteMemoShareLink_FullMethodName = "/memos.api.v2.MemoService/DeleteMemoShareLink"
+	MemoService_GetSharedMemo_FullMethodName       = "/memos.api.v2.MemoService/GetSharedMemo"
+	MemoService_CreateMemoComment_FullMethodName   = "/memos.api.v2.MemoService/CreateMemoComment"
+	MemoService_ListMemoComments_FullMethodName    = "/memos.api.v2.MemoService/ListMemoComments"
+	MemoService_GetUserMemosStats_FullMethodName   = "/memos.api.v2.MemoService/GetUserMemosStats"
+	MemoService_ListMemoReactions_FullMethodName   = "/memos.api.v2.MemoService/ListMemoReactions"
+	MemoService_UpsertMemoReaction_FullMethodName  = "/memos.api.v2.MemoService/UpsertMemoReaction"
+	MemoService_DeleteMemoReaction_FullMethodName  = "/memos.api.v2.MemoService/DeleteMemoReaction"
 )
 
 // MemoServiceClient is the client API for MemoService service.
@@ -48,6 +56,8 @@ type MemoServiceClient interface {
 	ListMemos(ctx context.Context, in *ListMemosRequest, opts ...grpc.CallOption) (*ListMemosResponse, error)
 	// SearchMemos searches memos.
 	SearchMemos(ctx context.Context, in *SearchMemosRequest, opts ...grpc.CallOption) (*SearchMemosResponse, error)
+	// GetMemoGraph gets the graph of the memos and their tags, connected by their references, comments and tags.
+	GetMemoGraph(ctx context.Context, in *GetMemoGraphRequest, opts ...grpc.CallOption) (*GetMemoGraphResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*GetMemoResponse, error)
 	// UpdateMemo updates a memo.
@@ -64,6 +74,20 @@ type MemoServiceClient interface {
 	SetMemoRelations(ctx context.Context, in *SetMemoRelationsRequest, opts ...grpc.CallOption) (*SetMemoRelationsResponse, error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(ctx context.Context, in *ListMemoRelationsRequest, opts ...grpc.CallOption) (*ListMemoRelationsResponse, error)
+	// ListMemoBacklinks lists the memos referencing a memo, with the text around their links to it.
+	ListMemoBacklinks(ctx context.Context, in *ListMemoBacklinksRequest, opts ...grpc.CallOption) (*ListMemoBacklinksResponse, error)
+	// SetMemoGrants sets the users and groups a memo is shared with.
+	SetMemoGrants(ctx context.Context, in *SetMemoGrantsRequest, opts ...grpc.CallOption) (*SetMemoGrantsResponse, error)
+	// ListMemoGrants lists the users and groups a memo is shared with.
+	ListMemoGrants(ctx context.Context, in *ListMemoGrantsRequest, opts ...grpc.CallOption) (*ListMemoGrantsResponse, error)
+	// CreateMemoShareLink creates a link to share a memo with anyone who has it.
+	CreateMemoShareLink(ctx context.Context, in *CreateMemoShareLinkRequest, opts ...grpc.CallOption) (*CreateMemoShareLinkResponse, error)
+	// ListMemoShareLinks lists the share links of a memo.
+	ListMemoShareLinks(ctx context.Context, in *ListMemoShareLinksRequest, opts ...grpc.CallOption) (*ListMemoShareLinksResponse, error)
+	// DeleteMemoShareLink revokes a share link.
+	DeleteMemoShareLink(ctx context.Context, in *DeleteMemoShareLinkRequest, opts ...grpc.CallOption) (*DeleteMemoShareLinkResponse, error)
+	// GetSharedMemo gets a memo by the token of a share link.
+	GetSharedMemo(ctx context.Context, in *GetSharedMemoRequest, opts ...grpc.CallOption) (*GetSharedMemoResponse, error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(ctx context.Context, in *CreateMemoCommentRequest, opts ...grpc.CallOption) (*CreateMemoCommentResponse, error)
 	// ListMemoComments lists comments for a memo.
@@ -113,6 +137,15 @@ func (c *memoServiceClient) SearchMemos(ctx context.Context, in *SearchMemosRequ
 	return out, nil
 }
 
+func (c *memoServiceClient) GetMemoGraph(ctx context.Context, in *GetMemoGraphRequest, opts ...grpc.CallOption) (*GetMemoGraphResponse, error) {
+	out := new(GetMemoGraphResponse)
+	err := c.cc.Invoke(ctx, MemoService_GetMemoGraph_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) GetMemo(ctx context.Context, in *GetMemoRequest, opts ...grpc.CallOption) (*GetMemoResponse, error) {
 	out := new(GetMemoResponse)
 	err := c.cc.Invoke(ctx, MemoService_GetMemo_FullMethodName, in, out, opts...)
@@ -185,6 +218,69 @@ func (c *memoServiceClient) ListMemoRelations(ctx context.Context, in *ListMemoR
 	return out, nil
 }
 
+func (c *memoServiceClient) ListMemoBacklinks(ctx context.Context, in *ListMemoBacklinksRequest, opts ...grpc.CallOption) (*ListMemoBacklinksResponse, error) {
+	out := new(ListMemoBacklinksResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoBacklinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) SetMemoGrants(ctx context.Context, in *SetMemoGrantsRequest, opts ...grpc.CallOption) (*SetMemoGrantsResponse, error) {
+	out := new(SetMemoGrantsResponse)
+	err := c.cc.Invoke(ctx, MemoService_SetMemoGrants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoGrants(ctx context.Context, in *ListMemoGrantsRequest, opts ...grpc.CallOption) (*ListMemoGrantsResponse, error) {
+	out := new(ListMemoGrantsResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoGrants_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) CreateMemoShareLink(ctx context.Context, in *CreateMemoShareLinkRequest, opts ...grpc.CallOption) (*CreateMemoShareLinkResponse, error) {
+	out := new(CreateMemoShareLinkResponse)
+	err := c.cc.Invoke(ctx, MemoService_CreateMemoShareLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) ListMemoShareLinks(ctx context.Context, in *ListMemoShareLinksRequest, opts ...grpc.CallOption) (*ListMemoShareLinksResponse, error) {
+	out := new(ListMemoShareLinksResponse)
+	err := c.cc.Invoke(ctx, MemoService_ListMemoShareLinks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) DeleteMemoShareLink(ctx context.Context, in *DeleteMemoShareLinkRequest, opts ...grpc.CallOption) (*DeleteMemoShareLinkResponse, error) {
+	out := new(DeleteMemoShareLinkResponse)
+	err := c.cc.Invoke(ctx, MemoService_DeleteMemoShareLink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *memoServiceClient) GetSharedMemo(ctx context.Context, in *GetSharedMemoRequest, opts ...grpc.CallOption) (*GetSharedMemoResponse, error) {
+	out := new(GetSharedMemoResponse)
+	err := c.cc.Invoke(ctx, MemoService_GetSharedMemo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *memoServiceClient) CreateMemoComment(ctx context.Context, in *CreateMemoCommentRequest, opts ...grpc.CallOption) (*CreateMemoCommentResponse, error) {
 	out := new(CreateMemoCommentResponse)
 	err := c.cc.Invoke(ctx, MemoService_CreateMemoComment_FullMethodName, in, out, opts...)
@@ -249,6 +345,8 @@ type MemoServiceServer interface {
 	ListMemos(context.Context, *ListMemosRequest) (*ListMemosResponse, error)
 	// SearchMemos searches memos.
 	SearchMemos(context.Context, *SearchMemosRequest) (*SearchMemosResponse, error)
+	// GetMemoGraph gets the graph of the memos and their tags, connected by their references, comments and tags.
+	GetMemoGraph(context.Context, *GetMemoGraphRequest) (*GetMemoGraphResponse, error)
 	// GetMemo gets a memo.
 	GetMemo(context.Context, *GetMemoRequest) (*GetMemoResponse, error)
 	// UpdateMemo updates a memo.
@@ -265,6 +363,20 @@ type MemoServiceServer interface {
 	SetMemoRelations(context.Context, *SetMemoRelationsRequest) (*SetMemoRelationsResponse, error)
 	// ListMemoRelations lists relations for a memo.
 	ListMemoRelations(context.Context, *ListMemoRelationsRequest) (*ListMemoRelationsResponse, error)
+	// ListMemoBacklinks lists the memos referencing a memo, with the text around their links to it.
+	ListMemoBacklinks(context.Context, *ListMemoBacklinksRequest) (*ListMemoBacklinksResponse, error)
+	// SetMemoGrants sets the users and groups a memo is shared with.
+	SetMemoGrants(context.Context, *SetMemoGrantsRequest) (*SetMemoGrantsResponse, error)
+	// ListMemoGrants lists the users and groups a memo is shared with.
+	ListMemoGrants(context.Context, *ListMemoGrantsRequest) (*ListMemoGrantsResponse, error)
+	// CreateMemoShareLink creates a link to share a memo with anyone who has it.
+	CreateMemoShareLink(context.Context, *CreateMemoShareLinkRequest) (*CreateMemoShareLinkResponse, error)
+	// ListMemoShareLinks lists the share links of a memo.
+	ListMemoShareLinks(context.Context, *ListMemoShareLinksRequest) (*ListMemoShareLinksResponse, error)
+	// DeleteMemoShareLink revokes a share link.
+	DeleteMemoShareLink(context.Context, *DeleteMemoShareLinkRequest) (*DeleteMemoShareLinkResponse, error)
+	// GetSharedMemo gets a memo by the token of a share link.
+	GetSharedMemo(context.Context, *GetSharedMemoRequest) (*GetSharedMemoResponse, error)
 	// CreateMemoComment creates a comment for a memo.
 	CreateMemoComment(context.Context, *CreateMemoCommentRequest) (*CreateMemoCommentResponse, error)
 	// ListMemoComments lists comments for a memo.
@@ -293,6 +405,9 @@ func (UnimplementedMemoServiceServer) ListMemos(context.Context, *ListMemosReque
 func (UnimplementedMemoServiceServer) SearchMemos(context.Context, *SearchMemosRequest) (*SearchMemosResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchMemos not implemented")
 }
+func (UnimplementedMemoServiceServer) GetMemoGraph(context.Context, *GetMemoGraphRequest) (*GetMemoGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMemoGraph not implemented")
+}
 func (UnimplementedMemoServiceServer) GetMemo(context.Context, *GetMemoRequest) (*GetMemoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMemo not implemented")
 }
@@ -317,6 +432,27 @@ func (UnimplementedMemoServiceServer) SetMemoRelations(context.Context, *SetMemo
 func (UnimplementedMemoServiceServer) ListMemoRelations(context.Context, *ListMemoRelationsRequest) (*ListMemoRelationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListMemoRelations not implemented")
 }
+func (UnimplementedMemoServiceServer) ListMemoBacklinks(context.Context, *ListMemoBacklinksRequest) (*ListMemoBacklinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoBacklinks not implemented")
+}
+func (UnimplementedMemoServiceServer) SetMemoGrants(context.Context, *SetMemoGrantsRequest) (*SetMemoGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMemoGrants not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoGrants(context.Context, *ListMemoGrantsRequest) (*ListMemoGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoGrants not implemented")
+}
+func (UnimplementedMemoServiceServer) CreateMemoShareLink(context.Context, *CreateMemoShareLinkRequest) (*CreateMemoShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMemoShareLink not implemented")
+}
+func (UnimplementedMemoServiceServer) ListMemoShareLinks(context.Context, *ListMemoShareLinksRequest) (*ListMemoShareLinksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListMemoShareLinks not implemented")
+}
+func (UnimplementedMemoServiceServer) DeleteMemoShareLink(context.Context, *DeleteMemoShareLinkRequest) (*DeleteMemoShareLinkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMemoShareLink not implemented")
+}
+func (UnimplementedMemoServiceServer) GetSharedMemo(context.Context, *GetSharedMemoRequest) (*GetSharedMemoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSharedMemo not implemented")
+}
 func (UnimplementedMemoServiceServer) CreateMemoComment(context.Context, *CreateMemoCommentRequest) (*CreateMemoCommentResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMemoComment not implemented")
 }
@@ -402,6 +538,24 @@ func _MemoService_SearchMemos_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_GetMemoGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMemoGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetMemoGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetMemoGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetMemoGraph(ctx, req.(*GetMemoGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_GetMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMemoRequest)
 	if err := dec(in); err != nil {
@@ -546,6 +700,132 @@ func _MemoService_ListMemoRelations_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MemoService_ListMemoBacklinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoBacklinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoBacklinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoBacklinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoBacklinks(ctx, req.(*ListMemoBacklinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_SetMemoGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMemoGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).SetMemoGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_SetMemoGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).SetMemoGrants(ctx, req.(*SetMemoGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoGrants(ctx, req.(*ListMemoGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_CreateMemoShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMemoShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).CreateMemoShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_CreateMemoShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).CreateMemoShareLink(ctx, req.(*CreateMemoShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_ListMemoShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListMemoShareLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).ListMemoShareLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_ListMemoShareLinks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).ListMemoShareLinks(ctx, req.(*ListMemoShareLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_DeleteMemoShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMemoShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).DeleteMemoShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_DeleteMemoShareLink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).DeleteMemoShareLink(ctx, req.(*DeleteMemoShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MemoService_GetSharedMemo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSharedMemoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MemoServiceServer).GetSharedMemo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MemoService_GetSharedMemo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MemoServiceServer).GetSharedMemo(ctx, req.(*GetSharedMemoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MemoService_CreateMemoComment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateMemoCommentRequest)
 	if err := dec(in); err != nil {
@@ -673,6 +953,10 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchMemos",
 			Handler:    _MemoService_SearchMemos_Handler,
 		},
+		{
+			MethodName: "GetMemoGraph",
+			Handler:    _MemoService_GetMemoGraph_Handler,
+		},
 		{
 			MethodName: "GetMemo",
 			Handler:    _MemoService_GetMemo_Handler,
@@ -705,6 +989,34 @@ var MemoService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListMemoRelations",
 			Handler:    _MemoService_ListMemoRelations_Handler,
 		},
+		{
+			MethodName: "ListMemoBacklinks",
+			Handler:    _MemoService_ListMemoBacklinks_Handler,
+		},
+		{
+			MethodName: "SetMemoGrants",
+			Handler:    _MemoService_SetMemoGrants_Handler,
+		},
+		{
+			MethodName: "ListMemoGrants",
+			Handler:    _MemoService_ListMemoGrants_Handler,
+		},
+		{
+			MethodName: "CreateMemoShareLink",
+			Handler:    _MemoService_CreateMemoShareLink_Handler,
+		},
+		{
+			MethodName: "ListMemoShareLinks",
+			Handler:    _MemoService_ListMemoShareLinks_Handler,
+		},
+		{
+			MethodName: "DeleteMemoShareLink",
+			Handler:    _MemoService_DeleteMemoShareLink_Handler,
+		},
+		{
+			MethodName: "GetSharedMemo",
+			Handler:    _MemoService_GetSharedMemo_Handler,
+		},
 		{
 			MethodName: "CreateMemoComment",
 			Handler:    _MemoService_CreateMemoComment_Handler,
@@ -22,7 +22,9 @@ const (
 	TagService_UpsertTag_FullMethodName         = "/memos.api.v2.TagService/UpsertTag"
 	TagService_BatchUpsertTag_FullMethodName    = "/memos.api.v2.TagService/BatchUpsertTag"
 	TagService_ListTags_FullMethodName          = "/memos.api.v2.TagService/ListTags"
+	TagService_UpdateTag_FullMethodName         = "/memos.api.v2.TagService/UpdateTag"
 	TagService_RenameTag_FullMethodName         = "/memos.api.v2.TagService/RenameTag"
+	TagService_MergeTags_FullMethodName         = "/memos.api.v2.TagService/MergeTags"
 	TagService_DeleteTag_FullMethodName         = "/memos.api.v2.TagService/DeleteTag"
 	TagService_GetTagSuggestions_FullMethodName = "/memos.api.v2.TagService/GetTagSuggestions"
 )
@@ -37,9 +39,14 @@ type TagServiceClient interface {
 	BatchUpsertTag(ctx context.Context, in *BatchUpsertTagRequest, opts ...grpc.CallOption) (*BatchUpsertTagResponse, error)
 	// ListTags lists tags.
 	ListTags(ctx context.Context, in *ListTagsRequest, opts ...grpc.CallOption) (*ListTagsResponse, error)
-	// RenameTag renames a tag.
+	// UpdateTag updates the metadata of a tag of the current user.
+	UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error)
+	// RenameTag renames a tag and its child tags.
 	// All related memos will be updated.
 	RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error)
+	// MergeTags merges tags and their child tags into a tag.
+	// All related memos will be updated.
+	MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error)
 	// DeleteTag deletes a tag.
 	DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error)
 	// GetTagSuggestions gets tag suggestions from the user's memos.
@@ -81,6 +88,15 @@ func (c *tagServiceClient) ListTags(ctx context.Context, in *ListTagsRequest, op
 	return out, nil
 }
 
+func (c *tagServiceClient) UpdateTag(ctx context.Context, in *UpdateTagRequest, opts ...grpc.CallOption) (*UpdateTagResponse, error) {
+	out := new(UpdateTagResponse)
+	err := c.cc.Invoke(ctx, TagService_UpdateTag_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tagServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest, opts ...grpc.CallOption) (*RenameTagResponse, error) {
 	out := new(RenameTagResponse)
 	err := c.cc.Invoke(ctx, TagService_RenameTag_FullMethodName, in, out, opts...)
@@ -90,6 +106,15 @@ func (c *tagServiceClient) RenameTag(ctx context.Context, in *RenameTagRequest,
 	return out, nil
 }
 
+func (c *tagServiceClient) MergeTags(ctx context.Context, in *MergeTagsRequest, opts ...grpc.CallOption) (*MergeTagsResponse, error) {
+	out := new(MergeTagsResponse)
+	err := c.cc.Invoke(ctx, TagService_MergeTags_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *tagServiceClient) DeleteTag(ctx context.Context, in *DeleteTagRequest, opts ...grpc.CallOption) (*DeleteTagResponse, error) {
 	out := new(DeleteTagResponse)
 	err := c.cc.Invoke(ctx, TagService_DeleteTag_FullMethodName, in, out, opts...)
@@ -118,9 +143,14 @@ type TagServiceServer interface {
 	BatchUpsertTag(context.Context, *BatchUpsertTagRequest) (*BatchUpsertTagResponse, error)
 	// ListTags lists tags.
 	ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error)
-	// RenameTag renames a tag.
+	// UpdateTag updates the metadata of a tag of the current user.
+	UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error)
+	// RenameTag renames a tag and its child tags.
 	// All related memos will be updated.
 	RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error)
+	// MergeTags merges tags and their child tags into a tag.
+	// All related memos will be updated.
+	MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error)
 	// DeleteTag deletes a tag.
 	DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error)
 	// GetTagSuggestions gets tag suggestions from the user's memos.
@@ -141,9 +171,15 @@ func (UnimplementedTagServiceServer) BatchUpsertTag(context.Context, *BatchUpser
 func (UnimplementedTagServiceServer) ListTags(context.Context, *ListTagsRequest) (*ListTagsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListTags not implemented")
 }
+func (UnimplementedTagServiceServer) UpdateTag(context.Context, *UpdateTagRequest) (*UpdateTagResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTag not implemented")
+}
 func (UnimplementedTagServiceServer) RenameTag(context.Context, *RenameTagRequest) (*RenameTagResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RenameTag not implemented")
 }
+func (UnimplementedTagServiceServer) MergeTags(context.Context, *MergeTagsRequest) (*MergeTagsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeTags not implemented")
+}
 func (UnimplementedTagServiceServer) DeleteTag(context.Context, *DeleteTagRequest) (*DeleteTagResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteTag not implemented")
 }
@@ -217,6 +253,24 @@ func _TagService_ListTags_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TagService_UpdateTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTagRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).UpdateTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_UpdateTag_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).UpdateTag(ctx, req.(*UpdateTagRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TagService_RenameTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RenameTagRequest)
 	if err := dec(in); err != nil {
@@ -235,6 +289,24 @@ func _TagService_RenameTag_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _TagService_MergeTags_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeTagsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TagServiceServer).MergeTags(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TagService_MergeTags_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TagServiceServer).MergeTags(ctx, req.(*MergeTagsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _TagService_DeleteTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteTagRequest)
 	if err := dec(in); err != nil {
@@ -290,10 +362,18 @@ var TagService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListTags",
 			Handler:    _TagService_ListTags_Handler,
 		},
+		{
+			MethodName: "UpdateTag",
+			Handler:    _TagService_UpdateTag_Handler,
+		},
 		{
 			MethodName: "RenameTag",
 			Handler:    _TagService_RenameTag_Handler,
 		},
+		{
+			MethodName: "MergeTags",
+			Handler:    _TagService_MergeTags_Handler,
+		},
 		{
 			MethodName: "DeleteTag",
 			Handler:    _TagService_DeleteTag_Handler,
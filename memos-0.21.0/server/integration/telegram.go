@@ -6,22 +6,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
-	"slices"
 	"strconv"
 	"time"
 	"unicode/utf16"
 
 	"github.com/lithammer/shortuuid/v4"
 	"github.com/pkg/errors"
-	"github.com/yourselfhosted/gomark/ast"
-	"github.com/yourselfhosted/gomark/parser"
-	"github.com/yourselfhosted/gomark/parser/tokenizer"
 
 	"github.com/usememos/memos/plugin/telegram"
 	"github.com/usememos/memos/plugin/webhook"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	apiv1 "github.com/usememos/memos/server/route/api/v1"
-	apiv2 "github.com/usememos/memos/server/route/api/v2"
 	"github.com/usememos/memos/store"
 )
 
@@ -94,20 +89,7 @@ func (t *TelegramHandler) MessageHandle(ctx context.Context, bot *telegram.Bot,
 	}
 
 	// Dynamically upsert tags from memo content.
-	nodes, err := parser.Parse(tokenizer.Tokenize(create.Content))
-	if err != nil {
-		return errors.Wrap(err, "Failed to parse content")
-	}
-	tags := []string{}
-	apiv2.TraverseASTNodes(nodes, func(node ast.Node) {
-		if tagNode, ok := node.(*ast.Tag); ok {
-			tag := tagNode.Content
-			if !slices.Contains(tags, tag) {
-				tags = append(tags, tag)
-			}
-		}
-	})
-	for _, tag := range tags {
+	for _, tag := range store.ExtractMemoTags(create.Content) {
 		_, err := t.store.UpsertTag(ctx, &store.Tag{
 			Name:      tag,
 			CreatorID: creatorID,
@@ -284,8 +266,10 @@ func convertToMarkdown(text string, messageEntities []telegram.MessageEntity) st
 }
 
 func (t *TelegramHandler) dispatchMemoRelatedWebhook(ctx context.Context, memo store.Memo, activityType string) error {
+	personalGroupID := int32(0)
 	webhooks, err := t.store.ListWebhooks(ctx, &store.FindWebhook{
 		CreatorID: &memo.CreatorID,
+		GroupID:   &personalGroupID,
 	})
 	if err != nil {
 		return err
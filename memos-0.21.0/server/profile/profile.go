@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"runtime"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
@@ -34,6 +35,33 @@ type Profile struct {
 	Frontend bool `json:"-"`
 	// Origins is the list of allowed origins
 	Origins []string `json:"-"`
+	// RateLimit is the number of requests per second allowed for each client IP, user and access token, 0 disables it
+	RateLimit float64 `json:"-" mapstructure:"rate-limit"`
+	// RateLimitBurst is the number of requests allowed to exceed the rate limit at once
+	RateLimitBurst int `json:"-" mapstructure:"rate-limit-burst"`
+	// LoginMaxAttempts is the number of consecutive failed sign-ins before the client IP or username is locked out, 0 disables it
+	LoginMaxAttempts int `json:"-" mapstructure:"login-max-attempts"`
+	// LoginLockoutDuration is how long a client IP or username stays locked out
+	LoginLockoutDuration time.Duration `json:"-" mapstructure:"login-lockout-duration"`
+	// TrustedProxies is the list of IPs or CIDRs of the reverse proxies whose X-Forwarded-For header gives the client IP,
+	// which the rate limits and the sign-in lockouts are keyed by
+	TrustedProxies []string `json:"-" mapstructure:"trusted-proxies"`
+	// AuthProxyHeader is the header carrying the username authenticated by a reverse proxy, empty disables it
+	AuthProxyHeader string `json:"-" mapstructure:"auth-proxy-header"`
+	// AuthProxyTrustedProxies is the list of IPs or CIDRs of the proxies allowed to set the auth proxy header
+	AuthProxyTrustedProxies []string `json:"-" mapstructure:"auth-proxy-trusted-proxies"`
+	// AuthProxyAutoCreate is whether to create the users authenticated by the proxy who do not exist yet
+	AuthProxyAutoCreate bool `json:"-" mapstructure:"auth-proxy-auto-create"`
+	// AuditLogFile is the file the audit logs are appended to as JSON lines, empty disables it
+	AuditLogFile string `json:"-" mapstructure:"audit-log-file"`
+	// MasterKey is the key encrypting the secrets stored in the database, empty stores them in plaintext
+	MasterKey string `json:"-" mapstructure:"master-key"`
+	// MasterKeyFile is the file to read the master key from, it takes precedence over MasterKey
+	MasterKeyFile string `json:"-" mapstructure:"master-key-file"`
+	// PreviousMasterKey is the master key being rotated, used to decrypt the secrets not re-encrypted with MasterKey yet
+	PreviousMasterKey string `json:"-" mapstructure:"previous-master-key"`
+	// PreviousMasterKeyFile is the file to read the previous master key from, it takes precedence over PreviousMasterKey
+	PreviousMasterKeyFile string `json:"-" mapstructure:"previous-master-key-file"`
 }
 
 func (p *Profile) IsDev() bool {
@@ -93,6 +121,20 @@ func GetProfile() (*Profile, error) {
 	}
 
 	profile.Data = dataDir
+	if profile.MasterKeyFile != "" {
+		masterKey, err := ReadKeyFile(profile.MasterKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		profile.MasterKey = masterKey
+	}
+	if profile.PreviousMasterKeyFile != "" {
+		previousMasterKey, err := ReadKeyFile(profile.PreviousMasterKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		profile.PreviousMasterKey = previousMasterKey
+	}
 	if profile.Driver == "sqlite" && profile.DSN == "" {
 		dbFile := fmt.Sprintf("memos_%s.db", profile.Mode)
 		profile.DSN = filepath.Join(dataDir, dbFile)
@@ -101,3 +143,16 @@ func GetProfile() (*Profile, error) {
 
 	return &profile, nil
 }
+
+// ReadKeyFile returns the key stored in the file, ignoring the surrounding whitespaces.
+func ReadKeyFile(path string) (string, error) {
+	bytes, err := os.ReadFile(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "unable to read key file %s", path)
+	}
+	key := strings.TrimSpace(string(bytes))
+	if key == "" {
+		return "", errors.Errorf("key file %s is empty", path)
+	}
+	return key, nil
+}
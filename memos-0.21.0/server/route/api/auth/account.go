@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// IsSuspended returns true if the user is suspended at the time.
+func IsSuspended(suspension *storepb.SuspensionUserSetting, now time.Time) bool {
+	if suspension.GetSuspendedTs() == 0 {
+		return false
+	}
+	return suspension.GetExpiresTs() == 0 || now.Unix() < suspension.GetExpiresTs()
+}
+
+// FormatSuspension returns the message telling the user about the suspension.
+func FormatSuspension(suspension *storepb.SuspensionUserSetting) string {
+	message := "user is suspended"
+	if suspension.GetExpiresTs() != 0 {
+		message += " until " + time.Unix(suspension.GetExpiresTs(), 0).UTC().Format(time.RFC3339)
+	}
+	if suspension.GetReason() != "" {
+		message += fmt.Sprintf(": %s", suspension.GetReason())
+	}
+	return message
+}
+
+// GetActiveSuspension returns the suspension of the user if the user is suspended now, or nil.
+func GetActiveSuspension(ctx context.Context, s *store.Store, userID int32) (*storepb.SuspensionUserSetting, error) {
+	suspension, err := s.GetUserSuspension(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if !IsSuspended(suspension, time.Now()) {
+		return nil, nil
+	}
+	return suspension, nil
+}
+
+// CheckPasswordChangeRequired returns true if the user has to change the password before signing in,
+// because an admin required it or the password expired under the password policy of the workspace.
+func CheckPasswordChangeRequired(ctx context.Context, s *store.Store, user *store.User) (bool, error) {
+	policy, err := s.GetWorkspacePasswordPolicySetting(ctx)
+	if err != nil {
+		return false, err
+	}
+	password, err := s.GetUserPassword(ctx, user.ID)
+	if err != nil {
+		return false, err
+	}
+	return IsPasswordChangeRequired(policy, password, user.CreatedTs, time.Now()), nil
+}
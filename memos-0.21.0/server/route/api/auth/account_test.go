@@ -0,0 +1,40 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestIsSuspended(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	if IsSuspended(&storepb.SuspensionUserSetting{}, now) {
+		t.Errorf("expected an empty suspension not to suspend the user")
+	}
+	if !IsSuspended(&storepb.SuspensionUserSetting{SuspendedTs: now.Unix() - 1}, now) {
+		t.Errorf("expected a suspension without expiry to suspend the user")
+	}
+	if !IsSuspended(&storepb.SuspensionUserSetting{SuspendedTs: now.Unix() - 1, ExpiresTs: now.Unix() + 1}, now) {
+		t.Errorf("expected a suspension to suspend the user until it expires")
+	}
+	if IsSuspended(&storepb.SuspensionUserSetting{SuspendedTs: now.Unix() - 2, ExpiresTs: now.Unix()}, now) {
+		t.Errorf("expected an expired suspension not to suspend the user")
+	}
+}
+
+func TestFormatSuspension(t *testing.T) {
+	tests := []struct {
+		suspension *storepb.SuspensionUserSetting
+		want       string
+	}{
+		{suspension: &storepb.SuspensionUserSetting{SuspendedTs: 1}, want: "user is suspended"},
+		{suspension: &storepb.SuspensionUserSetting{SuspendedTs: 1, Reason: "spam"}, want: "user is suspended: spam"},
+		{suspension: &storepb.SuspensionUserSetting{SuspendedTs: 1, ExpiresTs: 1700000000, Reason: "spam"}, want: "user is suspended until 2023-11-14T22:13:20Z: spam"},
+	}
+	for _, test := range tests {
+		if got := FormatSuspension(test.suspension); got != test.want {
+			t.Errorf("FormatSuspension() = %q, want %q", got, test.want)
+		}
+	}
+}
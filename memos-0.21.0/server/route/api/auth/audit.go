@@ -0,0 +1,81 @@
+package auth
+
+import (
+	"encoding/json"
+	"strings"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// RedactedValue replaces the secrets in the API responses and the audit log snapshots.
+// A secret updated to it is left unchanged.
+const RedactedValue = "******"
+
+// NewAuditSnapshot returns the JSON snapshot of the value to be saved in the audit log,
+// with the secrets like passwords, tokens and keys redacted.
+// It returns an empty string for nil values or values that cannot be marshaled.
+func NewAuditSnapshot(value any) string {
+	if value == nil {
+		return ""
+	}
+	var bytes []byte
+	var err error
+	if message, ok := value.(proto.Message); ok {
+		bytes, err = protojson.Marshal(message)
+	} else {
+		bytes, err = json.Marshal(value)
+	}
+	if err != nil {
+		return ""
+	}
+
+	var snapshot any
+	if err := json.Unmarshal(bytes, &snapshot); err != nil || snapshot == nil {
+		return ""
+	}
+	bytes, err = json.Marshal(redactSecrets(snapshot))
+	if err != nil {
+		return ""
+	}
+	return string(bytes)
+}
+
+func redactSecrets(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		for key, field := range v {
+			if isSecretField(key) {
+				if s, ok := field.(string); ok && s != "" {
+					v[key] = RedactedValue
+				}
+				continue
+			}
+			v[key] = redactSecrets(field)
+		}
+		// Settings stored as name and value pairs, e.g. the "telegram-bot-token" system setting.
+		if name, ok := v["name"].(string); ok && isSecretField(name) {
+			if s, ok := v["value"].(string); ok && s != "" {
+				v["value"] = RedactedValue
+			}
+		}
+	case []any:
+		for i, item := range v {
+			v[i] = redactSecrets(item)
+		}
+	}
+	return value
+}
+
+func isSecretField(name string) bool {
+	name = strings.NewReplacer("_", "", "-", "").Replace(strings.ToLower(name))
+	if strings.HasSuffix(name, "url") {
+		return false
+	}
+	for _, keyword := range []string{"secret", "password", "token"} {
+		if strings.Contains(name, keyword) {
+			return true
+		}
+	}
+	return false
+}
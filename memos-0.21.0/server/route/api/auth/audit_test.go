@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"testing"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestNewAuditSnapshot(t *testing.T) {
+	tests := []struct {
+		value any
+		want  string
+	}{
+		{
+			value: nil,
+			want:  "",
+		},
+		{
+			value: map[string]any{"username": "steven", "password": "secret", "email": ""},
+			want:  `{"email":"","password":"******","username":"steven"}`,
+		},
+		{
+			value: map[string]any{"name": "telegram-bot-token", "value": "123:abc"},
+			want:  `{"name":"telegram-bot-token","value":"******"}`,
+		},
+		{
+			value: map[string]any{"tokenUrl": "https://example.com/token", "clientSecret": "secret"},
+			want:  `{"clientSecret":"******","tokenUrl":"https://example.com/token"}`,
+		},
+		{
+			value: &storepb.IdentityProviderConfig_OAuth2{
+				ClientId:     "id",
+				ClientSecret: "secret",
+			},
+			want: `{"clientId":"id","clientSecret":"******"}`,
+		},
+	}
+	for _, test := range tests {
+		if got := NewAuditSnapshot(test.value); got != test.want {
+			t.Errorf("NewAuditSnapshot(%v) = %s, want %s", test.value, got, test.want)
+		}
+	}
+}
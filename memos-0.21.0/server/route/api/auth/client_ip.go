@@ -0,0 +1,87 @@
+package auth
+
+import (
+	"net"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// TrustedProxies are the networks of the reverse proxies trusted to set headers about the clients,
+// such as the X-Forwarded-For header.
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses the IPs and CIDRs of the trusted proxies.
+func ParseTrustedProxies(cidrs []string) (TrustedProxies, error) {
+	trustedProxies := TrustedProxies{}
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+		if !strings.Contains(cidr, "/") {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, errors.Errorf("invalid trusted proxy %q", cidr)
+			}
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			trustedProxies = append(trustedProxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid trusted proxy %q", cidr)
+		}
+		trustedProxies = append(trustedProxies, ipNet)
+	}
+	return trustedProxies, nil
+}
+
+// Contains returns true if the address, with or without a port, belongs to a trusted proxy.
+func (p TrustedProxies) Contains(addr string) bool {
+	ip := net.ParseIP(getHost(addr))
+	if ip == nil {
+		return false
+	}
+	for _, ipNet := range p {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP returns the IP of the client of a request sent from the remote address with the X-Forwarded-For headers.
+// Clients can set the headers to anything, so they are only read when the request comes from a trusted proxy,
+// and then from the right, where each proxy appends the address it got the request from, to the first untrusted address.
+func (p TrustedProxies) ClientIP(remoteAddr string, forwardedFor []string) string {
+	clientIP := getHost(remoteAddr)
+	if !p.Contains(clientIP) {
+		return clientIP
+	}
+	ips := []string{}
+	for _, header := range forwardedFor {
+		for _, ip := range strings.Split(header, ",") {
+			ips = append(ips, strings.TrimSpace(ip))
+		}
+	}
+	for i := len(ips) - 1; i >= 0; i-- {
+		if net.ParseIP(ips[i]) == nil {
+			break
+		}
+		clientIP = ips[i]
+		if !p.Contains(clientIP) {
+			break
+		}
+	}
+	return clientIP
+}
+
+// getHost returns the host of the address without its port.
+func getHost(addr string) string {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		host = addr
+	}
+	return strings.TrimSpace(host)
+}
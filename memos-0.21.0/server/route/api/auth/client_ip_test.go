@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/usememos/memos/internal/ratelimit"
+)
+
+func TestClientIP(t *testing.T) {
+	trustedProxies, err := ParseTrustedProxies([]string{"10.0.0.1", "192.168.0.0/16"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		remoteAddr   string
+		forwardedFor []string
+		want         string
+	}{
+		{remoteAddr: "203.0.113.7:1234", want: "203.0.113.7"},
+		// The header of a client is not trusted.
+		{remoteAddr: "203.0.113.7:1234", forwardedFor: []string{"198.51.100.1"}, want: "203.0.113.7"},
+		{remoteAddr: "10.0.0.1:1234", forwardedFor: []string{"198.51.100.1"}, want: "198.51.100.1"},
+		// The addresses a client prepended are skipped, up to the one the trusted proxies got the request from.
+		{remoteAddr: "10.0.0.1:1234", forwardedFor: []string{"198.51.100.1, 203.0.113.7", "192.168.1.1"}, want: "203.0.113.7"},
+		{remoteAddr: "10.0.0.1:1234", forwardedFor: []string{"not-an-ip, 203.0.113.7"}, want: "203.0.113.7"},
+		{remoteAddr: "10.0.0.1:1234", want: "10.0.0.1"},
+	}
+	for _, test := range tests {
+		if got := trustedProxies.ClientIP(test.remoteAddr, test.forwardedFor); got != test.want {
+			t.Errorf("ClientIP(%q, %q) = %q, want %q", test.remoteAddr, test.forwardedFor, got, test.want)
+		}
+	}
+}
+
+func TestClientIPSpoofedHeader(t *testing.T) {
+	trustedProxies, err := ParseTrustedProxies([]string{"10.0.0.1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	limiter := ratelimit.NewLimiter(0.001, 2)
+	// A client sending another X-Forwarded-For header with each request still uses up the bucket of its own IP.
+	for i := 0; i < 3; i++ {
+		ip := trustedProxies.ClientIP("203.0.113.7:1234", []string{fmt.Sprintf("198.51.100.%d", i)})
+		if allowed := limiter.Allow("ip:" + ip); allowed != (i < 2) {
+			t.Errorf("request %d with a spoofed header allowed = %v, want %v", i, allowed, i < 2)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/internal/util"
+)
+
+// EmailTokenPurpose is what the token sent by email can be used for, which is its audience.
+type EmailTokenPurpose string
+
+const (
+	// EmailTokenPasswordReset resets the password of the user.
+	EmailTokenPasswordReset EmailTokenPurpose = "user.password-reset"
+	// EmailTokenEmailVerification verifies the email of a new user.
+	EmailTokenEmailVerification EmailTokenPurpose = "user.email-verification"
+	// EmailTokenEmailChange changes the email of the user to the email of the token.
+	EmailTokenEmailChange EmailTokenPurpose = "user.email-change"
+
+	PasswordResetTokenDuration     = 1 * time.Hour
+	EmailVerificationTokenDuration = 24 * time.Hour
+	EmailChangeTokenDuration       = 24 * time.Hour
+)
+
+// EmailTokenClaims are the claims of a token sent by email.
+type EmailTokenClaims struct {
+	// Email is the email the token was sent to.
+	Email string `json:"email"`
+	// State is the digest of the state of the user the token was issued for, e.g. the password hash
+	// for a password reset. The token can only be used once since using it changes the state.
+	State string `json:"state"`
+	jwt.RegisteredClaims
+}
+
+// UserID returns the id of the user the token was issued for.
+func (c *EmailTokenClaims) UserID() (int32, error) {
+	userID, err := util.ConvertStringToInt32(c.Subject)
+	if err != nil {
+		return 0, errors.Wrap(err, "malformed user id in the token")
+	}
+	return userID, nil
+}
+
+// MatchState returns true if the state of the user has not changed since the token was issued.
+func (c *EmailTokenClaims) MatchState(state string, secret []byte) bool {
+	return hmac.Equal([]byte(c.State), []byte(digestEmailTokenState(state, secret)))
+}
+
+// GenerateEmailToken generates the token sent by email to the user, bound to the current state of the user.
+func GenerateEmailToken(purpose EmailTokenPurpose, userID int32, email, state string, expirationTime time.Time, secret []byte) (string, error) {
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &EmailTokenClaims{
+		Email: email,
+		State: digestEmailTokenState(state, secret),
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    Issuer,
+			Audience:  jwt.ClaimStrings{string(purpose)},
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expirationTime),
+			Subject:   fmt.Sprint(userID),
+		},
+	})
+	token.Header["kid"] = KeyID
+	return token.SignedString(emailTokenSigningKey(secret))
+}
+
+// ParseEmailToken parses the token sent by email, checking its signature, purpose and expiration.
+func ParseEmailToken(tokenString string, purpose EmailTokenPurpose, secret []byte) (*EmailTokenClaims, error) {
+	claims := &EmailTokenClaims{}
+	if _, err := jwt.ParseWithClaims(tokenString, claims, func(t *jwt.Token) (any, error) {
+		if kid, ok := t.Header["kid"].(string); !ok || kid != KeyID {
+			return nil, errors.Errorf("unexpected token kid=%v", t.Header["kid"])
+		}
+		return emailTokenSigningKey(secret), nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Name}),
+		jwt.WithIssuer(Issuer),
+		jwt.WithAudience(string(purpose)),
+		jwt.WithExpirationRequired(),
+	); err != nil {
+		return nil, errors.Wrap(err, "invalid or expired token")
+	}
+	return claims, nil
+}
+
+// emailTokenSigningKey derives the key signing the tokens sent by email from the secret,
+// so that they can never be mistaken for access tokens.
+func emailTokenSigningKey(secret []byte) []byte {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte("email-token"))
+	return mac.Sum(nil)
+}
+
+func digestEmailTokenState(state string, secret []byte) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(state))
+	return hex.EncodeToString(mac.Sum(nil))
+}
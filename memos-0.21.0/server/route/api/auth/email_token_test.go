@@ -0,0 +1,49 @@
+package auth
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEmailToken(t *testing.T) {
+	secret := []byte("secret")
+	token, err := GenerateEmailToken(EmailTokenPasswordReset, 1, "steven@example.com", "password hash", time.Now().Add(time.Hour), secret)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	claims, err := ParseEmailToken(token, EmailTokenPasswordReset, secret)
+	if err != nil {
+		t.Fatalf("failed to parse token: %v", err)
+	}
+	if userID, err := claims.UserID(); err != nil || userID != 1 {
+		t.Errorf("got user id %d, error %v, want 1", userID, err)
+	}
+	if !claims.MatchState("password hash", secret) {
+		t.Errorf("expected the token to match the state it was issued for")
+	}
+	if claims.MatchState("new password hash", secret) {
+		t.Errorf("expected the token not to match once the state changed")
+	}
+
+	if _, err := ParseEmailToken(token, EmailTokenEmailChange, secret); err == nil {
+		t.Errorf("expected the token not to be usable for another purpose")
+	}
+	if _, err := ParseEmailToken(token, EmailTokenPasswordReset, []byte("other secret")); err == nil {
+		t.Errorf("expected the token not to be valid with another secret")
+	}
+	accessToken, err := GenerateAccessToken("steven", 1, time.Now().Add(time.Hour), secret)
+	if err != nil {
+		t.Fatalf("failed to generate access token: %v", err)
+	}
+	if _, err := ParseEmailToken(accessToken, EmailTokenPasswordReset, secret); err == nil {
+		t.Errorf("expected an access token not to be usable as an email token")
+	}
+
+	expired, err := GenerateEmailToken(EmailTokenPasswordReset, 1, "steven@example.com", "password hash", time.Now().Add(-time.Minute), secret)
+	if err != nil {
+		t.Fatalf("failed to generate token: %v", err)
+	}
+	if _, err := ParseEmailToken(expired, EmailTokenPasswordReset, secret); err == nil {
+		t.Errorf("expected an expired token to be rejected")
+	}
+}
@@ -0,0 +1,124 @@
+package auth
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+// MemoAccess is the level of access of a user to a memo, each level including the ones below it.
+type MemoAccess int
+
+const (
+	// MemoAccessNone does not allow anything.
+	MemoAccessNone MemoAccess = iota
+	// MemoAccessView allows reading the memo and its resources.
+	MemoAccessView
+	// MemoAccessComment allows commenting on the memo.
+	MemoAccessComment
+	// MemoAccessEdit allows changing the content and resources of the memo.
+	MemoAccessEdit
+)
+
+// GetMemoAccess returns the access of the user, nil for anonymous, to the memo.
+// The access comes from the visibility of the memo, the membership of its group space,
+// and the grants to the user or to the groups the user is a member of.
+func GetMemoAccess(ctx context.Context, s *store.Store, user *store.User, memo *store.Memo) (MemoAccess, error) {
+	if user == nil {
+		if memo.Visibility == store.Public {
+			return MemoAccessView, nil
+		}
+		return MemoAccessNone, nil
+	}
+	if memo.CreatorID == user.ID {
+		return MemoAccessEdit, nil
+	}
+
+	access := MemoAccessNone
+	if memo.Visibility == store.Public || memo.Visibility == store.Protected {
+		access = MemoAccessComment
+	}
+	if memo.GroupID != 0 {
+		member, err := s.GetUserGroupMember(ctx, &store.FindUserGroupMember{
+			GroupID: &memo.GroupID,
+			UserID:  &user.ID,
+		})
+		if err != nil {
+			return MemoAccessNone, errors.Wrap(err, "failed to get user group member")
+		}
+		// Members comment on the memos of the group space, and its admins moderate them.
+		if member != nil && member.Role == store.UserGroupMemberAdmin {
+			access = MemoAccessEdit
+		} else if member != nil && access < MemoAccessComment {
+			access = MemoAccessComment
+		}
+	}
+
+	grants, err := s.ListMemoGrants(ctx, &store.FindMemoGrant{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return MemoAccessNone, errors.Wrap(err, "failed to list memo grants")
+	}
+	var groupIDs map[int32]bool
+	for _, grant := range grants {
+		if grant.GranteeType == store.MemoGranteeUser && grant.GranteeID != user.ID {
+			continue
+		}
+		if grant.GranteeType == store.MemoGranteeGroup {
+			if groupIDs == nil {
+				groups, err := s.ListUserGroups(ctx, &store.FindUserGroup{
+					MemberID: &user.ID,
+				})
+				if err != nil {
+					return MemoAccessNone, errors.Wrap(err, "failed to list user groups")
+				}
+				groupIDs = map[int32]bool{}
+				for _, group := range groups {
+					groupIDs[group.ID] = true
+				}
+			}
+			if !groupIDs[grant.GranteeID] {
+				continue
+			}
+		}
+		if grantAccess := convertMemoGrantRoleToAccess(grant.Role); grantAccess > access {
+			access = grantAccess
+		}
+	}
+	return access, nil
+}
+
+// CanAccessMemo returns true if the user, nil for anonymous, has at least the access to the memo.
+func CanAccessMemo(ctx context.Context, s *store.Store, user *store.User, memo *store.Memo, access MemoAccess) (bool, error) {
+	memoAccess, err := GetMemoAccess(ctx, s, user, memo)
+	if err != nil {
+		return false, err
+	}
+	return memoAccess >= access, nil
+}
+
+// CanCompleteMemoTask returns true if the user can check off the task of the memo.
+// The assignees of a task only need to see the memo, the other users need to be able to edit it.
+func CanCompleteMemoTask(ctx context.Context, s *store.Store, user *store.User, memo *store.Memo, task *store.MemoTask) (bool, error) {
+	access := MemoAccessEdit
+	if user != nil && task.AssigneeID == user.ID {
+		access = MemoAccessView
+	}
+	return CanAccessMemo(ctx, s, user, memo, access)
+}
+
+func convertMemoGrantRoleToAccess(role store.MemoGrantRole) MemoAccess {
+	switch role {
+	case store.MemoGrantViewer:
+		return MemoAccessView
+	case store.MemoGrantCommenter:
+		return MemoAccessComment
+	case store.MemoGrantEditor:
+		return MemoAccessEdit
+	default:
+		return MemoAccessNone
+	}
+}
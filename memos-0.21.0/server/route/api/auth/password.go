@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+// ValidatePassword returns an error describing the first rule of the password policy the password breaks.
+func ValidatePassword(policy *storepb.WorkspacePasswordPolicySetting, password string) error {
+	if password == "" {
+		return errors.New("password is required")
+	}
+	if policy == nil {
+		return nil
+	}
+	if policy.MinLength > 0 && utf8.RuneCountInString(password) < int(policy.MinLength) {
+		return errors.Errorf("password must be at least %d characters long", policy.MinLength)
+	}
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case !unicode.IsLetter(r):
+			hasSymbol = true
+		}
+	}
+	if policy.RequireUppercase && !hasUpper {
+		return errors.New("password must contain an uppercase letter")
+	}
+	if policy.RequireLowercase && !hasLower {
+		return errors.New("password must contain a lowercase letter")
+	}
+	if policy.RequireDigit && !hasDigit {
+		return errors.New("password must contain a digit")
+	}
+	if policy.RequireSymbol && !hasSymbol {
+		return errors.New("password must contain a symbol")
+	}
+	return nil
+}
+
+// GetPasswordExpireTime returns when the password changed at updatedTs expires, or the zero time if it never does.
+func GetPasswordExpireTime(policy *storepb.WorkspacePasswordPolicySetting, updatedTs int64) time.Time {
+	if policy == nil || policy.ExpiryDays <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(updatedTs, 0).AddDate(0, 0, int(policy.ExpiryDays))
+}
+
+// IsPasswordChangeRequired returns true if the user has to change the password before signing in.
+// The passwords changed before their changes were recorded are considered changed when the user was created.
+func IsPasswordChangeRequired(policy *storepb.WorkspacePasswordPolicySetting, password *storepb.PasswordUserSetting, userCreatedTs int64, now time.Time) bool {
+	if password.GetChangeRequired() {
+		return true
+	}
+	updatedTs := password.GetUpdatedTs()
+	if updatedTs == 0 {
+		updatedTs = userCreatedTs
+	}
+	expireTime := GetPasswordExpireTime(policy, updatedTs)
+	return !expireTime.IsZero() && !now.Before(expireTime)
+}
@@ -0,0 +1,65 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+func TestValidatePassword(t *testing.T) {
+	policy := &storepb.WorkspacePasswordPolicySetting{
+		MinLength:        8,
+		RequireUppercase: true,
+		RequireLowercase: true,
+		RequireDigit:     true,
+		RequireSymbol:    true,
+	}
+	tests := []struct {
+		password string
+		policy   *storepb.WorkspacePasswordPolicySetting
+		valid    bool
+	}{
+		{password: "", policy: nil, valid: false},
+		{password: "secret", policy: nil, valid: true},
+		{password: "secret", policy: &storepb.WorkspacePasswordPolicySetting{}, valid: true},
+		{password: "Aa1!", policy: policy, valid: false},
+		{password: "abcdefg1!", policy: policy, valid: false},
+		{password: "ABCDEFG1!", policy: policy, valid: false},
+		{password: "Abcdefgh!", policy: policy, valid: false},
+		{password: "Abcdefgh1", policy: policy, valid: false},
+		{password: "Abcdefg1!", policy: policy, valid: true},
+		{password: "Äbcdéfg1 ", policy: policy, valid: true},
+	}
+	for _, test := range tests {
+		err := ValidatePassword(test.policy, test.password)
+		if (err == nil) != test.valid {
+			t.Errorf("ValidatePassword(%q) returned %v, want valid %v", test.password, err, test.valid)
+		}
+	}
+}
+
+func TestIsPasswordChangeRequired(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	day := int64(24 * 60 * 60)
+	policy := &storepb.WorkspacePasswordPolicySetting{ExpiryDays: 30}
+	tests := []struct {
+		name     string
+		policy   *storepb.WorkspacePasswordPolicySetting
+		password *storepb.PasswordUserSetting
+		want     bool
+	}{
+		{name: "no policy", policy: nil, password: &storepb.PasswordUserSetting{UpdatedTs: now.Unix() - 365*day}, want: false},
+		{name: "change required", policy: nil, password: &storepb.PasswordUserSetting{ChangeRequired: true}, want: true},
+		{name: "recent password", policy: policy, password: &storepb.PasswordUserSetting{UpdatedTs: now.Unix() - 29*day}, want: false},
+		{name: "expired password", policy: policy, password: &storepb.PasswordUserSetting{UpdatedTs: now.Unix() - 30*day}, want: true},
+		{name: "unrecorded password of an old user", policy: policy, password: &storepb.PasswordUserSetting{}, want: true},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := IsPasswordChangeRequired(test.policy, test.password, now.Unix()-60*day, now); got != test.want {
+				t.Errorf("IsPasswordChangeRequired() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package auth
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// Permission is an action on the workspace beyond managing one's own memos and account.
+type Permission string
+
+const (
+	// PermissionManageWorkspace allows changing the workspace and system settings.
+	PermissionManageWorkspace Permission = "workspace.manage"
+	// PermissionManageUsers allows creating, updating and deleting other users.
+	PermissionManageUsers Permission = "users.manage"
+	// PermissionManageStorage allows managing the storages of resources.
+	PermissionManageStorage Permission = "storage.manage"
+	// PermissionManageIdentityProviders allows managing the SSO identity providers.
+	PermissionManageIdentityProviders Permission = "idps.manage"
+	// PermissionModeratePublicMemos allows archiving, hiding and deleting the public memos of other users.
+	PermissionModeratePublicMemos Permission = "memos.moderate"
+	// PermissionViewAuditLog allows viewing the audit log of the workspace.
+	PermissionViewAuditLog Permission = "audit_log.view"
+)
+
+// Permissions is the list of all known permissions.
+var Permissions = []Permission{
+	PermissionManageWorkspace,
+	PermissionManageUsers,
+	PermissionManageStorage,
+	PermissionManageIdentityProviders,
+	PermissionModeratePublicMemos,
+	PermissionViewAuditLog,
+}
+
+// defaultRolePermissions are the permissions of the built-in roles unless overridden by the workspace.
+// The host always has every permission so that the workspace cannot be locked out.
+var defaultRolePermissions = map[store.Role][]Permission{
+	store.RoleAdmin: {
+		PermissionManageUsers,
+		PermissionModeratePublicMemos,
+		PermissionViewAuditLog,
+	},
+	store.RoleUser: {},
+}
+
+var roleNameMatcher = regexp.MustCompile("^[A-Z][A-Z0-9_]{1,31}$")
+
+// IsValidPermission returns true if the permission is a known permission.
+func IsValidPermission(permission string) bool {
+	for _, p := range Permissions {
+		if string(p) == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateRoles checks the roles of the workspace roles setting.
+func ValidateRoles(roles []*storepb.WorkspaceRole) error {
+	names := map[string]bool{}
+	for _, role := range roles {
+		if !roleNameMatcher.MatchString(role.Name) {
+			return errors.Errorf("invalid role name %q", role.Name)
+		}
+		if role.Name == string(store.RoleHost) {
+			return errors.New("the permissions of the host cannot be changed")
+		}
+		if names[role.Name] {
+			return errors.Errorf("duplicate role %q", role.Name)
+		}
+		names[role.Name] = true
+		for _, permission := range role.Permissions {
+			if !IsValidPermission(permission) {
+				return errors.Errorf("invalid permission %q of role %q", permission, role.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// Evaluator decides what users are allowed to do from the permissions granted to their role.
+type Evaluator struct {
+	roles map[store.Role]map[Permission]bool
+}
+
+// NewEvaluator returns an evaluator with the default permissions of the built-in roles
+// overridden and extended by the roles of the workspace.
+func NewEvaluator(roles []*storepb.WorkspaceRole) *Evaluator {
+	e := &Evaluator{
+		roles: map[store.Role]map[Permission]bool{},
+	}
+	for role, permissions := range defaultRolePermissions {
+		e.roles[role] = map[Permission]bool{}
+		for _, permission := range permissions {
+			e.roles[role][permission] = true
+		}
+	}
+	for _, role := range roles {
+		if role.Name == string(store.RoleHost) {
+			continue
+		}
+		e.roles[store.Role(role.Name)] = map[Permission]bool{}
+		for _, permission := range role.Permissions {
+			e.roles[store.Role(role.Name)][Permission(permission)] = true
+		}
+	}
+	return e
+}
+
+// GetEvaluator returns the evaluator for the roles currently configured in the workspace.
+func GetEvaluator(ctx context.Context, s *store.Store) (*Evaluator, error) {
+	rolesSetting, err := s.GetWorkspaceRolesSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return NewEvaluator(rolesSetting.Roles), nil
+}
+
+// HasPermission returns true if the user is granted the permission in the workspace.
+func HasPermission(ctx context.Context, s *store.Store, user *store.User, permission Permission) (bool, error) {
+	evaluator, err := GetEvaluator(ctx, s)
+	if err != nil {
+		return false, err
+	}
+	return evaluator.HasPermission(user, permission), nil
+}
+
+// IsRoleDefined returns true if the role is a built-in role or a custom role of the workspace.
+func (e *Evaluator) IsRoleDefined(role store.Role) bool {
+	if role == store.RoleHost {
+		return true
+	}
+	_, ok := e.roles[role]
+	return ok
+}
+
+// RolePermissions returns the permissions granted to the role.
+func (e *Evaluator) RolePermissions(role store.Role) []Permission {
+	permissions := []Permission{}
+	for _, permission := range Permissions {
+		if role == store.RoleHost || e.roles[role][permission] {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions
+}
+
+// HasPermission returns true if the user is granted the permission.
+func (e *Evaluator) HasPermission(user *store.User, permission Permission) bool {
+	if user == nil || user.RowStatus == store.Archived {
+		return false
+	}
+	if user.Role == store.RoleHost {
+		return true
+	}
+	return e.roles[user.Role][permission]
+}
+
+// CanAssignRole returns true if the user is allowed to give the role to other users.
+// Users managing others can only assign the roles whose permissions they have themselves, and nobody can assign the host role.
+func (e *Evaluator) CanAssignRole(user *store.User, role store.Role) bool {
+	if role == store.RoleHost || !e.IsRoleDefined(role) || !e.HasPermission(user, PermissionManageUsers) {
+		return false
+	}
+	for _, permission := range e.RolePermissions(role) {
+		if !e.HasPermission(user, permission) {
+			return false
+		}
+	}
+	return true
+}
+
+// CanManageUser returns true if the user is allowed to manage the target user.
+// Like roles, users can only manage the users whose permissions they have themselves, and only the host can manage the host.
+func (e *Evaluator) CanManageUser(user *store.User, target *store.User) bool {
+	if user != nil && target != nil && user.ID == target.ID {
+		return true
+	}
+	if !e.HasPermission(user, PermissionManageUsers) {
+		return false
+	}
+	if target == nil {
+		return true
+	}
+	if target.Role == store.RoleHost {
+		return user.Role == store.RoleHost
+	}
+	for _, permission := range e.RolePermissions(target.Role) {
+		if !e.HasPermission(user, permission) {
+			return false
+		}
+	}
+	return true
+}
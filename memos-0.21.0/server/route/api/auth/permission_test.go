@@ -0,0 +1,118 @@
+package auth
+
+import (
+	"testing"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestEvaluatorHasPermission(t *testing.T) {
+	evaluator := NewEvaluator([]*storepb.WorkspaceRole{
+		{Name: "MODERATOR", Permissions: []string{string(PermissionModeratePublicMemos)}},
+		{Name: "USER", Permissions: []string{string(PermissionViewAuditLog)}},
+		{Name: "HOST", Permissions: []string{}},
+	})
+
+	tests := []struct {
+		name       string
+		user       *store.User
+		permission Permission
+		want       bool
+	}{
+		{name: "host has every permission", user: &store.User{Role: store.RoleHost}, permission: PermissionManageStorage, want: true},
+		{name: "host cannot be overridden", user: &store.User{Role: store.RoleHost}, permission: PermissionManageWorkspace, want: true},
+		{name: "admin manages users", user: &store.User{Role: store.RoleAdmin}, permission: PermissionManageUsers, want: true},
+		{name: "admin does not manage storage", user: &store.User{Role: store.RoleAdmin}, permission: PermissionManageStorage, want: false},
+		{name: "admin does not manage workspace", user: &store.User{Role: store.RoleAdmin}, permission: PermissionManageWorkspace, want: false},
+		{name: "user override", user: &store.User{Role: store.RoleUser}, permission: PermissionViewAuditLog, want: true},
+		{name: "user without permission", user: &store.User{Role: store.RoleUser}, permission: PermissionManageUsers, want: false},
+		{name: "custom role", user: &store.User{Role: "MODERATOR"}, permission: PermissionModeratePublicMemos, want: true},
+		{name: "custom role without permission", user: &store.User{Role: "MODERATOR"}, permission: PermissionManageUsers, want: false},
+		{name: "undefined role", user: &store.User{Role: "GHOST"}, permission: PermissionModeratePublicMemos, want: false},
+		{name: "archived user", user: &store.User{Role: store.RoleHost, RowStatus: store.Archived}, permission: PermissionManageUsers, want: false},
+		{name: "anonymous", user: nil, permission: PermissionModeratePublicMemos, want: false},
+	}
+	for _, test := range tests {
+		if got := evaluator.HasPermission(test.user, test.permission); got != test.want {
+			t.Errorf("%s: HasPermission(%v) = %v, want %v", test.name, test.permission, got, test.want)
+		}
+	}
+}
+
+func TestEvaluatorCanAssignRole(t *testing.T) {
+	evaluator := NewEvaluator([]*storepb.WorkspaceRole{
+		{Name: "MODERATOR", Permissions: []string{string(PermissionModeratePublicMemos)}},
+		{Name: "OPERATOR", Permissions: []string{string(PermissionManageUsers), string(PermissionManageStorage)}},
+	})
+
+	tests := []struct {
+		name string
+		user *store.User
+		role store.Role
+		want bool
+	}{
+		{name: "host assigns admin", user: &store.User{Role: store.RoleHost}, role: store.RoleAdmin, want: true},
+		{name: "host assigns custom role", user: &store.User{Role: store.RoleHost}, role: "OPERATOR", want: true},
+		{name: "nobody assigns host", user: &store.User{Role: store.RoleHost}, role: store.RoleHost, want: false},
+		{name: "undefined role", user: &store.User{Role: store.RoleHost}, role: "GHOST", want: false},
+		{name: "admin assigns user", user: &store.User{Role: store.RoleAdmin}, role: store.RoleUser, want: true},
+		{name: "admin assigns subset role", user: &store.User{Role: store.RoleAdmin}, role: "MODERATOR", want: true},
+		{name: "admin cannot escalate", user: &store.User{Role: store.RoleAdmin}, role: "OPERATOR", want: false},
+		{name: "user cannot assign", user: &store.User{Role: store.RoleUser}, role: store.RoleUser, want: false},
+	}
+	for _, test := range tests {
+		if got := evaluator.CanAssignRole(test.user, test.role); got != test.want {
+			t.Errorf("%s: CanAssignRole(%v) = %v, want %v", test.name, test.role, got, test.want)
+		}
+	}
+}
+
+func TestEvaluatorCanManageUser(t *testing.T) {
+	evaluator := NewEvaluator([]*storepb.WorkspaceRole{
+		{Name: "OPERATOR", Permissions: []string{string(PermissionManageUsers), string(PermissionManageStorage)}},
+	})
+	host := &store.User{ID: 1, Role: store.RoleHost}
+	admin := &store.User{ID: 2, Role: store.RoleAdmin}
+	operator := &store.User{ID: 3, Role: "OPERATOR"}
+	user := &store.User{ID: 4, Role: store.RoleUser}
+
+	tests := []struct {
+		name   string
+		user   *store.User
+		target *store.User
+		want   bool
+	}{
+		{name: "self", user: user, target: user, want: true},
+		{name: "host manages admin", user: host, target: admin, want: true},
+		{name: "admin manages user", user: admin, target: user, want: true},
+		{name: "admin cannot manage host", user: admin, target: host, want: false},
+		{name: "admin cannot manage more privileged role", user: admin, target: operator, want: false},
+		{name: "operator cannot manage admin", user: operator, target: admin, want: false},
+		{name: "user cannot manage others", user: user, target: admin, want: false},
+	}
+	for _, test := range tests {
+		if got := evaluator.CanManageUser(test.user, test.target); got != test.want {
+			t.Errorf("%s: CanManageUser() = %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestValidateRoles(t *testing.T) {
+	tests := []struct {
+		roles   []*storepb.WorkspaceRole
+		wantErr bool
+	}{
+		{roles: []*storepb.WorkspaceRole{{Name: "MODERATOR", Permissions: []string{"memos.moderate"}}}, wantErr: false},
+		{roles: []*storepb.WorkspaceRole{{Name: "ADMIN", Permissions: []string{"storage.manage"}}}, wantErr: false},
+		{roles: []*storepb.WorkspaceRole{{Name: "HOST"}}, wantErr: true},
+		{roles: []*storepb.WorkspaceRole{{Name: "moderator"}}, wantErr: true},
+		{roles: []*storepb.WorkspaceRole{{Name: "MODERATOR"}, {Name: "MODERATOR"}}, wantErr: true},
+		{roles: []*storepb.WorkspaceRole{{Name: "MODERATOR", Permissions: []string{"memos.delete"}}}, wantErr: true},
+	}
+	for _, test := range tests {
+		if err := ValidateRoles(test.roles); (err != nil) != test.wantErr {
+			t.Errorf("ValidateRoles(%v) error = %v, want error %v", test.roles, err, test.wantErr)
+		}
+	}
+}
@@ -0,0 +1,126 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/server/profile"
+	"github.com/usememos/memos/store"
+)
+
+// ProxyAuthenticator authenticates users by a header set by a trusted reverse proxy,
+// such as Authelia or oauth2-proxy, which has already authenticated them.
+type ProxyAuthenticator struct {
+	header         string
+	trustedProxies TrustedProxies
+	autoCreate     bool
+	store          *store.Store
+}
+
+// NewProxyAuthenticator returns a proxy authenticator configured by the profile.
+// It returns nil if no trusted header is configured.
+func NewProxyAuthenticator(profile *profile.Profile, store *store.Store) (*ProxyAuthenticator, error) {
+	if profile.AuthProxyHeader == "" {
+		return nil, nil
+	}
+	if len(profile.AuthProxyTrustedProxies) == 0 {
+		return nil, errors.New("at least one trusted proxy is required to authenticate by header")
+	}
+
+	trustedProxies, err := ParseTrustedProxies(profile.AuthProxyTrustedProxies)
+	if err != nil {
+		return nil, err
+	}
+	return &ProxyAuthenticator{
+		header:         profile.AuthProxyHeader,
+		trustedProxies: trustedProxies,
+		autoCreate:     profile.AuthProxyAutoCreate,
+		store:          store,
+	}, nil
+}
+
+// Header returns the name of the header carrying the username.
+func (p *ProxyAuthenticator) Header() string {
+	if p == nil {
+		return ""
+	}
+	return p.header
+}
+
+// IsTrustedProxy returns true if the remote address, with or without a port, belongs to a trusted proxy.
+func (p *ProxyAuthenticator) IsTrustedProxy(remoteAddr string) bool {
+	if p == nil {
+		return false
+	}
+	return p.trustedProxies.Contains(remoteAddr)
+}
+
+// Authenticate returns the user named by the header value of a request sent from the remote address.
+// It returns nil if the request does not come from a trusted proxy or does not carry the header.
+func (p *ProxyAuthenticator) Authenticate(ctx context.Context, remoteAddr, username string) (*store.User, error) {
+	username = strings.TrimSpace(username)
+	if p == nil || username == "" || !p.IsTrustedProxy(remoteAddr) {
+		return nil, nil
+	}
+
+	user, err := p.store.GetUser(ctx, &store.FindUser{
+		Username: &username,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get user")
+	}
+	if user == nil {
+		if !p.autoCreate {
+			return nil, errors.Errorf("user %q not exists", username)
+		}
+		if user, err = p.createUser(ctx, username); err != nil {
+			return nil, err
+		}
+	}
+	if user.RowStatus == store.Archived {
+		return nil, errors.Errorf("user %q is archived", username)
+	}
+	return user, nil
+}
+
+func (p *ProxyAuthenticator) createUser(ctx context.Context, username string) (*store.User, error) {
+	if !util.UIDMatcher.MatchString(strings.ToLower(username)) {
+		return nil, errors.Errorf("invalid username %q", username)
+	}
+	// The user signs in through the proxy only, so the password is never used.
+	password, err := util.RandomString(20)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate random password")
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate password hash")
+	}
+
+	hostUserType := store.RoleHost
+	existedHostUsers, err := p.store.ListUsers(ctx, &store.FindUser{
+		Role: &hostUserType,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list users")
+	}
+	create := &store.User{
+		Username:     username,
+		Nickname:     username,
+		Role:         store.RoleUser,
+		PasswordHash: string(passwordHash),
+	}
+	if len(existedHostUsers) == 0 {
+		// Change the default role to host if there is no host user.
+		create.Role = store.RoleHost
+	}
+	user, err := p.store.CreateUser(ctx, create)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create user")
+	}
+	return user, nil
+}
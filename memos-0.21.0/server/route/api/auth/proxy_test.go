@@ -0,0 +1,66 @@
+package auth
+
+import (
+	"context"
+	"testing"
+
+	"github.com/usememos/memos/server/profile"
+)
+
+func TestProxyAuthenticator(t *testing.T) {
+	proxyAuthenticator, err := NewProxyAuthenticator(&profile.Profile{
+		AuthProxyHeader:         "Remote-User",
+		AuthProxyTrustedProxies: []string{"10.0.0.0/24", "192.168.1.5", "fd00::/8"},
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		remoteAddr string
+		want       bool
+	}{
+		{remoteAddr: "10.0.0.42:51234", want: true},
+		{remoteAddr: "10.0.1.42:51234", want: false},
+		{remoteAddr: "192.168.1.5", want: true},
+		{remoteAddr: "192.168.1.6:80", want: false},
+		{remoteAddr: "[fd00::1]:8080", want: true},
+		{remoteAddr: "[::1]:8080", want: false},
+		{remoteAddr: "", want: false},
+	}
+	for _, test := range tests {
+		if got := proxyAuthenticator.IsTrustedProxy(test.remoteAddr); got != test.want {
+			t.Errorf("IsTrustedProxy(%q) = %v, want %v", test.remoteAddr, got, test.want)
+		}
+	}
+
+	// Requests from untrusted addresses are ignored without looking the user up.
+	user, err := proxyAuthenticator.Authenticate(context.Background(), "172.16.0.1:1234", "admin")
+	if err != nil || user != nil {
+		t.Errorf("Authenticate from an untrusted proxy = %v, %v, want nil", user, err)
+	}
+}
+
+func TestNewProxyAuthenticator(t *testing.T) {
+	tests := []struct {
+		profile *profile.Profile
+		enabled bool
+		wantErr bool
+	}{
+		{profile: &profile.Profile{}, enabled: false},
+		{profile: &profile.Profile{AuthProxyHeader: "Remote-User"}, wantErr: true},
+		{profile: &profile.Profile{AuthProxyHeader: "Remote-User", AuthProxyTrustedProxies: []string{"not-an-ip"}}, wantErr: true},
+		{profile: &profile.Profile{AuthProxyHeader: "Remote-User", AuthProxyTrustedProxies: []string{"10.0.0.1/33"}}, wantErr: true},
+		{profile: &profile.Profile{AuthProxyHeader: "Remote-User", AuthProxyTrustedProxies: []string{" 127.0.0.1 "}}, enabled: true},
+	}
+	for _, test := range tests {
+		proxyAuthenticator, err := NewProxyAuthenticator(test.profile, nil)
+		if (err != nil) != test.wantErr {
+			t.Errorf("NewProxyAuthenticator(%+v) error = %v, want error %v", test.profile.AuthProxyTrustedProxies, err, test.wantErr)
+			continue
+		}
+		if (proxyAuthenticator != nil) != test.enabled {
+			t.Errorf("NewProxyAuthenticator(%+v) enabled = %v, want %v", test.profile.AuthProxyTrustedProxies, proxyAuthenticator != nil, test.enabled)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package auth
+
+import (
+	"strings"
+)
+
+// Scopes that can be granted to a personal access token.
+const (
+	ScopeMemosRead      = "memos:read"
+	ScopeMemosWrite     = "memos:write"
+	ScopeResourcesRead  = "resources:read"
+	ScopeResourcesWrite = "resources:write"
+	ScopeUserRead       = "user:read"
+	ScopeUserWrite      = "user:write"
+	// ScopeAdmin grants every other scope.
+	ScopeAdmin = "admin"
+)
+
+// Scopes is the list of all known access token scopes.
+var Scopes = []string{
+	ScopeMemosRead,
+	ScopeMemosWrite,
+	ScopeResourcesRead,
+	ScopeResourcesWrite,
+	ScopeUserRead,
+	ScopeUserWrite,
+	ScopeAdmin,
+}
+
+// IsValidScope returns true if the scope is a known scope.
+func IsValidScope(scope string) bool {
+	for _, s := range Scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// HasScope returns true if the granted scopes satisfy the required scope.
+// An empty granted list means the token is unrestricted, a write scope implies
+// the read scope of the same resource and admin implies everything.
+func HasScope(granted []string, required string) bool {
+	if len(granted) == 0 || required == "" {
+		return true
+	}
+	for _, scope := range granted {
+		if scope == ScopeAdmin || scope == required {
+			return true
+		}
+		if strings.HasSuffix(required, ":read") && scope == strings.TrimSuffix(required, ":read")+":write" {
+			return true
+		}
+	}
+	return false
+}
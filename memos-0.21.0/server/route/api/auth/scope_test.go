@@ -0,0 +1,55 @@
+package auth
+
+import (
+	"testing"
+)
+
+func TestHasScope(t *testing.T) {
+	tests := []struct {
+		granted  []string
+		required string
+		want     bool
+	}{
+		{
+			granted:  []string{},
+			required: ScopeAdmin,
+			want:     true,
+		},
+		{
+			granted:  []string{ScopeMemosRead},
+			required: "",
+			want:     true,
+		},
+		{
+			granted:  []string{ScopeMemosRead},
+			required: ScopeMemosRead,
+			want:     true,
+		},
+		{
+			granted:  []string{ScopeMemosRead},
+			required: ScopeMemosWrite,
+			want:     false,
+		},
+		{
+			granted:  []string{ScopeMemosWrite},
+			required: ScopeMemosRead,
+			want:     true,
+		},
+		{
+			granted:  []string{ScopeResourcesWrite},
+			required: ScopeMemosRead,
+			want:     false,
+		},
+		{
+			granted:  []string{ScopeAdmin},
+			required: ScopeResourcesWrite,
+			want:     true,
+		},
+	}
+	for _, test := range tests {
+		result := HasScope(test.granted, test.required)
+		if result != test.want {
+			t.Errorf("HasScope %v %q: got result %v, want %v.", test.granted, test.required, result, test.want)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/usememos/memos/store"
+)
+
+// memoShareViewKeyExpiresIn is how long the key of a view of a share link with max views opens the resources of the memo.
+const memoShareViewKeyExpiresIn = time.Hour
+
+// IsMemoShareLinkExpired returns true if the share link can no longer be used at the time.
+func IsMemoShareLinkExpired(link *store.MemoShareLink, now time.Time) bool {
+	return link.ExpiresTs > 0 && now.Unix() >= link.ExpiresTs
+}
+
+// GenerateMemoShareKey generates the key proving that the share link was opened, which the resources of the memo are fetched with.
+// For links with max views the key belongs to the view just counted and expires soon after, so that the last view
+// still shows the resources while the link gives no more views. For password protected links the key changes
+// whenever the password does. The other links need no key, and it is empty.
+func GenerateMemoShareKey(link *store.MemoShareLink, secret []byte, now time.Time) string {
+	if link.MaxViews > 0 {
+		expiresTs := strconv.FormatInt(now.Add(memoShareViewKeyExpiresIn).Unix(), 10)
+		return expiresTs + "." + signMemoShareKey(link, secret, expiresTs)
+	}
+	if link.PasswordHash == "" {
+		return ""
+	}
+	return signMemoShareKey(link, secret, "")
+}
+
+// CheckMemoShareKey returns true if the key unlocks the share link at the time.
+func CheckMemoShareKey(link *store.MemoShareLink, key string, secret []byte, now time.Time) bool {
+	if link.MaxViews > 0 {
+		expiresTs, signature, ok := strings.Cut(key, ".")
+		if !ok {
+			return false
+		}
+		ts, err := strconv.ParseInt(expiresTs, 10, 64)
+		if err != nil || now.Unix() >= ts {
+			return false
+		}
+		return hmac.Equal([]byte(signMemoShareKey(link, secret, expiresTs)), []byte(signature))
+	}
+	return hmac.Equal([]byte(GenerateMemoShareKey(link, secret, now)), []byte(key))
+}
+
+// CanViewMemoResourcesByShareLink returns true if the share link of the memo, with the key given when it was opened,
+// gives access to the resources of the memo at the time.
+func CanViewMemoResourcesByShareLink(link *store.MemoShareLink, memo *store.Memo, key string, secret []byte, now time.Time) bool {
+	if link.MemoID != memo.ID || IsMemoShareLinkExpired(link, now) {
+		return false
+	}
+	// The resources are not counted as views, so the links with max views need the key of a view.
+	if link.MaxViews > 0 || link.PasswordHash != "" {
+		return CheckMemoShareKey(link, key, secret, now)
+	}
+	return true
+}
+
+func signMemoShareKey(link *store.MemoShareLink, secret []byte, expiresTs string) string {
+	mac := hmac.New(sha256.New, secret)
+	mac.Write([]byte(link.Token))
+	mac.Write([]byte(link.PasswordHash))
+	mac.Write([]byte(expiresTs))
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,57 @@
+package auth
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestIsMemoShareLinkExpired(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	tests := []struct {
+		expiresTs int64
+		want      bool
+	}{
+		{expiresTs: 0, want: false},
+		{expiresTs: now.Unix() + 1, want: false},
+		{expiresTs: now.Unix(), want: true},
+		{expiresTs: now.Unix() - 1, want: true},
+	}
+	for _, test := range tests {
+		link := &store.MemoShareLink{ExpiresTs: test.expiresTs}
+		if got := IsMemoShareLinkExpired(link, now); got != test.want {
+			t.Errorf("IsMemoShareLinkExpired(%d) = %v, want %v", test.expiresTs, got, test.want)
+		}
+	}
+}
+
+func TestCheckMemoShareKey(t *testing.T) {
+	secret := []byte("secret")
+	now := time.Unix(1700000000, 0)
+	link := &store.MemoShareLink{Token: "token", PasswordHash: "hash"}
+	key := GenerateMemoShareKey(link, secret, now)
+	if !CheckMemoShareKey(link, key, secret, now) {
+		t.Errorf("expected the generated key to unlock the link")
+	}
+	if CheckMemoShareKey(link, "", secret, now) {
+		t.Errorf("expected an empty key not to unlock a password protected link")
+	}
+	if CheckMemoShareKey(&store.MemoShareLink{Token: "token", PasswordHash: "other"}, key, secret, now) {
+		t.Errorf("expected the key to change with the password")
+	}
+
+	link = &store.MemoShareLink{Token: "token", MaxViews: 1}
+	key = GenerateMemoShareKey(link, secret, now)
+	if !CheckMemoShareKey(link, key, secret, now.Add(memoShareViewKeyExpiresIn-time.Second)) {
+		t.Errorf("expected the key of a view to unlock the link until it expires")
+	}
+	if CheckMemoShareKey(link, key, secret, now.Add(memoShareViewKeyExpiresIn)) {
+		t.Errorf("expected the key of a view to expire")
+	}
+	expiresTs, signature, _ := strings.Cut(key, ".")
+	if CheckMemoShareKey(link, expiresTs+"0."+signature, secret, now) {
+		t.Errorf("expected a key with a changed expiry not to unlock the link")
+	}
+}
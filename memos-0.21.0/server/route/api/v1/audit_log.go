@@ -0,0 +1,23 @@
+package v1
+
+import (
+	"log/slog"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/usememos/memos/store"
+)
+
+// createAuditLog records the action of the user in session, or the given actor, in the audit log.
+// Failing to record it is logged rather than failing the request, as the action already happened.
+func (s *APIV1Service) createAuditLog(c echo.Context, auditLog *store.AuditLog) {
+	if auditLog.ActorID == 0 {
+		if userID, ok := c.Get(userIDContextKey).(int32); ok {
+			auditLog.ActorID = userID
+		}
+	}
+	auditLog.IP = c.RealIP()
+	if _, err := s.Store.CreateAuditLog(c.Request().Context(), auditLog); err != nil {
+		slog.Warn("failed to create audit log", slog.String("action", auditLog.Action.String()), slog.Any("error", err))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"regexp"
 	"strings"
@@ -55,7 +56,8 @@ func (s *APIV1Service) registerAuthRoutes(g *echo.Group) {
 //	@Success	200		{object}	store.User	"User information"
 //	@Failure	400		{object}	nil			"Malformatted signin request"
 //	@Failure	401		{object}	nil			"Password login is deactivated | Incorrect login credentials, please try again"
-//	@Failure	403		{object}	nil			"User has been archived with username %s"
+//	@Failure	403		{object}	nil			"User has been archived with username %s | Email is not verified"
+//	@Failure	429		{object}	nil			"Too many failed sign-in attempts"
 //	@Failure	500		{object}	nil			"Failed to find system setting | Failed to unmarshal system setting | Incorrect login credentials, please try again | Failed to generate tokens | Failed to create activity"
 //	@Router		/api/v1/auth/signin [POST]
 func (s *APIV1Service) SignIn(c echo.Context) error {
@@ -72,6 +74,10 @@ func (s *APIV1Service) SignIn(c echo.Context) error {
 	if err := json.NewDecoder(c.Request().Body).Decode(signin); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "Malformatted signin request").SetInternal(err)
 	}
+	loginGuardKeys := []string{"ip:" + c.RealIP(), "user:" + signin.Username}
+	if err := s.checkLoginGuard(loginGuardKeys...); err != nil {
+		return err
+	}
 
 	user, err := s.Store.GetUser(ctx, &store.FindUser{
 		Username: &signin.Username,
@@ -80,6 +86,7 @@ func (s *APIV1Service) SignIn(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Incorrect login credentials, please try again")
 	}
 	if user == nil {
+		s.recordLoginFailure(c, nil, fmt.Sprintf("user not found with username %s", signin.Username), loginGuardKeys...)
 		return echo.NewHTTPError(http.StatusUnauthorized, "Incorrect login credentials, please try again")
 	} else if user.RowStatus == store.Archived {
 		return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("User has been archived with username %s", signin.Username))
@@ -88,8 +95,30 @@ func (s *APIV1Service) SignIn(c echo.Context) error {
 	// Compare the stored hashed password, with the hashed version of the password that was received.
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(signin.Password)); err != nil {
 		// If the two passwords don't match, return a 401 status.
+		s.recordLoginFailure(c, user, "unmatched password", loginGuardKeys...)
 		return echo.NewHTTPError(http.StatusUnauthorized, "Incorrect login credentials, please try again")
 	}
+	// Only the username is cleared, so that a valid account cannot be used to reset the counter of an IP.
+	s.loginGuard.Succeed("user:" + signin.Username)
+	if workspaceGeneralSetting.RequireEmailVerification {
+		emailVerification, err := s.Store.GetUserEmailVerification(ctx, user.ID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find email verification").SetInternal(err)
+		}
+		if emailVerification.PendingEmail != "" {
+			return echo.NewHTTPError(http.StatusForbidden, "Email is not verified")
+		}
+	}
+	if err := s.checkUserSuspended(ctx, user); err != nil {
+		return err
+	}
+	changeRequired, err := auth.CheckPasswordChangeRequired(ctx, s.Store, user)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check password").SetInternal(err)
+	}
+	if changeRequired {
+		return echo.NewHTTPError(http.StatusForbidden, "Password change required")
+	}
 
 	var expireAt time.Time
 	// Set cookie expiration to 100 years to make it persistent.
@@ -103,8 +132,8 @@ func (s *APIV1Service) SignIn(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to generate tokens, err: %s", err)).SetInternal(err)
 	}
-	if err := s.UpsertAccessTokenToStore(ctx, user, accessToken); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to upsert access token, err: %s", err)).SetInternal(err)
+	if err := s.createUserSession(c, user, accessToken); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to create session, err: %s", err)).SetInternal(err)
 	}
 	setTokenCookie(c, auth.AccessTokenCookieName, accessToken, cookieExp)
 	userMessage := convertUserFromStore(user)
@@ -123,10 +152,15 @@ func (s *APIV1Service) SignIn(c echo.Context) error {
 //	@Failure	401		{object}	nil			"Access denied, identifier does not match the filter."
 //	@Failure	403		{object}	nil			"User has been archived with username {username}"
 //	@Failure	404		{object}	nil			"Identity provider not found"
+//	@Failure	429		{object}	nil			"Too many failed sign-in attempts"
 //	@Failure	500		{object}	nil			"Failed to find identity provider | Failed to create identity provider instance | Failed to exchange token | Failed to get user info | Failed to compile identifier filter | Incorrect login credentials, please try again | Failed to generate random password | Failed to generate password hash | Failed to create user | Failed to generate tokens | Failed to create activity"
 //	@Router		/api/v1/auth/signin/sso [POST]
 func (s *APIV1Service) SignInSSO(c echo.Context) error {
 	ctx := c.Request().Context()
+	loginGuardKey := "ip:" + c.RealIP()
+	if err := s.checkLoginGuard(loginGuardKey); err != nil {
+		return err
+	}
 	signin := &SSOSignIn{}
 	if err := json.NewDecoder(c.Request().Body).Decode(signin); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "Malformatted signin request").SetInternal(err)
@@ -150,6 +184,7 @@ func (s *APIV1Service) SignInSSO(c echo.Context) error {
 		}
 		token, err := oauth2IdentityProvider.ExchangeToken(ctx, signin.RedirectURI, signin.Code)
 		if err != nil {
+			s.recordLoginFailure(c, nil, fmt.Sprintf("failed to exchange token with identity provider %s", identityProvider.Name), loginGuardKey)
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to exchange token").SetInternal(err)
 		}
 		userInfo, err = oauth2IdentityProvider.UserInfo(token)
@@ -204,17 +239,21 @@ func (s *APIV1Service) SignInSSO(c echo.Context) error {
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create user").SetInternal(err)
 		}
+		s.createSignUpAuditLog(c, user)
 	}
 	if user.RowStatus == store.Archived {
 		return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("User has been archived with username %s", userInfo.Identifier))
 	}
+	if err := s.checkUserSuspended(ctx, user); err != nil {
+		return err
+	}
 
 	accessToken, err := auth.GenerateAccessToken(user.Username, user.ID, time.Now().Add(auth.AccessTokenDuration), []byte(s.Secret))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to generate tokens, err: %s", err)).SetInternal(err)
 	}
-	if err := s.UpsertAccessTokenToStore(ctx, user, accessToken); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to upsert access token, err: %s", err)).SetInternal(err)
+	if err := s.createUserSession(c, user, accessToken); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to create session, err: %s", err)).SetInternal(err)
 	}
 	cookieExp := time.Now().Add(auth.CookieExpDuration)
 	setTokenCookie(c, auth.AccessTokenCookieName, accessToken, cookieExp)
@@ -237,6 +276,13 @@ func (s *APIV1Service) SignOut(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to remove access token, err: %s", err)).SetInternal(err)
 	}
+	if userID != 0 {
+		s.createAuditLog(c, &store.AuditLog{
+			ActorID: userID,
+			Action:  store.AuditActionSignOut,
+			Target:  fmt.Sprintf("users/%d", userID),
+		})
+	}
 
 	return c.JSON(http.StatusOK, true)
 }
@@ -253,10 +299,14 @@ func (s *APIV1Service) SignOut(c echo.Context) error {
 //	@Failure	401		{object}	nil			"signup is disabled"
 //	@Failure	403		{object}	nil			"Forbidden"
 //	@Failure	404		{object}	nil			"Not found"
+//	@Failure	429		{object}	nil			"Too many failed sign-in attempts"
 //	@Failure	500		{object}	nil			"Failed to find system setting | Failed to unmarshal system setting allow signup | Failed to generate password hash | Failed to create user | Failed to generate tokens | Failed to create activity"
 //	@Router		/api/v1/auth/signup [POST]
 func (s *APIV1Service) SignUp(c echo.Context) error {
 	ctx := c.Request().Context()
+	if err := s.checkLoginGuard("ip:" + c.RealIP()); err != nil {
+		return err
+	}
 	signup := &SignUp{}
 	if err := json.NewDecoder(c.Request().Body).Decode(signup); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "Malformatted signup request").SetInternal(err)
@@ -293,8 +343,15 @@ func (s *APIV1Service) SignUp(c echo.Context) error {
 		if workspaceGeneralSetting.DisallowPasswordLogin {
 			return echo.NewHTTPError(http.StatusUnauthorized, "password login is deactivated").SetInternal(err)
 		}
+		// The sign up of v1 has no email to verify.
+		if workspaceGeneralSetting.RequireEmailVerification {
+			return echo.NewHTTPError(http.StatusForbidden, "signup requires email verification")
+		}
 	}
 
+	if err := s.validatePassword(ctx, signup.Password); err != nil {
+		return err
+	}
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte(signup.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate password hash").SetInternal(err)
@@ -305,12 +362,16 @@ func (s *APIV1Service) SignUp(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create user").SetInternal(err)
 	}
+	s.createSignUpAuditLog(c, user)
+	if err := s.recordPasswordChange(ctx, user.ID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to record password change").SetInternal(err)
+	}
 	accessToken, err := auth.GenerateAccessToken(user.Username, user.ID, time.Now().Add(auth.AccessTokenDuration), []byte(s.Secret))
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to generate tokens, err: %s", err)).SetInternal(err)
 	}
-	if err := s.UpsertAccessTokenToStore(ctx, user, accessToken); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to upsert access token, err: %s", err)).SetInternal(err)
+	if err := s.createUserSession(c, user, accessToken); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to create session, err: %s", err)).SetInternal(err)
 	}
 	cookieExp := time.Now().Add(auth.CookieExpDuration)
 	setTokenCookie(c, auth.AccessTokenCookieName, accessToken, cookieExp)
@@ -318,36 +379,129 @@ func (s *APIV1Service) SignUp(c echo.Context) error {
 	return c.JSON(http.StatusOK, userMessage)
 }
 
-func (s *APIV1Service) UpsertAccessTokenToStore(ctx context.Context, user *store.User, accessToken string) error {
-	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+// checkLoginGuard returns an error if sign-in attempts are delayed or locked out for any of the keys.
+func (s *APIV1Service) checkLoginGuard(keys ...string) error {
+	if wait := s.loginGuard.Check(keys...); wait > 0 {
+		return echo.NewHTTPError(http.StatusTooManyRequests, fmt.Sprintf("Too many failed sign-in attempts, retry in %s", wait.Round(time.Second)))
+	}
+	return nil
+}
+
+// validatePassword returns an error unless the password follows the password policy of the workspace.
+func (s *APIV1Service) validatePassword(ctx context.Context, password string) error {
+	passwordPolicy, err := s.Store.GetWorkspacePasswordPolicySetting(ctx)
 	if err != nil {
-		return errors.Wrap(err, "failed to get user access tokens")
-	}
-	userAccessToken := storepb.AccessTokensUserSetting_AccessToken{
-		AccessToken: accessToken,
-		Description: "Account sign in",
-	}
-	userAccessTokens = append(userAccessTokens, &userAccessToken)
-	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
-		UserId: user.ID,
-		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
-		Value: &storepb.UserSetting_AccessTokens{
-			AccessTokens: &storepb.AccessTokensUserSetting{
-				AccessTokens: userAccessTokens,
-			},
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find password policy").SetInternal(err)
+	}
+	if err := auth.ValidatePassword(passwordPolicy, password); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+	return nil
+}
+
+// recordPasswordChange records that the password of the user changed now.
+func (s *APIV1Service) recordPasswordChange(ctx context.Context, userID int32) error {
+	return s.Store.UpsertUserPassword(ctx, userID, &storepb.PasswordUserSetting{
+		UpdatedTs: time.Now().Unix(),
+	})
+}
+
+// checkUserSuspended returns an error telling the user about the suspension, if the user is suspended.
+func (s *APIV1Service) checkUserSuspended(ctx context.Context, user *store.User) error {
+	suspension, err := auth.GetActiveSuspension(ctx, s.Store, user.ID)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find suspension").SetInternal(err)
+	}
+	if suspension != nil {
+		return echo.NewHTTPError(http.StatusForbidden, auth.FormatSuspension(suspension))
+	}
+	return nil
+}
+
+// createSignUpAuditLog records the creation of the user signing up, who is the actor.
+func (s *APIV1Service) createSignUpAuditLog(c echo.Context, user *store.User) {
+	s.createAuditLog(c, &store.AuditLog{
+		ActorID: user.ID,
+		Action:  store.AuditActionUserCreate,
+		Target:  fmt.Sprintf("users/%d", user.ID),
+		Payload: &storepb.AuditLogPayload{
+			After: auth.NewAuditSnapshot(convertUserFromStore(user)),
+		},
+	})
+}
+
+// recordLoginFailure records a failed sign-in attempt in the audit log and an activity for every lockout it starts.
+func (s *APIV1Service) recordLoginFailure(c echo.Context, user *store.User, reason string, keys ...string) {
+	creatorID := store.SystemBotID
+	auditLog := &store.AuditLog{
+		Action: store.AuditActionSignInFailed,
+		Payload: &storepb.AuditLogPayload{
+			Message: reason,
 		},
-	}); err != nil {
-		return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("failed to upsert user setting, err: %s", err)).SetInternal(err)
 	}
+	if user != nil {
+		creatorID = user.ID
+		auditLog.Target = fmt.Sprintf("users/%d", user.ID)
+	}
+	s.createAuditLog(c, auditLog)
+	for _, lockout := range s.loginGuard.Fail(keys...) {
+		if _, err := s.Store.CreateActivity(c.Request().Context(), &store.Activity{
+			CreatorID: creatorID,
+			Type:      store.ActivityTypeLoginLockout,
+			Level:     store.ActivityLevelWarn,
+			Payload: &storepb.ActivityPayload{
+				LoginLockout: &storepb.ActivityLoginLockoutPayload{
+					Key:            lockout.Key,
+					FailedAttempts: int32(lockout.FailedAttempts),
+					LockedUntilTs:  lockout.LockedUntil.Unix(),
+					Ip:             c.RealIP(),
+				},
+			},
+		}); err != nil {
+			slog.Warn("failed to create login lockout activity", slog.Any("error", err))
+		}
+	}
+}
+
+// createUserSession stores a sign-in session for the access token issued to the user, and records the sign-in in the audit log.
+func (s *APIV1Service) createUserSession(c echo.Context, user *store.User, accessToken string) error {
+	claims, err := getClaimsFromAccessToken(accessToken, s.Secret)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse access token")
+	}
+	session := &storepb.SessionsUserSetting_Session{
+		UserAgent:  c.Request().UserAgent(),
+		Ip:         c.RealIP(),
+		CreatedTs:  claims.IssuedAt.Unix(),
+		LastSeenTs: claims.IssuedAt.Unix(),
+		LastSeenIp: c.RealIP(),
+	}
+	if claims.ExpiresAt != nil {
+		session.ExpiresTs = claims.ExpiresAt.Unix()
+	}
+	if err := s.Store.CreateUserSession(c.Request().Context(), user.ID, accessToken, session); err != nil {
+		return err
+	}
+	s.createAuditLog(c, &store.AuditLog{
+		ActorID: user.ID,
+		Action:  store.AuditActionSignIn,
+		Target:  fmt.Sprintf("users/%d", user.ID),
+	})
 	return nil
 }
 
-// removeAccessTokenAndCookies removes the jwt token from the cookies.
+// removeAccessTokenAndCookies removes the jwt token and its session from the store and the cookies.
 func removeAccessTokenAndCookies(c echo.Context, s *store.Store, userID int32, token string) error {
 	err := s.RemoveUserAccessToken(c.Request().Context(), userID, token)
 	if err != nil {
 		return err
 	}
+	tokenHash := store.HashAccessToken(token)
+	if err := s.RemoveUserSessions(c.Request().Context(), userID, func(session *storepb.SessionsUserSetting_Session) bool {
+		return session.TokenHash == tokenHash
+	}); err != nil {
+		return err
+	}
 
 	cookieExp := time.Now().Add(-1 * time.Hour)
 	setTokenCookie(c, auth.AccessTokenCookieName, "", cookieExp)
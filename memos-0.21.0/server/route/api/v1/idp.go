@@ -8,6 +8,8 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/usememos/memos/internal/util"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/route/api/auth"
 	"github.com/usememos/memos/store"
 )
 
@@ -89,7 +91,7 @@ func (s *APIV1Service) GetIdentityProviderList(c echo.Context) error {
 	}
 
 	userID, ok := c.Get(userIDContextKey).(int32)
-	isHostUser := false
+	canManageIdentityProviders := false
 	if ok {
 		user, err := s.Store.GetUser(ctx, &store.FindUser{
 			ID: &userID,
@@ -97,8 +99,8 @@ func (s *APIV1Service) GetIdentityProviderList(c echo.Context) error {
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 		}
-		if user == nil || user.Role == store.RoleHost {
-			isHostUser = true
+		if canManageIdentityProviders, err = auth.HasPermission(ctx, s.Store, user, auth.PermissionManageIdentityProviders); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check permission").SetInternal(err)
 		}
 	}
 
@@ -106,7 +108,7 @@ func (s *APIV1Service) GetIdentityProviderList(c echo.Context) error {
 	for _, item := range list {
 		identityProvider := convertIdentityProviderFromStore(item)
 		// data desensitize
-		if !isHostUser {
+		if !canManageIdentityProviders {
 			identityProvider.Config.OAuth2Config.ClientSecret = ""
 		}
 		identityProviderList = append(identityProviderList, identityProvider)
@@ -139,8 +141,8 @@ func (s *APIV1Service) CreateIdentityProvider(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 	}
-	if user == nil || user.Role != store.RoleHost {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	if err := s.checkPermission(ctx, user, auth.PermissionManageIdentityProviders); err != nil {
+		return err
 	}
 
 	identityProviderCreate := &CreateIdentityProviderRequest{}
@@ -157,7 +159,15 @@ func (s *APIV1Service) CreateIdentityProvider(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create identity provider").SetInternal(err)
 	}
-	return c.JSON(http.StatusOK, convertIdentityProviderFromStore(identityProvider))
+	identityProviderMessage := convertIdentityProviderFromStore(identityProvider)
+	s.createAuditLog(c, &store.AuditLog{
+		Action: store.AuditActionIdentityProviderCreate,
+		Target: fmt.Sprintf("identityProviders/%d", identityProvider.ID),
+		Payload: &storepb.AuditLogPayload{
+			After: auth.NewAuditSnapshot(identityProviderMessage),
+		},
+	})
+	return c.JSON(http.StatusOK, identityProviderMessage)
 }
 
 // GetIdentityProvider godoc
@@ -186,8 +196,8 @@ func (s *APIV1Service) GetIdentityProvider(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 	}
-	if user == nil || user.Role != store.RoleHost {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	if err := s.checkPermission(ctx, user, auth.PermissionManageIdentityProviders); err != nil {
+		return err
 	}
 
 	identityProviderID, err := util.ConvertStringToInt32(c.Param("idpId"))
@@ -232,8 +242,8 @@ func (s *APIV1Service) DeleteIdentityProvider(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 	}
-	if user == nil || user.Role != store.RoleHost {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	if err := s.checkPermission(ctx, user, auth.PermissionManageIdentityProviders); err != nil {
+		return err
 	}
 
 	identityProviderID, err := util.ConvertStringToInt32(c.Param("idpId"))
@@ -241,9 +251,27 @@ func (s *APIV1Service) DeleteIdentityProvider(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("ID is not a number: %s", c.Param("idpId"))).SetInternal(err)
 	}
 
+	identityProvider, err := s.Store.GetIdentityProvider(ctx, &store.FindIdentityProvider{
+		ID: &identityProviderID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get identity provider").SetInternal(err)
+	}
+	if identityProvider == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Identity provider not found")
+	}
+	before := auth.NewAuditSnapshot(convertIdentityProviderFromStore(identityProvider))
+
 	if err = s.Store.DeleteIdentityProvider(ctx, &store.DeleteIdentityProvider{ID: identityProviderID}); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete identity provider").SetInternal(err)
 	}
+	s.createAuditLog(c, &store.AuditLog{
+		Action: store.AuditActionIdentityProviderDelete,
+		Target: fmt.Sprintf("identityProviders/%d", identityProviderID),
+		Payload: &storepb.AuditLogPayload{
+			Before: before,
+		},
+	})
 	return c.JSON(http.StatusOK, true)
 }
 
@@ -273,8 +301,8 @@ func (s *APIV1Service) UpdateIdentityProvider(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 	}
-	if user == nil || user.Role != store.RoleHost {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	if err := s.checkPermission(ctx, user, auth.PermissionManageIdentityProviders); err != nil {
+		return err
 	}
 
 	identityProviderID, err := util.ConvertStringToInt32(c.Param("idpId"))
@@ -289,7 +317,25 @@ func (s *APIV1Service) UpdateIdentityProvider(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusBadRequest, "Malformatted patch identity provider request").SetInternal(err)
 	}
 
-	identityProvider, err := s.Store.UpdateIdentityProvider(ctx, &store.UpdateIdentityProvider{
+	identityProvider, err := s.Store.GetIdentityProvider(ctx, &store.FindIdentityProvider{
+		ID: &identityProviderID,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get identity provider").SetInternal(err)
+	}
+	if identityProvider == nil {
+		return echo.NewHTTPError(http.StatusNotFound, "Identity provider not found")
+	}
+	// Snapshot the identity provider before updating it, as the store may return the cached one.
+	before := auth.NewAuditSnapshot(convertIdentityProviderFromStore(identityProvider))
+	// The client secret is redacted in the responses, keep the current one if it is sent back as is.
+	if config := identityProviderPatch.Config; config != nil && config.OAuth2Config != nil && config.OAuth2Config.ClientSecret == auth.RedactedValue {
+		if identityProvider.Config != nil && identityProvider.Config.OAuth2Config != nil {
+			config.OAuth2Config.ClientSecret = identityProvider.Config.OAuth2Config.ClientSecret
+		}
+	}
+
+	identityProvider, err = s.Store.UpdateIdentityProvider(ctx, &store.UpdateIdentityProvider{
 		ID:               identityProviderPatch.ID,
 		Type:             store.IdentityProviderType(identityProviderPatch.Type),
 		Name:             identityProviderPatch.Name,
@@ -299,7 +345,16 @@ func (s *APIV1Service) UpdateIdentityProvider(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to patch identity provider").SetInternal(err)
 	}
-	return c.JSON(http.StatusOK, convertIdentityProviderFromStore(identityProvider))
+	identityProviderMessage := convertIdentityProviderFromStore(identityProvider)
+	s.createAuditLog(c, &store.AuditLog{
+		Action: store.AuditActionIdentityProviderUpdate,
+		Target: fmt.Sprintf("identityProviders/%d", identityProviderID),
+		Payload: &storepb.AuditLogPayload{
+			Before: before,
+			After:  auth.NewAuditSnapshot(identityProviderMessage),
+		},
+	})
+	return c.JSON(http.StatusOK, identityProviderMessage)
 }
 
 func convertIdentityProviderFromStore(identityProvider *store.IdentityProvider) *IdentityProvider {
@@ -312,11 +367,16 @@ func convertIdentityProviderFromStore(identityProvider *store.IdentityProvider)
 	}
 }
 
+// convertIdentityProviderConfigFromStore converts the config returned by the API, with the client secret redacted.
 func convertIdentityProviderConfigFromStore(config *store.IdentityProviderConfig) *IdentityProviderConfig {
+	clientSecret := ""
+	if config.OAuth2Config.ClientSecret != "" {
+		clientSecret = auth.RedactedValue
+	}
 	return &IdentityProviderConfig{
 		OAuth2Config: &IdentityProviderOAuth2Config{
 			ClientID:     config.OAuth2Config.ClientID,
-			ClientSecret: config.OAuth2Config.ClientSecret,
+			ClientSecret: clientSecret,
 			AuthURL:      config.OAuth2Config.AuthURL,
 			TokenURL:     config.OAuth2Config.TokenURL,
 			UserInfoURL:  config.OAuth2Config.UserInfoURL,
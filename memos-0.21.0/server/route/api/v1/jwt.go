@@ -1,17 +1,18 @@
 package v1
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/internal/util"
-	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/route/api/auth"
 	"github.com/usememos/memos/store"
 )
@@ -65,6 +66,18 @@ func JWTMiddleware(server *APIV1Service, next echo.HandlerFunc, secret string) e
 			return next(c)
 		}
 
+		// Users authenticated by a trusted reverse proxy need no access token.
+		if header := server.proxyAuthenticator.Header(); header != "" {
+			user, err := server.proxyAuthenticator.Authenticate(ctx, c.Request().RemoteAddr, c.Request().Header.Get(header))
+			if err != nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, "Failed to authenticate by proxy header").SetInternal(err)
+			}
+			if user != nil {
+				c.Set(userIDContextKey, user.ID)
+				return next(c)
+			}
+		}
+
 		accessToken := findAccessToken(c)
 		if accessToken == "" {
 			// Allow the user to access the public endpoints.
@@ -87,17 +100,20 @@ func JWTMiddleware(server *APIV1Service, next echo.HandlerFunc, secret string) e
 			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid or expired access token")
 		}
 
-		accessTokens, err := server.Store.GetUserAccessTokens(ctx, userID)
+		scopes, valid, err := findAccessTokenScopes(ctx, server.Store, userID, accessToken)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get user access tokens.").WithInternal(err)
 		}
-		if !validateAccessToken(accessToken, accessTokens) {
+		if !valid {
 			err = removeAccessTokenAndCookies(c, server.Store, userID, accessToken)
 			if err != nil {
 				slog.Warn("fail to remove AccessToken and Cookies", err)
 			}
 			return echo.NewHTTPError(http.StatusUnauthorized, "Invalid access token.")
 		}
+		if requiredScope := getRequiredScope(path, method); !auth.HasScope(scopes, requiredScope) {
+			return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("Access token does not have the required scope %q", requiredScope))
+		}
 
 		// Even if there is no error, we still need to make sure the user still exists.
 		user, err := server.Store.GetUser(ctx, &store.FindUser{
@@ -109,6 +125,23 @@ func JWTMiddleware(server *APIV1Service, next echo.HandlerFunc, secret string) e
 		if user == nil {
 			return echo.NewHTTPError(http.StatusUnauthorized, fmt.Sprintf("Failed to find user ID: %d", userID))
 		}
+		if user.RowStatus == store.Archived {
+			return echo.NewHTTPError(http.StatusForbidden, fmt.Sprintf("User has been archived with ID: %d", userID))
+		}
+		suspension, err := auth.GetActiveSuspension(ctx, server.Store, user.ID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find suspension").SetInternal(err)
+		}
+		if suspension != nil {
+			return echo.NewHTTPError(http.StatusForbidden, auth.FormatSuspension(suspension))
+		}
+
+		if err := server.Store.UpdateUserAccessTokenLastUsed(ctx, userID, accessToken, c.RealIP()); err != nil {
+			slog.Warn("failed to update access token last used", slog.Any("error", err))
+		}
+		if err := server.Store.UpdateUserSessionLastSeen(ctx, userID, accessToken, c.RealIP()); err != nil {
+			slog.Warn("failed to update session last seen", slog.Any("error", err))
+		}
 
 		// Stores userID into context.
 		c.Set(userIDContextKey, userID)
@@ -116,7 +149,41 @@ func JWTMiddleware(server *APIV1Service, next echo.HandlerFunc, secret string) e
 	}
 }
 
+// findAccessTokenScopes looks the access token up in the personal access tokens and the sign-in sessions of the user.
+// It returns the scopes granted to the token and whether the token is valid.
+func findAccessTokenScopes(ctx context.Context, s *store.Store, userID int32, accessToken string) ([]string, bool, error) {
+	now := time.Now().Unix()
+	userAccessToken, err := s.FindUserAccessToken(ctx, userID, accessToken)
+	if err != nil {
+		return nil, false, err
+	}
+	if userAccessToken != nil {
+		return userAccessToken.Scopes, userAccessToken.ExpiresTs == 0 || userAccessToken.ExpiresTs > now, nil
+	}
+	session, err := s.FindUserSession(ctx, userID, accessToken)
+	if err != nil {
+		return nil, false, err
+	}
+	if session != nil {
+		return nil, session.ExpiresTs == 0 || session.ExpiresTs > now, nil
+	}
+	return nil, false, nil
+}
+
 func getUserIDFromAccessToken(accessToken, secret string) (int32, error) {
+	claims, err := getClaimsFromAccessToken(accessToken, secret)
+	if err != nil {
+		return 0, err
+	}
+	// We either have a valid access token or we will attempt to generate new access token.
+	userID, err := util.ConvertStringToInt32(claims.Subject)
+	if err != nil {
+		return 0, errors.Wrap(err, "Malformed ID in the token")
+	}
+	return userID, nil
+}
+
+func getClaimsFromAccessToken(accessToken, secret string) (*auth.ClaimsMessage, error) {
 	claims := &auth.ClaimsMessage{}
 	_, err := jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
 		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
@@ -130,14 +197,9 @@ func getUserIDFromAccessToken(accessToken, secret string) (int32, error) {
 		return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
 	})
 	if err != nil {
-		return 0, errors.Wrap(err, "Invalid or expired access token")
+		return nil, errors.Wrap(err, "Invalid or expired access token")
 	}
-	// We either have a valid access token or we will attempt to generate new access token.
-	userID, err := util.ConvertStringToInt32(claims.Subject)
-	if err != nil {
-		return 0, errors.Wrap(err, "Malformed ID in the token")
-	}
-	return userID, nil
+	return claims, nil
 }
 
 func (*APIV1Service) defaultAuthSkipper(c echo.Context) bool {
@@ -145,11 +207,28 @@ func (*APIV1Service) defaultAuthSkipper(c echo.Context) bool {
 	return util.HasPrefixes(path, "/api/v1/auth")
 }
 
-func validateAccessToken(accessTokenString string, userAccessTokens []*storepb.AccessTokensUserSetting_AccessToken) bool {
-	for _, userAccessToken := range userAccessTokens {
-		if accessTokenString == userAccessToken.AccessToken {
-			return true
+// getRequiredScope returns the access token scope needed for the request.
+// An empty scope means the request is allowed for any token.
+func getRequiredScope(path, method string) string {
+	isRead := method == http.MethodGet || method == http.MethodHead
+	var resource string
+	switch {
+	case util.HasPrefixes(path, "/api/v1/memo", "/api/v1/tag"):
+		resource = "memos"
+	case util.HasPrefixes(path, "/api/v1/resource", "/o/r"):
+		resource = "resources"
+	case util.HasPrefixes(path, "/api/v1/user", "/api/v1/auth"):
+		resource = "user"
+	case util.HasPrefixes(path, "/api/v1/idp", "/api/v1/system/setting", "/o/get"):
+		if isRead {
+			return ""
 		}
+		return auth.ScopeAdmin
+	default:
+		return auth.ScopeAdmin
+	}
+	if isRead {
+		return resource + ":read"
 	}
-	return false
+	return resource + ":write"
 }
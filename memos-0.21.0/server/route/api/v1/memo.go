@@ -13,9 +13,11 @@ import (
 	"github.com/lithammer/shortuuid/v4"
 	"github.com/pkg/errors"
 
+	"github.com/usememos/memos/internal/envelope"
 	"github.com/usememos/memos/internal/util"
 	"github.com/usememos/memos/plugin/webhook"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/route/api/auth"
 	"github.com/usememos/memos/store"
 )
 
@@ -58,6 +60,8 @@ type Memo struct {
 	Content    string     `json:"content"`
 	Visibility Visibility `json:"visibility"`
 	Pinned     bool       `json:"pinned"`
+	// Encrypted is true if the content is an envelope encrypted end-to-end by the client.
+	Encrypted bool `json:"encrypted"`
 
 	// Related fields
 	CreatorName     string          `json:"creatorName"`
@@ -297,8 +301,12 @@ func (s *APIV1Service) CreateMemo(c echo.Context) error {
 			if user == nil {
 				return echo.NewHTTPError(http.StatusNotFound, "User not found")
 			}
-			// Enforce normal user to create private memo if public memos are disabled.
-			if user.Role == store.RoleUser {
+			// Enforce users other than moderators to create private memo if public memos are disabled.
+			canModerate, err := auth.HasPermission(ctx, s.Store, user, auth.PermissionModeratePublicMemos)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check permission").SetInternal(err)
+			}
+			if !canModerate {
 				createMemoRequest.Visibility = Private
 			}
 		}
@@ -570,9 +578,23 @@ func (s *APIV1Service) GetMemo(c echo.Context) error {
 
 	userID, ok := c.Get(userIDContextKey).(int32)
 	if memo.Visibility == store.Private {
-		if !ok || memo.CreatorID != userID {
+		if !ok {
 			return echo.NewHTTPError(http.StatusForbidden, "this memo is private only")
 		}
+		if memo.CreatorID != userID {
+			// Private memos may still be shared with the user or one of their groups.
+			user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+			}
+			canView, err := auth.CanAccessMemo(ctx, s.Store, user, memo, auth.MemoAccessView)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check memo access").SetInternal(err)
+			}
+			if !canView {
+				return echo.NewHTTPError(http.StatusForbidden, "this memo is private only")
+			}
+		}
 	} else if memo.Visibility == store.Protected {
 		if !ok {
 			return echo.NewHTTPError(http.StatusForbidden, "this memo is protected, missing user in session")
@@ -618,7 +640,9 @@ func (s *APIV1Service) DeleteMemo(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Memo not found: %d", memoID))
 	}
 	if memo.CreatorID != userID {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+		if err := s.checkCanModerateMemo(ctx, userID, memo); err != nil {
+			return err
+		}
 	}
 
 	if memoMessage, err := s.convertMemoFromStore(ctx, memo); err == nil {
@@ -677,9 +701,6 @@ func (s *APIV1Service) UpdateMemo(c echo.Context) error {
 	if memo == nil {
 		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Memo not found: %d", memoID))
 	}
-	if memo.CreatorID != userID {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
-	}
 
 	currentTs := time.Now().Unix()
 	patchMemoRequest := &PatchMemoRequest{
@@ -689,10 +710,29 @@ func (s *APIV1Service) UpdateMemo(c echo.Context) error {
 	if err := json.NewDecoder(c.Request().Body).Decode(patchMemoRequest); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "Malformatted patch memo request").SetInternal(err)
 	}
+	if memo.CreatorID != userID {
+		if err := s.checkCanModerateMemo(ctx, userID, memo); err != nil {
+			return err
+		}
+		// Moderators can only hide or archive the memos of others.
+		if patchMemoRequest.Content != nil || patchMemoRequest.CreatedTs != nil || patchMemoRequest.ResourceIDList != nil || patchMemoRequest.RelationList != nil {
+			return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+		}
+	}
 
 	if patchMemoRequest.Content != nil && len(*patchMemoRequest.Content) > maxContentLength {
 		return echo.NewHTTPError(http.StatusBadRequest, "Content size overflow, up to 1MB").SetInternal(err)
 	}
+	if memo.Encrypted {
+		if patchMemoRequest.Content != nil {
+			if _, err := envelope.Parse(*patchMemoRequest.Content); err != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "Invalid encrypted content").SetInternal(err)
+			}
+		}
+		if patchMemoRequest.Visibility != nil && *patchMemoRequest.Visibility != Private {
+			return echo.NewHTTPError(http.StatusBadRequest, "Encrypted memos must be private")
+		}
+	}
 
 	updateMemoMessage := &store.UpdateMemo{
 		ID:        memoID,
@@ -730,8 +770,12 @@ func (s *APIV1Service) UpdateMemo(c echo.Context) error {
 				if user == nil {
 					return echo.NewHTTPError(http.StatusNotFound, "User not found")
 				}
-				// Enforce normal user to save as private memo if public memos are disabled.
-				if user.Role == store.RoleUser {
+				// Enforce users other than moderators to save as private memo if public memos are disabled.
+				canModerate, err := auth.HasPermission(ctx, s.Store, user, auth.PermissionModeratePublicMemos)
+				if err != nil {
+					return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check permission").SetInternal(err)
+				}
+				if !canModerate {
 					visibility = store.Visibility("PRIVATE")
 					updateMemoMessage.Visibility = &visibility
 				}
@@ -835,6 +879,7 @@ func (s *APIV1Service) convertMemoFromStore(ctx context.Context, memo *store.Mem
 		Content:    memo.Content,
 		Visibility: Visibility(memo.Visibility.String()),
 		Pinned:     memo.Pinned,
+		Encrypted:  memo.Encrypted,
 	}
 
 	// Compose creator name.
@@ -999,8 +1044,10 @@ func (s *APIV1Service) DispatchMemoDeletedWebhook(ctx context.Context, memo *Mem
 }
 
 func (s *APIV1Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *Memo, activityType string) error {
+	personalGroupID := int32(0)
 	webhooks, err := s.Store.ListWebhooks(ctx, &store.FindWebhook{
 		CreatorID: &memo.CreatorID,
+		GroupID:   &personalGroupID,
 	})
 	if err != nil {
 		return err
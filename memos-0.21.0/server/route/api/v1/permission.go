@@ -0,0 +1,39 @@
+package v1
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/usememos/memos/server/route/api/auth"
+	"github.com/usememos/memos/store"
+)
+
+// checkPermission returns an HTTP error unless the user is granted the permission in the workspace.
+func (s *APIV1Service) checkPermission(ctx context.Context, user *store.User, permission auth.Permission) error {
+	if user == nil {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+	allowed, err := auth.HasPermission(ctx, s.Store, user, permission)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check permission").SetInternal(err)
+	}
+	if !allowed {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+	return nil
+}
+
+// checkCanModerateMemo returns an HTTP error unless the user is allowed to moderate the memo of another user.
+// Only public memos can be moderated.
+func (s *APIV1Service) checkCanModerateMemo(ctx context.Context, userID int32, memo *store.Memo) error {
+	if memo.Visibility != store.Public {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+	}
+	return s.checkPermission(ctx, user, auth.PermissionModeratePublicMemos)
+}
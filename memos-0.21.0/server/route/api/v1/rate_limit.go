@@ -0,0 +1,45 @@
+package v1
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/usememos/memos/store"
+)
+
+// IPRateLimitMiddleware limits the request rate of each client IP.
+// It runs before the JWT middleware so that the requests failing authentication are limited too.
+func IPRateLimitMiddleware(server *APIV1Service, next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		if !server.rateLimiter.Allow("ip:" + c.RealIP()) {
+			return echo.NewHTTPError(http.StatusTooManyRequests, "Too many requests")
+		}
+		return next(c)
+	}
+}
+
+// RateLimitMiddleware limits the request rate of each user and access token.
+// It runs after the JWT middleware so that the user is known.
+func RateLimitMiddleware(server *APIV1Service, next echo.HandlerFunc) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		keys := []string{}
+		if userID, ok := c.Get(userIDContextKey).(int32); ok {
+			// Key by username to share the budget of the user with API v2.
+			user, err := server.Store.GetUser(c.Request().Context(), &store.FindUser{ID: &userID})
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+			}
+			if user != nil {
+				keys = append(keys, "user:"+user.Username)
+			}
+		}
+		if accessToken := findAccessToken(c); accessToken != "" {
+			keys = append(keys, "token:"+store.HashAccessToken(accessToken))
+		}
+		if !server.rateLimiter.Allow(keys...) {
+			return echo.NewHTTPError(http.StatusTooManyRequests, "Too many requests")
+		}
+		return next(c)
+	}
+}
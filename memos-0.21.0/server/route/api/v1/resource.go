@@ -503,3 +503,92 @@ func SaveResourceBlob(ctx context.Context, s *store.Store, create *store.Resourc
 	create.ExternalLink = link
 	return nil
 }
+
+// ReadResourceBlob returns the content of the resource from where it is stored.
+// It returns nil if the resource is an external link not stored in any of the storages.
+func ReadResourceBlob(ctx context.Context, s *store.Store, resource *store.Resource) ([]byte, error) {
+	if resourcePath := s.GetResourceLocalPath(resource); resourcePath != "" {
+		blob, err := os.ReadFile(resourcePath)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to read local file")
+		}
+		return blob, nil
+	}
+	if resource.ExternalLink == "" {
+		return resource.Blob, nil
+	}
+
+	s3Clients, err := listS3Clients(ctx, s)
+	if err != nil {
+		return nil, err
+	}
+	for _, s3Client := range s3Clients {
+		blob, ok, err := s3Client.ReadFile(ctx, resource.ExternalLink)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to read via s3 client")
+		}
+		if ok {
+			return blob, nil
+		}
+	}
+	return nil, nil
+}
+
+// DeleteResourceBlob deletes the content of the resource from the local disk or the storages,
+// the content stored in the database is deleted with the resource.
+func DeleteResourceBlob(ctx context.Context, s *store.Store, resource *store.Resource) error {
+	s.DeleteResourceLocalFiles(resource)
+	if resource.ExternalLink == "" {
+		return nil
+	}
+
+	s3Clients, err := listS3Clients(ctx, s)
+	if err != nil {
+		return err
+	}
+	for _, s3Client := range s3Clients {
+		ok, err := s3Client.DeleteFile(ctx, resource.ExternalLink)
+		if err != nil {
+			return errors.Wrap(err, "Failed to delete via s3 client")
+		}
+		if ok {
+			return nil
+		}
+	}
+	return nil
+}
+
+// listS3Clients returns the clients of all the S3 storages, as the resources may be uploaded before the current storage is set.
+func listS3Clients(ctx context.Context, s *store.Store) ([]*s3.Client, error) {
+	storages, err := s.ListStorages(ctx, &store.FindStorage{})
+	if err != nil {
+		return nil, errors.Wrap(err, "Failed to list storages")
+	}
+
+	s3Clients := []*s3.Client{}
+	for _, storage := range storages {
+		storageMessage, err := ConvertStorageFromStore(storage)
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to ConvertStorageFromStore")
+		}
+		if storageMessage.Type != StorageS3 || storageMessage.Config.S3Config == nil {
+			continue
+		}
+		s3Config := storageMessage.Config.S3Config
+		s3Client, err := s3.NewClient(ctx, &s3.Config{
+			AccessKey: s3Config.AccessKey,
+			SecretKey: s3Config.SecretKey,
+			EndPoint:  s3Config.EndPoint,
+			Region:    s3Config.Region,
+			Bucket:    s3Config.Bucket,
+			URLPrefix: s3Config.URLPrefix,
+			URLSuffix: s3Config.URLSuffix,
+			PreSign:   s3Config.PreSign,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "Failed to create s3 client")
+		}
+		s3Clients = append(s3Clients, s3Client)
+	}
+	return s3Clients, nil
+}
@@ -8,6 +8,8 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/usememos/memos/internal/util"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/route/api/auth"
 	"github.com/usememos/memos/store"
 )
 
@@ -95,8 +97,8 @@ func (s *APIV1Service) GetStorageList(c echo.Context) error {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 	}
 	// We should only show storage list to host user.
-	if user == nil || user.Role != store.RoleHost {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	if err := s.checkPermission(ctx, user, auth.PermissionManageStorage); err != nil {
+		return err
 	}
 
 	list, err := s.Store.ListStorages(ctx, &store.FindStorage{})
@@ -110,7 +112,7 @@ func (s *APIV1Service) GetStorageList(c echo.Context) error {
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to convert storage").SetInternal(err)
 		}
-		storageList = append(storageList, storageMessage)
+		storageList = append(storageList, redactStorage(storageMessage))
 	}
 	return c.JSON(http.StatusOK, storageList)
 }
@@ -140,8 +142,8 @@ func (s *APIV1Service) CreateStorage(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 	}
-	if user == nil || user.Role != store.RoleHost {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	if err := s.checkPermission(ctx, user, auth.PermissionManageStorage); err != nil {
+		return err
 	}
 
 	create := &CreateStorageRequest{}
@@ -170,7 +172,14 @@ func (s *APIV1Service) CreateStorage(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to convert storage").SetInternal(err)
 	}
-	return c.JSON(http.StatusOK, storageMessage)
+	s.createAuditLog(c, &store.AuditLog{
+		Action: store.AuditActionStorageCreate,
+		Target: fmt.Sprintf("storages/%d", storage.ID),
+		Payload: &storepb.AuditLogPayload{
+			After: auth.NewAuditSnapshot(storageMessage),
+		},
+	})
+	return c.JSON(http.StatusOK, redactStorage(storageMessage))
 }
 
 // DeleteStorage godoc
@@ -200,8 +209,8 @@ func (s *APIV1Service) DeleteStorage(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 	}
-	if user == nil || user.Role != store.RoleHost {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	if err := s.checkPermission(ctx, user, auth.PermissionManageStorage); err != nil {
+		return err
 	}
 
 	storageID, err := util.ConvertStringToInt32(c.Param("storageId"))
@@ -224,9 +233,28 @@ func (s *APIV1Service) DeleteStorage(c echo.Context) error {
 		}
 	}
 
+	storage, err := s.Store.GetStorage(ctx, &store.FindStorage{ID: &storageID})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find storage").SetInternal(err)
+	}
+	if storage == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Storage not found with ID: %d", storageID))
+	}
+	storageMessage, err := ConvertStorageFromStore(storage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to convert storage").SetInternal(err)
+	}
+
 	if err = s.Store.DeleteStorage(ctx, &store.DeleteStorage{ID: storageID}); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete storage").SetInternal(err)
 	}
+	s.createAuditLog(c, &store.AuditLog{
+		Action: store.AuditActionStorageDelete,
+		Target: fmt.Sprintf("storages/%d", storageID),
+		Payload: &storepb.AuditLogPayload{
+			Before: auth.NewAuditSnapshot(storageMessage),
+		},
+	})
 	return c.JSON(http.StatusOK, true)
 }
 
@@ -255,8 +283,8 @@ func (s *APIV1Service) UpdateStorage(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 	}
-	if user == nil || user.Role != store.RoleHost {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	if err := s.checkPermission(ctx, user, auth.PermissionManageStorage); err != nil {
+		return err
 	}
 
 	storageID, err := util.ConvertStringToInt32(c.Param("storageId"))
@@ -268,6 +296,18 @@ func (s *APIV1Service) UpdateStorage(c echo.Context) error {
 	if err := json.NewDecoder(c.Request().Body).Decode(update); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "Malformatted patch storage request").SetInternal(err)
 	}
+	storage, err := s.Store.GetStorage(ctx, &store.FindStorage{ID: &storageID})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find storage").SetInternal(err)
+	}
+	if storage == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Storage not found with ID: %d", storageID))
+	}
+	before, err := ConvertStorageFromStore(storage)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to convert storage").SetInternal(err)
+	}
+
 	storageUpdate := &store.UpdateStorage{
 		ID: storageID,
 	}
@@ -276,6 +316,10 @@ func (s *APIV1Service) UpdateStorage(c echo.Context) error {
 	}
 	if update.Config != nil {
 		if update.Type == StorageS3 {
+			// The secret key is redacted in the responses, keep the current one if it is sent back as is.
+			if update.Config.S3Config != nil && update.Config.S3Config.SecretKey == auth.RedactedValue && before.Config.S3Config != nil {
+				update.Config.S3Config.SecretKey = before.Config.S3Config.SecretKey
+			}
 			configBytes, err := json.Marshal(update.Config.S3Config)
 			if err != nil {
 				return echo.NewHTTPError(http.StatusBadRequest, "Malformatted post storage request").SetInternal(err)
@@ -285,7 +329,7 @@ func (s *APIV1Service) UpdateStorage(c echo.Context) error {
 		}
 	}
 
-	storage, err := s.Store.UpdateStorage(ctx, storageUpdate)
+	storage, err = s.Store.UpdateStorage(ctx, storageUpdate)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to patch storage").SetInternal(err)
 	}
@@ -293,7 +337,29 @@ func (s *APIV1Service) UpdateStorage(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to convert storage").SetInternal(err)
 	}
-	return c.JSON(http.StatusOK, storageMessage)
+	auditLog := &store.AuditLog{
+		Action: store.AuditActionStorageUpdate,
+		Target: fmt.Sprintf("storages/%d", storageID),
+		Payload: &storepb.AuditLogPayload{
+			Before: auth.NewAuditSnapshot(before),
+			After:  auth.NewAuditSnapshot(storageMessage),
+		},
+	}
+	// The secrets are redacted in the snapshots, so tell when they are rotated.
+	if before.Config.S3Config != nil && storageMessage.Config.S3Config != nil &&
+		(before.Config.S3Config.AccessKey != storageMessage.Config.S3Config.AccessKey || before.Config.S3Config.SecretKey != storageMessage.Config.S3Config.SecretKey) {
+		auditLog.Payload.Message = "credentials rotated"
+	}
+	s.createAuditLog(c, auditLog)
+	return c.JSON(http.StatusOK, redactStorage(storageMessage))
+}
+
+// redactStorage hides the secret key of the storage returned by the API.
+func redactStorage(storage *Storage) *Storage {
+	if storage.Config != nil && storage.Config.S3Config != nil && storage.Config.S3Config.SecretKey != "" {
+		storage.Config.S3Config.SecretKey = auth.RedactedValue
+	}
+	return storage
 }
 
 func ConvertStorageFromStore(storage *store.Storage) (*Storage, error) {
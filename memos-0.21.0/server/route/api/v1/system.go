@@ -7,6 +7,7 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/usememos/memos/server/profile"
+	"github.com/usememos/memos/server/route/api/auth"
 	"github.com/usememos/memos/store"
 )
 
@@ -156,8 +157,8 @@ func (s *APIV1Service) ExecVacuum(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 	}
-	if user == nil || user.Role != store.RoleHost {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	if err := s.checkPermission(ctx, user, auth.PermissionManageWorkspace); err != nil {
+		return err
 	}
 
 	if err := s.Store.Vacuum(ctx); err != nil {
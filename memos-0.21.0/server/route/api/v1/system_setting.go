@@ -2,6 +2,7 @@ package v1
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
 	"path/filepath"
 	"strings"
@@ -9,6 +10,8 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/route/api/auth"
 	"github.com/usememos/memos/store"
 )
 
@@ -54,6 +57,12 @@ func (key SystemSettingName) String() string {
 	return string(key)
 }
 
+// IsSecret returns true if the value of the system setting is a secret, which is redacted in the responses.
+func (key SystemSettingName) IsSecret() bool {
+	// The SMTP setting of the v2 API is listed as a whole, including its password.
+	return key == SystemSettingSecretSessionName || key == SystemSettingTelegramBotTokenName || key == "WORKSPACE_SETTING_SMTP"
+}
+
 type SystemSetting struct {
 	Name SystemSettingName `json:"name"`
 	// Value is a JSON string with basic value.
@@ -94,8 +103,8 @@ func (s *APIV1Service) GetSystemSettingList(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 	}
-	if user == nil || user.Role != store.RoleHost {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	if err := s.checkPermission(ctx, user, auth.PermissionManageWorkspace); err != nil {
+		return err
 	}
 
 	list, err := s.Store.ListWorkspaceSettings(ctx, &store.FindWorkspaceSetting{})
@@ -135,17 +144,36 @@ func (s *APIV1Service) CreateSystemSetting(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
 	}
-	if user == nil || user.Role != store.RoleHost {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized")
+	if err := s.checkPermission(ctx, user, auth.PermissionManageWorkspace); err != nil {
+		return err
 	}
 
 	systemSettingUpsert := &UpsertSystemSettingRequest{}
 	if err := json.NewDecoder(c.Request().Body).Decode(systemSettingUpsert); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "Malformatted post system setting request").SetInternal(err)
 	}
+
+	before, err := s.Store.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{
+		Name: systemSettingUpsert.Name.String(),
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find system setting").SetInternal(err)
+	}
+	// The secrets are redacted in the responses, keep the current one if it is sent back as is.
+	if systemSettingUpsert.Name.IsSecret() && systemSettingUpsert.Value == auth.RedactedValue && before != nil {
+		systemSettingUpsert.Value = before.Value
+	}
 	if err := systemSettingUpsert.Validate(); err != nil {
 		return echo.NewHTTPError(http.StatusBadRequest, "invalid system setting").SetInternal(err)
 	}
+	auditLog := &store.AuditLog{
+		Action:  store.AuditActionWorkspaceSettingUpdate,
+		Target:  fmt.Sprintf("settings/%s", systemSettingUpsert.Name),
+		Payload: &storepb.AuditLogPayload{},
+	}
+	if before != nil {
+		auditLog.Payload.Before = auth.NewAuditSnapshot(convertSystemSettingFromStore(before))
+	}
 
 	systemSetting, err := s.Store.UpsertWorkspaceSetting(ctx, &store.WorkspaceSetting{
 		Name:        systemSettingUpsert.Name.String(),
@@ -155,7 +183,10 @@ func (s *APIV1Service) CreateSystemSetting(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to upsert system setting").SetInternal(err)
 	}
-	return c.JSON(http.StatusOK, convertSystemSettingFromStore(systemSetting))
+	systemSettingMessage := convertSystemSettingFromStore(systemSetting)
+	auditLog.Payload.After = auth.NewAuditSnapshot(systemSettingMessage)
+	s.createAuditLog(c, auditLog)
+	return c.JSON(http.StatusOK, systemSettingMessage)
 }
 
 func (upsert UpsertSystemSettingRequest) Validate() error {
@@ -241,9 +272,13 @@ func (upsert UpsertSystemSettingRequest) Validate() error {
 }
 
 func convertSystemSettingFromStore(systemSetting *store.WorkspaceSetting) *SystemSetting {
-	return &SystemSetting{
+	systemSettingMessage := &SystemSetting{
 		Name:        SystemSettingName(systemSetting.Name),
 		Value:       systemSetting.Value,
 		Description: systemSetting.Description,
 	}
+	if systemSettingMessage.Name.IsSecret() && systemSettingMessage.Value != "" {
+		systemSettingMessage.Value = auth.RedactedValue
+	}
+	return systemSettingMessage
 }
@@ -1,8 +1,10 @@
 package v1
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"strings"
 	"time"
@@ -12,6 +14,8 @@ import (
 	"golang.org/x/crypto/bcrypt"
 
 	"github.com/usememos/memos/internal/util"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/route/api/auth"
 	"github.com/usememos/memos/store"
 )
 
@@ -99,8 +103,8 @@ func (s *APIV1Service) GetUserList(c echo.Context) error {
 	if currentUser == nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, "Missing auth session")
 	}
-	if currentUser.Role != store.RoleHost && currentUser.Role != store.RoleAdmin {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized to list users")
+	if err := s.checkPermission(ctx, currentUser, auth.PermissionManageUsers); err != nil {
+		return err
 	}
 
 	list, err := s.Store.ListUsers(ctx, &store.FindUser{})
@@ -146,9 +150,6 @@ func (s *APIV1Service) CreateUser(c echo.Context) error {
 	if currentUser == nil {
 		return echo.NewHTTPError(http.StatusUnauthorized, "Missing auth session")
 	}
-	if currentUser.Role != store.RoleHost {
-		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized to create user")
-	}
 
 	userCreate := &CreateUserRequest{}
 	if err := json.NewDecoder(c.Request().Body).Decode(userCreate); err != nil {
@@ -160,11 +161,24 @@ func (s *APIV1Service) CreateUser(c echo.Context) error {
 	if !util.UIDMatcher.MatchString(strings.ToLower(userCreate.Username)) {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid username %s", userCreate.Username)).SetInternal(err)
 	}
+	if userCreate.Role == "" {
+		userCreate.Role = RoleUser
+	}
 	// Disallow host user to be created.
 	if userCreate.Role == RoleHost {
 		return echo.NewHTTPError(http.StatusForbidden, "Could not create host user")
 	}
+	evaluator, err := auth.GetEvaluator(ctx, s.Store)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get permissions").SetInternal(err)
+	}
+	if !evaluator.CanAssignRole(currentUser, store.Role(userCreate.Role)) {
+		return echo.NewHTTPError(http.StatusUnauthorized, "Unauthorized to create user")
+	}
 
+	if err := s.validatePassword(ctx, userCreate.Password); err != nil {
+		return err
+	}
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte(userCreate.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate password hash").SetInternal(err)
@@ -180,8 +194,18 @@ func (s *APIV1Service) CreateUser(c echo.Context) error {
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to create user").SetInternal(err)
 	}
+	if err := s.recordPasswordChange(ctx, user.ID); err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to record password change").SetInternal(err)
+	}
 
 	userMessage := convertUserFromStore(user)
+	s.createAuditLog(c, &store.AuditLog{
+		Action: store.AuditActionUserCreate,
+		Target: fmt.Sprintf("users/%d", user.ID),
+		Payload: &storepb.AuditLogPayload{
+			After: auth.NewAuditSnapshot(userMessage),
+		},
+	})
 	return c.JSON(http.StatusOK, userMessage)
 }
 
@@ -302,8 +326,6 @@ func (s *APIV1Service) DeleteUser(c echo.Context) error {
 	}
 	if currentUser == nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Current session user not found with ID: %d", currentUserID)).SetInternal(err)
-	} else if currentUser.Role != store.RoleHost {
-		return echo.NewHTTPError(http.StatusForbidden, "Unauthorized to delete user").SetInternal(err)
 	}
 
 	userID, err := util.ConvertStringToInt32(c.Param("id"))
@@ -313,12 +335,39 @@ func (s *APIV1Service) DeleteUser(c echo.Context) error {
 	if currentUserID == userID {
 		return echo.NewHTTPError(http.StatusBadRequest, "Cannot delete current user")
 	}
+	if err := s.checkCanManageUser(ctx, currentUser, userID); err != nil {
+		return err
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+	}
+	if user == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("User not found with ID: %d", userID))
+	}
 
+	// The files of the resources are deleted once their rows are.
+	resources, err := s.Store.ListResources(ctx, &store.FindResource{CreatorID: &userID})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find resources").SetInternal(err)
+	}
 	if err := s.Store.DeleteUser(ctx, &store.DeleteUser{
 		ID: userID,
 	}); err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to delete user").SetInternal(err)
 	}
+	for _, resource := range resources {
+		if err := DeleteResourceBlob(ctx, s.Store, resource); err != nil {
+			slog.Warn("failed to delete resource blob", slog.String("resource", resource.UID), slog.Any("error", err))
+		}
+	}
+	s.createAuditLog(c, &store.AuditLog{
+		Action: store.AuditActionUserDelete,
+		Target: fmt.Sprintf("users/%d", userID),
+		Payload: &storepb.AuditLogPayload{
+			Before: auth.NewAuditSnapshot(convertUserFromStore(user)),
+		},
+	})
 	return c.JSON(http.StatusOK, true)
 }
 
@@ -352,8 +401,9 @@ func (s *APIV1Service) UpdateUser(c echo.Context) error {
 	}
 	if currentUser == nil {
 		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Current session user not found with ID: %d", currentUserID)).SetInternal(err)
-	} else if currentUser.Role != store.RoleHost && currentUserID != userID {
-		return echo.NewHTTPError(http.StatusForbidden, "Unauthorized to update user").SetInternal(err)
+	}
+	if err := s.checkCanManageUser(ctx, currentUser, userID); err != nil {
+		return err
 	}
 
 	request := &UpdateUserRequest{}
@@ -383,12 +433,25 @@ func (s *APIV1Service) UpdateUser(c echo.Context) error {
 		userUpdate.Username = request.Username
 	}
 	if request.Email != nil {
+		// The users changing their own email confirm it by email when the workspace can send emails.
+		if currentUserID == userID && *request.Email != currentUser.Email {
+			smtpSetting, err := s.Store.GetWorkspaceSmtpSetting(ctx)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find system setting").SetInternal(err)
+			}
+			if smtpSetting != nil {
+				return echo.NewHTTPError(http.StatusBadRequest, "The email change must be confirmed by email")
+			}
+		}
 		userUpdate.Email = request.Email
 	}
 	if request.Nickname != nil {
 		userUpdate.Nickname = request.Nickname
 	}
 	if request.Password != nil {
+		if err := s.validatePassword(ctx, *request.Password); err != nil {
+			return err
+		}
 		passwordHash, err := bcrypt.GenerateFromPassword([]byte(*request.Password), bcrypt.DefaultCost)
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to generate password hash").SetInternal(err)
@@ -401,15 +464,60 @@ func (s *APIV1Service) UpdateUser(c echo.Context) error {
 		userUpdate.AvatarURL = request.AvatarURL
 	}
 
+	before, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+	}
+	if before == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("User not found with ID: %d", userID))
+	}
+	// Snapshot the user before updating it, as the store may return the cached user.
+	auditLog := &store.AuditLog{
+		Action: store.AuditActionUserUpdate,
+		Target: fmt.Sprintf("users/%d", userID),
+		Payload: &storepb.AuditLogPayload{
+			Before: auth.NewAuditSnapshot(convertUserFromStore(before)),
+		},
+	}
+	if userUpdate.PasswordHash != nil {
+		auditLog.Payload.Message = "password changed"
+	}
+
 	user, err := s.Store.UpdateUser(ctx, userUpdate)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to patch user").SetInternal(err)
 	}
+	if userUpdate.PasswordHash != nil {
+		if err := s.recordPasswordChange(ctx, user.ID); err != nil {
+			return echo.NewHTTPError(http.StatusInternalServerError, "Failed to record password change").SetInternal(err)
+		}
+	}
 
 	userMessage := convertUserFromStore(user)
+	auditLog.Payload.After = auth.NewAuditSnapshot(userMessage)
+	s.createAuditLog(c, auditLog)
 	return c.JSON(http.StatusOK, userMessage)
 }
 
+// checkCanManageUser returns an HTTP error unless the user is allowed to manage the user with the given ID.
+func (s *APIV1Service) checkCanManageUser(ctx context.Context, user *store.User, userID int32) error {
+	target, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+	}
+	if target == nil {
+		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("User not found with ID: %d", userID))
+	}
+	evaluator, err := auth.GetEvaluator(ctx, s.Store)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to get permissions").SetInternal(err)
+	}
+	if !evaluator.CanManageUser(user, target) {
+		return echo.NewHTTPError(http.StatusForbidden, "Unauthorized to manage user")
+	}
+	return nil
+}
+
 func (create CreateUserRequest) Validate() error {
 	if len(create.Username) < 3 {
 		return errors.New("username is too short, minimum length is 3")
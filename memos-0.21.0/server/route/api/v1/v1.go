@@ -1,16 +1,13 @@
 package v1
 
 import (
-	"net/http"
-	"time"
-
 	"github.com/labstack/echo/v4"
-	"github.com/labstack/echo/v4/middleware"
 
+	"github.com/usememos/memos/internal/ratelimit"
 	"github.com/usememos/memos/plugin/telegram"
 	"github.com/usememos/memos/server/profile"
+	"github.com/usememos/memos/server/route/api/auth"
 	"github.com/usememos/memos/server/route/resource"
-	"github.com/usememos/memos/server/route/rss"
 	"github.com/usememos/memos/store"
 )
 
@@ -19,6 +16,10 @@ type APIV1Service struct {
 	Profile     *profile.Profile
 	Store       *store.Store
 	telegramBot *telegram.Bot
+	rateLimiter *ratelimit.Limiter
+	loginGuard  *ratelimit.LoginGuard
+
+	proxyAuthenticator *auth.ProxyAuthenticator
 }
 
 // @title						memos API
@@ -35,36 +36,31 @@ type APIV1Service struct {
 //
 // @externalDocs.url			https://usememos.com/
 // @externalDocs.description	Find out more about Memos.
-func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, telegramBot *telegram.Bot) *APIV1Service {
+func NewAPIV1Service(secret string, profile *profile.Profile, store *store.Store, telegramBot *telegram.Bot, rateLimiter *ratelimit.Limiter, loginGuard *ratelimit.LoginGuard, proxyAuthenticator *auth.ProxyAuthenticator) *APIV1Service {
 	return &APIV1Service{
 		Secret:      secret,
 		Profile:     profile,
 		Store:       store,
 		telegramBot: telegramBot,
+		rateLimiter: rateLimiter,
+		loginGuard:  loginGuard,
+
+		proxyAuthenticator: proxyAuthenticator,
 	}
 }
 
 func (s *APIV1Service) Register(rootGroup *echo.Group) {
 	// Register API v1 routes.
 	apiV1Group := rootGroup.Group("/api/v1")
-	apiV1Group.Use(middleware.RateLimiterWithConfig(middleware.RateLimiterConfig{
-		Store: middleware.NewRateLimiterMemoryStoreWithConfig(
-			middleware.RateLimiterMemoryStoreConfig{Rate: 30, Burst: 100, ExpiresIn: 3 * time.Minute},
-		),
-		IdentifierExtractor: func(ctx echo.Context) (string, error) {
-			id := ctx.RealIP()
-			return id, nil
-		},
-		ErrorHandler: func(context echo.Context, err error) error {
-			return context.JSON(http.StatusForbidden, nil)
-		},
-		DenyHandler: func(context echo.Context, identifier string, err error) error {
-			return context.JSON(http.StatusTooManyRequests, nil)
-		},
-	}))
+	apiV1Group.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return IPRateLimitMiddleware(s, next)
+	})
 	apiV1Group.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
 		return JWTMiddleware(s, next, s.Secret)
 	})
+	apiV1Group.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return RateLimitMiddleware(s, next)
+	})
 	s.registerSystemRoutes(apiV1Group)
 	s.registerSystemSettingRoutes(apiV1Group)
 	s.registerAuthRoutes(apiV1Group)
@@ -79,16 +75,19 @@ func (s *APIV1Service) Register(rootGroup *echo.Group) {
 
 	// Register public routes.
 	publicGroup := rootGroup.Group("/o")
+	publicGroup.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return IPRateLimitMiddleware(s, next)
+	})
 	publicGroup.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
 		return JWTMiddleware(s, next, s.Secret)
 	})
+	publicGroup.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return RateLimitMiddleware(s, next)
+	})
 	s.registerGetterPublicRoutes(publicGroup)
 
 	// Create and register resource public routes.
-	resource.NewResourceService(s.Profile, s.Store).RegisterRoutes(publicGroup)
-
-	// Create and register rss public routes.
-	rss.NewRSSService(s.Profile, s.Store).RegisterRoutes(rootGroup)
+	resource.NewResourceService(s.Profile, s.Store, s.Secret).RegisterRoutes(publicGroup)
 
 	// programmatically set API version same as the server version
 	SwaggerInfo.Version = s.Profile.Version
@@ -2,18 +2,21 @@ package v2
 
 import (
 	"context"
+	"crypto/subtle"
+	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
 	"github.com/usememos/memos/internal/util"
-	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/route/api/auth"
 	"github.com/usememos/memos/store"
 )
@@ -25,19 +28,36 @@ const (
 	// The key name used to store username in the context
 	// user id is extracted from the jwt token subject field.
 	usernameContextKey ContextKey = iota
+	// The key name used to store the access token of the request in the context.
+	accessTokenContextKey
+	// The key name used to store the IP of the client of the request in the context.
+	clientIPContextKey
+)
+
+const (
+	// gatewaySecretMetadataKey is the metadata key of the secret the gRPC gateway sends along with the remote address.
+	gatewaySecretMetadataKey = "x-memos-gateway-secret"
+	// remoteAddrMetadataKey is the metadata key of the remote address of the HTTP request proxied by the gRPC gateway.
+	remoteAddrMetadataKey = "x-memos-remote-addr"
 )
 
 // GRPCAuthInterceptor is the auth interceptor for gRPC server.
 type GRPCAuthInterceptor struct {
 	Store  *store.Store
 	secret string
+
+	proxyAuthenticator *auth.ProxyAuthenticator
+	// gatewaySecret proves that the remote address metadata was set by our own gRPC gateway.
+	gatewaySecret string
 }
 
 // NewGRPCAuthInterceptor returns a new API auth interceptor.
-func NewGRPCAuthInterceptor(store *store.Store, secret string) *GRPCAuthInterceptor {
+func NewGRPCAuthInterceptor(store *store.Store, secret string, proxyAuthenticator *auth.ProxyAuthenticator, gatewaySecret string) *GRPCAuthInterceptor {
 	return &GRPCAuthInterceptor{
-		Store:  store,
-		secret: secret,
+		Store:              store,
+		secret:             secret,
+		proxyAuthenticator: proxyAuthenticator,
+		gatewaySecret:      gatewaySecret,
 	}
 }
 
@@ -52,13 +72,24 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 		return nil, status.Errorf(codes.Unauthenticated, err.Error())
 	}
 
-	username, err := in.authenticate(ctx, accessToken)
+	// Users authenticated by a trusted reverse proxy need no access token and are not restricted by scopes.
+	username, err := in.authenticateByProxy(ctx, md)
 	if err != nil {
-		if isUnauthorizeAllowedMethod(serverInfo.FullMethod) {
-			return handler(ctx, request)
-		}
 		return nil, err
 	}
+	var scopes []string
+	if username == "" {
+		username, scopes, err = in.authenticate(ctx, accessToken)
+		if err != nil {
+			if isUnauthorizeAllowedMethod(serverInfo.FullMethod) {
+				return handler(ctx, request)
+			}
+			return nil, err
+		}
+	}
+	if requiredScope := getRequiredScope(serverInfo.FullMethod); !auth.HasScope(scopes, requiredScope) {
+		return nil, status.Errorf(codes.PermissionDenied, "access token does not have the required scope %q", requiredScope)
+	}
 	user, err := in.Store.GetUser(ctx, &store.FindUser{
 		Username: &username,
 	})
@@ -69,20 +100,44 @@ func (in *GRPCAuthInterceptor) AuthenticationInterceptor(ctx context.Context, re
 		return nil, errors.Errorf("user %q not exists", username)
 	}
 	if user.RowStatus == store.Archived {
-		return nil, errors.Errorf("user %q is archived", username)
+		return nil, status.Errorf(codes.PermissionDenied, "user %q is archived", username)
+	}
+	suspension, err := auth.GetActiveSuspension(ctx, in.Store, user.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get suspension")
+	}
+	if suspension != nil {
+		return nil, status.Error(codes.PermissionDenied, auth.FormatSuspension(suspension))
+	}
+	if permission, ok := getRequiredPermission(serverInfo.FullMethod); ok {
+		allowed, err := auth.HasPermission(ctx, in.Store, user, permission)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to check permission")
+		}
+		if !allowed {
+			return nil, status.Errorf(codes.PermissionDenied, "user %q does not have the permission %q", username, permission)
+		}
 	}
-	if isOnlyForAdminAllowedMethod(serverInfo.FullMethod) && user.Role != store.RoleHost && user.Role != store.RoleAdmin {
-		return nil, errors.Errorf("user %q is not admin", username)
+
+	if accessToken != "" {
+		clientIP := getClientIPFromContext(ctx)
+		if err := in.Store.UpdateUserAccessTokenLastUsed(ctx, user.ID, accessToken, clientIP); err != nil {
+			slog.Warn("failed to update access token last used", slog.Any("error", err))
+		}
+		if err := in.Store.UpdateUserSessionLastSeen(ctx, user.ID, accessToken, clientIP); err != nil {
+			slog.Warn("failed to update session last seen", slog.Any("error", err))
+		}
 	}
 
 	// Stores userID into context.
 	childCtx := context.WithValue(ctx, usernameContextKey, username)
+	childCtx = context.WithValue(childCtx, accessTokenContextKey, accessToken)
 	return handler(childCtx, request)
 }
 
-func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken string) (string, error) {
+func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken string) (string, []string, error) {
 	if accessToken == "" {
-		return "", status.Errorf(codes.Unauthenticated, "access token not found")
+		return "", nil, status.Errorf(codes.Unauthenticated, "access token not found")
 	}
 	claims := &auth.ClaimsMessage{}
 	_, err := jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
@@ -97,38 +152,90 @@ func (in *GRPCAuthInterceptor) authenticate(ctx context.Context, accessToken str
 		return nil, status.Errorf(codes.Unauthenticated, "unexpected access token kid=%v", t.Header["kid"])
 	})
 	if err != nil {
-		return "", status.Errorf(codes.Unauthenticated, "Invalid or expired access token")
+		return "", nil, status.Errorf(codes.Unauthenticated, "Invalid or expired access token")
 	}
 
 	// We either have a valid access token or we will attempt to generate new access token.
 	userID, err := util.ConvertStringToInt32(claims.Subject)
 	if err != nil {
-		return "", errors.Wrap(err, "malformed ID in the token")
+		return "", nil, errors.Wrap(err, "malformed ID in the token")
 	}
 	user, err := in.Store.GetUser(ctx, &store.FindUser{
 		ID: &userID,
 	})
 	if err != nil {
-		return "", errors.Wrap(err, "failed to get user")
+		return "", nil, errors.Wrap(err, "failed to get user")
 	}
 	if user == nil {
-		return "", errors.Errorf("user %q not exists", userID)
+		return "", nil, errors.Errorf("user %q not exists", userID)
 	}
 	if user.RowStatus == store.Archived {
-		return "", errors.Errorf("user %q is archived", userID)
+		return "", nil, status.Errorf(codes.PermissionDenied, "user %d is archived", userID)
 	}
 
-	accessTokens, err := in.Store.GetUserAccessTokens(ctx, user.ID)
+	userAccessToken, err := in.Store.FindUserAccessToken(ctx, user.ID, accessToken)
 	if err != nil {
-		return "", errors.Wrapf(err, "failed to get user access tokens")
+		return "", nil, errors.Wrapf(err, "failed to get user access tokens")
 	}
-	if !validateAccessToken(accessToken, accessTokens) {
-		return "", status.Errorf(codes.Unauthenticated, "invalid access token")
+	if userAccessToken != nil {
+		if userAccessToken.ExpiresTs != 0 && userAccessToken.ExpiresTs < time.Now().Unix() {
+			return "", nil, status.Errorf(codes.Unauthenticated, "access token expired")
+		}
+		return user.Username, userAccessToken.Scopes, nil
 	}
 
+	// Sign-in sessions are not restricted by scopes.
+	session, err := in.Store.FindUserSession(ctx, user.ID, accessToken)
+	if err != nil {
+		return "", nil, errors.Wrapf(err, "failed to get user sessions")
+	}
+	if session == nil {
+		return "", nil, status.Errorf(codes.Unauthenticated, "invalid access token")
+	}
+	if session.ExpiresTs != 0 && session.ExpiresTs < time.Now().Unix() {
+		return "", nil, status.Errorf(codes.Unauthenticated, "session expired")
+	}
+	return user.Username, nil, nil
+}
+
+// authenticateByProxy returns the username set in the trusted header by a trusted reverse proxy,
+// or an empty string if the request does not come from one.
+func (in *GRPCAuthInterceptor) authenticateByProxy(ctx context.Context, md metadata.MD) (string, error) {
+	header := in.proxyAuthenticator.Header()
+	if header == "" {
+		return "", nil
+	}
+	usernames := md.Get(header)
+	if len(usernames) == 0 {
+		return "", nil
+	}
+	user, err := in.proxyAuthenticator.Authenticate(ctx, getRemoteAddr(ctx, md, in.gatewaySecret), usernames[0])
+	if err != nil {
+		return "", status.Errorf(codes.Unauthenticated, "failed to authenticate by proxy header: %v", err)
+	}
+	if user == nil {
+		return "", nil
+	}
 	return user.Username, nil
 }
 
+// getRemoteAddr returns the address of the peer that sent the request to the server.
+// It never trusts headers set by clients, so it is safe to check against the trusted proxies.
+func getRemoteAddr(ctx context.Context, md metadata.MD, gatewaySecret string) string {
+	// Requests through the gRPC gateway come from a local connection, the gateway passes the real remote address along.
+	gatewaySecrets := md.Get(gatewaySecretMetadataKey)
+	remoteAddrs := md.Get(remoteAddrMetadataKey)
+	if len(gatewaySecrets) > 0 && len(remoteAddrs) > 0 &&
+		subtle.ConstantTimeCompare([]byte(gatewaySecrets[len(gatewaySecrets)-1]), []byte(gatewaySecret)) == 1 {
+		// The values set by the gateway come after any forwarded by clients.
+		return remoteAddrs[len(remoteAddrs)-1]
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		return p.Addr.String()
+	}
+	return ""
+}
+
 func getTokenFromMetadata(md metadata.MD) (string, error) {
 	// Check the HTTP request header first.
 	authorizationHeaders := md.Get("Authorization")
@@ -152,11 +259,20 @@ func getTokenFromMetadata(md metadata.MD) (string, error) {
 	return accessToken, nil
 }
 
-func validateAccessToken(accessTokenString string, userAccessTokens []*storepb.AccessTokensUserSetting_AccessToken) bool {
-	for _, userAccessToken := range userAccessTokens {
-		if accessTokenString == userAccessToken.AccessToken {
-			return true
-		}
+// getUserAgent returns the user agent of the client sending the request.
+func getUserAgent(md metadata.MD) string {
+	// The gateway forwards the HTTP headers with a prefix.
+	if userAgent := md.Get("grpcgateway-user-agent"); len(userAgent) > 0 {
+		return userAgent[0]
+	}
+	if userAgent := md.Get("user-agent"); len(userAgent) > 0 {
+		return userAgent[0]
 	}
-	return false
+	return ""
+}
+
+// getClientIPFromContext returns the IP of the client sending the request in the context, set by the client IP interceptor.
+func getClientIPFromContext(ctx context.Context) string {
+	clientIP, _ := ctx.Value(clientIPContextKey).(string)
+	return clientIP
 }
@@ -1,5 +1,11 @@
 package v2
 
+import (
+	"strings"
+
+	"github.com/usememos/memos/server/route/api/auth"
+)
+
 var authenticationAllowlistMethods = map[string]bool{
 	"/memos.api.v2.WorkspaceService/GetWorkspaceProfile":        true,
 	"/memos.api.v2.WorkspaceSettingService/GetWorkspaceSetting": true,
@@ -8,14 +14,23 @@ var authenticationAllowlistMethods = map[string]bool{
 	"/memos.api.v2.AuthService/SignInWithSSO":                   true,
 	"/memos.api.v2.AuthService/SignOut":                         true,
 	"/memos.api.v2.AuthService/SignUp":                          true,
+	"/memos.api.v2.AuthService/RequestPasswordReset":            true,
+	"/memos.api.v2.AuthService/ResetPassword":                   true,
+	"/memos.api.v2.AuthService/VerifyEmail":                     true,
+	"/memos.api.v2.AuthService/ResendVerificationEmail":         true,
+	"/memos.api.v2.AuthService/ConfirmEmailChange":              true,
+	"/memos.api.v2.AuthService/ChangePassword":                  true,
 	"/memos.api.v2.UserService/GetUser":                         true,
 	"/memos.api.v2.UserService/SearchUsers":                     true,
 	"/memos.api.v2.MemoService/ListMemos":                       true,
 	"/memos.api.v2.MemoService/GetMemo":                         true,
 	"/memos.api.v2.MemoService/SearchMemos":                     true,
+	"/memos.api.v2.MemoService/GetMemoGraph":                    true,
 	"/memos.api.v2.MemoService/ListMemoResources":               true,
 	"/memos.api.v2.MemoService/ListMemoRelations":               true,
+	"/memos.api.v2.MemoService/ListMemoBacklinks":               true,
 	"/memos.api.v2.MemoService/ListMemoComments":                true,
+	"/memos.api.v2.MemoService/GetSharedMemo":                   true,
 	"/memos.api.v2.LinkService/GetLinkMetadata":                 true,
 }
 
@@ -24,11 +39,103 @@ func isUnauthorizeAllowedMethod(fullMethodName string) bool {
 	return authenticationAllowlistMethods[fullMethodName]
 }
 
-var allowedMethodsOnlyForAdmin = map[string]bool{
-	"/memos.api.v2.UserService/CreateUser": true,
+// methodPermissions are the permissions required to call the methods, on top of being signed in.
+// Methods acting on resources owned by users check the permissions in the handlers instead.
+var methodPermissions = map[string]auth.Permission{
+	"/memos.api.v2.UserService/ListUsers":                       auth.PermissionManageUsers,
+	"/memos.api.v2.UserService/CreateUser":                      auth.PermissionManageUsers,
+	"/memos.api.v2.UserService/ImportUsers":                     auth.PermissionManageUsers,
+	"/memos.api.v2.UserService/ExportUsers":                     auth.PermissionManageUsers,
+	"/memos.api.v2.UserService/SuspendUser":                     auth.PermissionManageUsers,
+	"/memos.api.v2.UserService/UnsuspendUser":                   auth.PermissionManageUsers,
+	"/memos.api.v2.UserService/RequirePasswordChange":           auth.PermissionManageUsers,
+	"/memos.api.v2.AuthService/ListLoginLockouts":               auth.PermissionManageUsers,
+	"/memos.api.v2.AuthService/ClearLoginLockout":               auth.PermissionManageUsers,
+	"/memos.api.v2.WorkspaceSettingService/SetWorkspaceSetting": auth.PermissionManageWorkspace,
+	"/memos.api.v2.AuditLogService/ListAuditLogs":               auth.PermissionViewAuditLog,
+}
+
+// getRequiredPermission returns the permission required to call the method, if any.
+func getRequiredPermission(fullMethodName string) (auth.Permission, bool) {
+	permission, ok := methodPermissions[fullMethodName]
+	return permission, ok
+}
+
+// serviceScopeResources maps each service to the resource part of the scopes guarding it.
+// Services not listed here can only be called with an unrestricted or admin token.
+var serviceScopeResources = map[string]string{
+	"memos.api.v2.MemoService":     "memos",
+	"memos.api.v2.TagService":      "memos",
+	"memos.api.v2.LinkService":     "memos",
+	"memos.api.v2.TaskService":     "memos",
+	"memos.api.v2.ResourceService": "resources",
+	"memos.api.v2.UserService":     "user",
+	"memos.api.v2.GroupService":    "user",
+	"memos.api.v2.InboxService":    "user",
+	"memos.api.v2.WebhookService":  "user",
+	"memos.api.v2.ActivityService": "user",
+	"memos.api.v2.AuthService":     "user",
+}
+
+// publicReadServices are the services whose read methods need no scope at all.
+var publicReadServices = map[string]bool{
+	"memos.api.v2.WorkspaceService":        true,
+	"memos.api.v2.WorkspaceSettingService": true,
+	"memos.api.v2.IdentityProviderService": true,
+}
+
+// methodScopes overrides the scope derived from the service and method name.
+var methodScopes = map[string]string{
+	// A scoped token must not be able to mint tokens with more privileges than itself,
+	// nor manage the sign-in sessions of the account.
+	"/memos.api.v2.UserService/ListUserAccessTokens":   auth.ScopeAdmin,
+	"/memos.api.v2.UserService/CreateUserAccessToken":  auth.ScopeAdmin,
+	"/memos.api.v2.UserService/DeleteUserAccessToken":  auth.ScopeAdmin,
+	"/memos.api.v2.UserService/ListSessions":           auth.ScopeAdmin,
+	"/memos.api.v2.UserService/RevokeSession":          auth.ScopeAdmin,
+	"/memos.api.v2.UserService/RevokeAllOtherSessions": auth.ScopeAdmin,
+	"/memos.api.v2.AuthService/RequestEmailChange":     auth.ScopeAdmin,
+	// The export of the data of the account goes beyond what any scope is limited to.
+	"/memos.api.v2.UserService/ExportMyData": auth.ScopeAdmin,
+}
+
+// getRequiredScope returns the access token scope needed to call the method.
+// An empty scope means the method is allowed for any token.
+func getRequiredScope(fullMethodName string) string {
+	if scope, ok := methodScopes[fullMethodName]; ok {
+		return scope
+	}
+	if _, ok := getRequiredPermission(fullMethodName); ok {
+		return auth.ScopeAdmin
+	}
+
+	service, method, ok := strings.Cut(strings.TrimPrefix(fullMethodName, "/"), "/")
+	if !ok {
+		return auth.ScopeAdmin
+	}
+	isRead := isReadMethod(method)
+	if publicReadServices[service] {
+		if isRead {
+			return ""
+		}
+		return auth.ScopeAdmin
+	}
+	resource, ok := serviceScopeResources[service]
+	if !ok {
+		return auth.ScopeAdmin
+	}
+	if isRead {
+		return resource + ":read"
+	}
+	return resource + ":write"
 }
 
-// isOnlyForAdminAllowedMethod returns true if the method is allowed to be called only by admin.
-func isOnlyForAdminAllowedMethod(methodName string) bool {
-	return allowedMethodsOnlyForAdmin[methodName]
+// isReadMethod returns true if the method does not modify any data.
+func isReadMethod(method string) bool {
+	for _, prefix := range []string{"Get", "List", "Search", "Export"} {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
 }
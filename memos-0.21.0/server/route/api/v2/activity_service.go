@@ -2,6 +2,7 @@ package v2
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"google.golang.org/grpc/codes"
@@ -54,5 +55,20 @@ func convertActivityPayloadFromStore(payload *storepb.ActivityPayload) *apiv2pb.
 			Version: payload.VersionUpdate.Version,
 		}
 	}
+	if payload.LoginLockout != nil {
+		v2Payload.LoginLockout = &apiv2pb.ActivityLoginLockoutPayload{
+			Key:            payload.LoginLockout.Key,
+			FailedAttempts: payload.LoginLockout.FailedAttempts,
+			LockedUntil:    timestamppb.New(time.Unix(payload.LoginLockout.LockedUntilTs, 0)),
+			Ip:             payload.LoginLockout.Ip,
+		}
+	}
+	if payload.TaskOverdue != nil {
+		v2Payload.TaskOverdue = &apiv2pb.ActivityTaskOverduePayload{
+			Task:    fmt.Sprintf("%s%d/%s%d", MemoNamePrefix, payload.TaskOverdue.MemoId, TaskNamePrefix, payload.TaskOverdue.Position),
+			Content: payload.TaskOverdue.Content,
+			DueTime: timestamppb.New(time.Unix(payload.TaskOverdue.DueTs, 0)),
+		}
+	}
 	return v2Payload
 }
@@ -0,0 +1,182 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/cel-go/cel"
+	"github.com/pkg/errors"
+	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV2Service) ListAuditLogs(ctx context.Context, request *apiv2pb.ListAuditLogsRequest) (*apiv2pb.ListAuditLogsResponse, error) {
+	// The caller is granted the permission to view the audit log by the auth interceptor.
+	auditLogFind := &store.FindAuditLog{}
+	if request.Filter != "" {
+		filter, err := parseListAuditLogsFilter(request.Filter)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
+		}
+		if filter.Actor != nil {
+			actorID, err := ExtractUserIDFromName(*filter.Actor)
+			if err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid actor: %v", err)
+			}
+			auditLogFind.ActorID = &actorID
+		}
+		if filter.Action != nil {
+			action := store.AuditAction(*filter.Action)
+			auditLogFind.Action = &action
+		}
+		auditLogFind.Target = filter.Target
+		auditLogFind.CreatedTsAfter = filter.CreateTimeAfter
+		auditLogFind.CreatedTsBefore = filter.CreateTimeBefore
+	}
+
+	var limit, offset int
+	if request.PageToken != "" {
+		var pageToken apiv2pb.PageToken
+		if err := s.unmarshalPageToken(request.PageToken, &pageToken); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+		}
+		limit = int(pageToken.Limit)
+		offset = int(pageToken.Offset)
+	} else {
+		limit = int(request.PageSize)
+	}
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+	limitPlusOne := limit + 1
+	auditLogFind.Limit = &limitPlusOne
+	auditLogFind.Offset = &offset
+	auditLogs, err := s.Store.ListAuditLogs(ctx, auditLogFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list audit logs: %v", err)
+	}
+
+	nextPageToken := ""
+	if len(auditLogs) == limitPlusOne {
+		auditLogs = auditLogs[:limit]
+		nextPageToken, err = s.getPageToken(limit, offset+limit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
+	response := &apiv2pb.ListAuditLogsResponse{
+		AuditLogs:     []*apiv2pb.AuditLog{},
+		NextPageToken: nextPageToken,
+	}
+	for _, auditLog := range auditLogs {
+		response.AuditLogs = append(response.AuditLogs, convertAuditLogFromStore(auditLog))
+	}
+	return response, nil
+}
+
+// createAuditLog records the action of the current user, or the given actor, in the audit log.
+// Failing to record it is logged rather than failing the request, as the action already happened.
+func (s *APIV2Service) createAuditLog(ctx context.Context, auditLog *store.AuditLog) {
+	if auditLog.ActorID == 0 {
+		if user, err := getCurrentUser(ctx, s.Store); err == nil && user != nil {
+			auditLog.ActorID = user.ID
+		}
+	}
+	auditLog.IP = getClientIPFromContext(ctx)
+	if _, err := s.Store.CreateAuditLog(ctx, auditLog); err != nil {
+		slog.Warn("failed to create audit log", slog.String("action", auditLog.Action.String()), slog.Any("error", err))
+	}
+}
+
+func convertAuditLogFromStore(auditLog *store.AuditLog) *apiv2pb.AuditLog {
+	auditLogMessage := &apiv2pb.AuditLog{
+		Id:         auditLog.ID,
+		CreateTime: timestamppb.New(time.Unix(auditLog.CreatedTs, 0)),
+		Action:     auditLog.Action.String(),
+		Target:     auditLog.Target,
+		Ip:         auditLog.IP,
+	}
+	if auditLog.ActorID != 0 {
+		auditLogMessage.Actor = fmt.Sprintf("%s%d", UserNamePrefix, auditLog.ActorID)
+	}
+	if auditLog.Payload != nil {
+		auditLogMessage.Before = auditLog.Payload.Before
+		auditLogMessage.After = auditLog.Payload.After
+		auditLogMessage.Message = auditLog.Payload.Message
+	}
+	return auditLogMessage
+}
+
+// ListAuditLogsFilterCELAttributes are the CEL attributes for ListAuditLogsFilter.
+var ListAuditLogsFilterCELAttributes = []cel.EnvOption{
+	cel.Variable("actor", cel.StringType),
+	cel.Variable("action", cel.StringType),
+	cel.Variable("target", cel.StringType),
+	cel.Variable("create_time_after", cel.IntType),
+	cel.Variable("create_time_before", cel.IntType),
+}
+
+type ListAuditLogsFilter struct {
+	Actor            *string
+	Action           *string
+	Target           *string
+	CreateTimeAfter  *int64
+	CreateTimeBefore *int64
+}
+
+func parseListAuditLogsFilter(expression string) (*ListAuditLogsFilter, error) {
+	e, err := cel.NewEnv(ListAuditLogsFilterCELAttributes...)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := e.Compile(expression)
+	if issues != nil {
+		return nil, errors.Errorf("found issue %v", issues)
+	}
+	filter := &ListAuditLogsFilter{}
+	expr, err := cel.AstToParsedExpr(ast)
+	if err != nil {
+		return nil, err
+	}
+	callExpr := expr.GetExpr().GetCallExpr()
+	findListAuditLogsField(callExpr, filter)
+	return filter, nil
+}
+
+func findListAuditLogsField(callExpr *expr.Expr_Call, filter *ListAuditLogsFilter) {
+	if len(callExpr.Args) == 2 {
+		idExpr := callExpr.Args[0].GetIdentExpr()
+		if idExpr != nil {
+			if idExpr.Name == "actor" {
+				actor := callExpr.Args[1].GetConstExpr().GetStringValue()
+				filter.Actor = &actor
+			} else if idExpr.Name == "action" {
+				action := callExpr.Args[1].GetConstExpr().GetStringValue()
+				filter.Action = &action
+			} else if idExpr.Name == "target" {
+				target := callExpr.Args[1].GetConstExpr().GetStringValue()
+				filter.Target = &target
+			} else if idExpr.Name == "create_time_after" {
+				createTimeAfter := callExpr.Args[1].GetConstExpr().GetInt64Value()
+				filter.CreateTimeAfter = &createTimeAfter
+			} else if idExpr.Name == "create_time_before" {
+				createTimeBefore := callExpr.Args[1].GetConstExpr().GetInt64Value()
+				filter.CreateTimeBefore = &createTimeBefore
+			}
+			return
+		}
+	}
+	for _, arg := range callExpr.Args {
+		callExpr := arg.GetCallExpr()
+		if callExpr != nil {
+			findListAuditLogsField(callExpr, filter)
+		}
+	}
+}
@@ -3,6 +3,7 @@ package v2
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"regexp"
 	"strings"
 	"time"
@@ -13,15 +14,21 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/internal/util"
 	"github.com/usememos/memos/plugin/idp"
 	"github.com/usememos/memos/plugin/idp/oauth2"
+	"github.com/usememos/memos/plugin/mail"
 	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/route/api/auth"
 	"github.com/usememos/memos/store"
 )
 
+// verificationEmailResendInterval is the minimum number of seconds between two verification emails.
+const verificationEmailResendInterval = 60
+
 func (s *APIV2Service) GetAuthStatus(ctx context.Context, _ *apiv2pb.GetAuthStatusRequest) (*apiv2pb.GetAuthStatusResponse, error) {
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
@@ -34,12 +41,22 @@ func (s *APIV2Service) GetAuthStatus(ctx context.Context, _ *apiv2pb.GetAuthStat
 		}
 		return nil, status.Errorf(codes.Unauthenticated, "user not found")
 	}
+	userMessage := convertUserFromStore(user)
+	if userMessage.Permissions, err = s.getUserPermissions(ctx, user); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get permissions: %v", err)
+	}
 	return &apiv2pb.GetAuthStatusResponse{
-		User: convertUserFromStore(user),
+		User: userMessage,
 	}, nil
 }
 
 func (s *APIV2Service) SignIn(ctx context.Context, request *apiv2pb.SignInRequest) (*apiv2pb.SignInResponse, error) {
+	clientIP := getClientIPFromContext(ctx)
+	loginGuardKeys := []string{"ip:" + clientIP, "user:" + request.Username}
+	if err := s.checkLoginGuard(loginGuardKeys...); err != nil {
+		return nil, err
+	}
+
 	user, err := s.Store.GetUser(ctx, &store.FindUser{
 		Username: &request.Username,
 	})
@@ -47,6 +64,7 @@ func (s *APIV2Service) SignIn(ctx context.Context, request *apiv2pb.SignInReques
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to find user by username %s", request.Username))
 	}
 	if user == nil {
+		s.recordLoginFailure(ctx, nil, clientIP, fmt.Sprintf("user not found with username %s", request.Username), loginGuardKeys...)
 		return nil, status.Errorf(codes.InvalidArgument, fmt.Sprintf("user not found with username %s", request.Username))
 	} else if user.RowStatus == store.Archived {
 		return nil, status.Errorf(codes.PermissionDenied, fmt.Sprintf("user has been archived with username %s", request.Username))
@@ -54,8 +72,20 @@ func (s *APIV2Service) SignIn(ctx context.Context, request *apiv2pb.SignInReques
 
 	// Compare the stored hashed password, with the hashed version of the password that was received.
 	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(request.Password)); err != nil {
+		s.recordLoginFailure(ctx, user, clientIP, "unmatched password", loginGuardKeys...)
 		return nil, status.Errorf(codes.InvalidArgument, "unmatched email and password")
 	}
+	// Only the username is cleared, so that a valid account cannot be used to reset the counter of an IP.
+	s.LoginGuard.Succeed("user:" + request.Username)
+	if err := s.checkEmailVerified(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := s.checkUserSuspended(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := s.checkPasswordChangeRequired(ctx, user); err != nil {
+		return nil, err
+	}
 
 	expireTime := time.Now().Add(auth.AccessTokenDuration)
 	if request.NeverExpire {
@@ -71,6 +101,11 @@ func (s *APIV2Service) SignIn(ctx context.Context, request *apiv2pb.SignInReques
 }
 
 func (s *APIV2Service) SignInWithSSO(ctx context.Context, request *apiv2pb.SignInWithSSORequest) (*apiv2pb.SignInWithSSOResponse, error) {
+	clientIP := getClientIPFromContext(ctx)
+	if err := s.checkLoginGuard("ip:" + clientIP); err != nil {
+		return nil, err
+	}
+
 	identityProvider, err := s.Store.GetIdentityProvider(ctx, &store.FindIdentityProvider{
 		ID: &request.IdpId,
 	})
@@ -89,6 +124,7 @@ func (s *APIV2Service) SignInWithSSO(ctx context.Context, request *apiv2pb.SignI
 		}
 		token, err := oauth2IdentityProvider.ExchangeToken(ctx, request.RedirectUri, request.Code)
 		if err != nil {
+			s.recordLoginFailure(ctx, nil, clientIP, fmt.Sprintf("failed to exchange token with identity provider %s", identityProvider.Name), "ip:"+clientIP)
 			return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to exchange token, err: %s", err))
 		}
 		userInfo, err = oauth2IdentityProvider.UserInfo(token)
@@ -135,10 +171,14 @@ func (s *APIV2Service) SignInWithSSO(ctx context.Context, request *apiv2pb.SignI
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to create user, err: %s", err))
 		}
+		s.createSignUpAuditLog(ctx, user)
 	}
 	if user.RowStatus == store.Archived {
 		return nil, status.Errorf(codes.PermissionDenied, fmt.Sprintf("user has been archived with username %s", userInfo.Identifier))
 	}
+	if err := s.checkUserSuspended(ctx, user); err != nil {
+		return nil, err
+	}
 
 	if err := s.doSignIn(ctx, user, time.Now().Add(auth.AccessTokenDuration)); err != nil {
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to sign in, err: %s", err))
@@ -153,8 +193,8 @@ func (s *APIV2Service) doSignIn(ctx context.Context, user *store.User, expireTim
 	if err != nil {
 		return status.Errorf(codes.Internal, fmt.Sprintf("failed to generate tokens, err: %s", err))
 	}
-	if err := s.UpsertAccessTokenToStore(ctx, user, accessToken, "user login"); err != nil {
-		return status.Errorf(codes.Internal, fmt.Sprintf("failed to upsert access token to store, err: %s", err))
+	if err := s.createUserSession(ctx, user, accessToken, expireTime); err != nil {
+		return status.Errorf(codes.Internal, fmt.Sprintf("failed to create session, err: %s", err))
 	}
 
 	cookie, err := s.buildAccessTokenCookie(ctx, accessToken, expireTime)
@@ -167,18 +207,44 @@ func (s *APIV2Service) doSignIn(ctx context.Context, user *store.User, expireTim
 		return status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
 	}
 
+	s.createAuditLog(ctx, &store.AuditLog{
+		ActorID: user.ID,
+		Action:  store.AuditActionSignIn,
+		Target:  fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+	})
 	return nil
 }
 
+// createSignUpAuditLog records the creation of the user signing up, who is the actor.
+func (s *APIV2Service) createSignUpAuditLog(ctx context.Context, user *store.User) {
+	userMessage := convertUserFromStore(user)
+	s.createAuditLog(ctx, &store.AuditLog{
+		ActorID: user.ID,
+		Action:  store.AuditActionUserCreate,
+		Target:  userMessage.Name,
+		Payload: &storepb.AuditLogPayload{
+			After: auth.NewAuditSnapshot(userMessage),
+		},
+	})
+}
+
 func (s *APIV2Service) SignUp(ctx context.Context, request *apiv2pb.SignUpRequest) (*apiv2pb.SignUpResponse, error) {
+	if err := s.checkLoginGuard("ip:" + getClientIPFromContext(ctx)); err != nil {
+		return nil, err
+	}
+
 	workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to get workspace setting, err: %s", err))
 	}
-	if workspaceGeneralSetting.DisallowSignup || workspaceGeneralSetting.DisallowPasswordLogin {
+	// The users invited sign up even if the sign up is otherwise disallowed.
+	if (workspaceGeneralSetting.DisallowSignup && request.InvitationCode == "") || workspaceGeneralSetting.DisallowPasswordLogin {
 		return nil, status.Errorf(codes.PermissionDenied, "sign up is not allowed")
 	}
 
+	if err := s.validatePassword(ctx, request.Password); err != nil {
+		return nil, err
+	}
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to generate password hash, err: %s", err))
@@ -187,11 +253,15 @@ func (s *APIV2Service) SignUp(ctx context.Context, request *apiv2pb.SignUpReques
 	create := &store.User{
 		Username:     request.Username,
 		Nickname:     request.Username,
+		Email:        request.Email,
 		PasswordHash: string(passwordHash),
 	}
 	if !util.UIDMatcher.MatchString(strings.ToLower(create.Username)) {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid username: %s", create.Username)
 	}
+	if create.Email != "" && !util.ValidateEmail(create.Email) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email: %s", create.Email)
+	}
 
 	hostUserType := store.RoleHost
 	existedHostUsers, err := s.Store.ListUsers(ctx, &store.FindUser{
@@ -207,10 +277,68 @@ func (s *APIV2Service) SignUp(ctx context.Context, request *apiv2pb.SignUpReques
 		create.Role = store.RoleUser
 	}
 
+	var invitation *store.Invitation
+	if request.InvitationCode != "" {
+		// Check the username first, so that a failed sign up does not count as a use of the invitation.
+		existedUser, err := s.Store.GetUser(ctx, &store.FindUser{Username: &create.Username})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		}
+		if existedUser != nil {
+			return nil, status.Errorf(codes.AlreadyExists, "username already exists")
+		}
+		if invitation, err = s.useInvitation(ctx, request.InvitationCode); err != nil {
+			return nil, err
+		}
+		if create.Role != store.RoleHost {
+			create.Role = invitation.Role
+		}
+	}
+
+	// The host signing up first configures the SMTP server, so only the other users verify their email.
+	var mailSender mail.Sender
+	requireEmailVerification := workspaceGeneralSetting.RequireEmailVerification && create.Role != store.RoleHost
+	if requireEmailVerification {
+		if create.Email == "" {
+			return nil, status.Errorf(codes.InvalidArgument, "email is required")
+		}
+		if workspaceGeneralSetting.InstanceUrl == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "email verification requires the instance url")
+		}
+		if mailSender, err = s.getMailSender(ctx); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get mail sender: %v", err)
+		}
+		if mailSender == nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "email verification requires an SMTP server")
+		}
+	}
+
 	user, err := s.Store.CreateUser(ctx, create)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to create user, err: %s", err))
 	}
+	s.createSignUpAuditLog(ctx, user)
+	if err := s.recordPasswordChange(ctx, user.ID, false); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record password change: %v", err)
+	}
+	if invitation != nil {
+		if err := s.recordInvitationUse(ctx, invitation, user); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to join the group of the invitation: %v", err)
+		}
+	}
+
+	if requireEmailVerification {
+		if err := s.Store.SetUserPendingEmail(ctx, user.ID, user.Email); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to set pending email: %v", err)
+		}
+		if err := s.sendVerificationEmail(ctx, mailSender, user); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to send verification email: %v", err)
+		}
+		return &apiv2pb.SignUpResponse{
+			User:                      convertUserFromStore(user),
+			EmailVerificationRequired: true,
+		}, nil
+	}
 
 	if err := s.doSignIn(ctx, user, time.Now().Add(auth.AccessTokenDuration)); err != nil {
 		return nil, status.Errorf(codes.Internal, fmt.Sprintf("failed to sign in, err: %s", err))
@@ -221,12 +349,474 @@ func (s *APIV2Service) SignUp(ctx context.Context, request *apiv2pb.SignUpReques
 }
 
 func (s *APIV2Service) SignOut(ctx context.Context, _ *apiv2pb.SignOutRequest) (*apiv2pb.SignOutResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if accessToken, ok := ctx.Value(accessTokenContextKey).(string); ok && user != nil {
+		tokenHash := store.HashAccessToken(accessToken)
+		if err := s.Store.RemoveUserSessions(ctx, user.ID, func(session *storepb.SessionsUserSetting_Session) bool {
+			return session.TokenHash == tokenHash
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to remove session: %v", err)
+		}
+	}
 	if err := s.clearAccessTokenCookie(ctx); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to set grpc header, error: %v", err)
 	}
+	if user != nil {
+		s.createAuditLog(ctx, &store.AuditLog{
+			Action: store.AuditActionSignOut,
+			Target: fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+		})
+	}
 	return &apiv2pb.SignOutResponse{}, nil
 }
 
+func (s *APIV2Service) RequestPasswordReset(ctx context.Context, request *apiv2pb.RequestPasswordResetRequest) (*apiv2pb.RequestPasswordResetResponse, error) {
+	if err := s.checkLoginGuard("ip:" + getClientIPFromContext(ctx)); err != nil {
+		return nil, err
+	}
+	if !util.ValidateEmail(request.Email) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email: %s", request.Email)
+	}
+	mailSender, err := s.getMailSender(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get mail sender: %v", err)
+	}
+	if mailSender == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "password reset by email requires an SMTP server")
+	}
+
+	normalStatus := store.Normal
+	users, err := s.Store.ListUsers(ctx, &store.FindUser{
+		Email:     &request.Email,
+		RowStatus: &normalStatus,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+	// The emails are sent in the background, so that the response does not tell whether the email belongs to a user.
+	for _, user := range users {
+		go func(user *store.User) {
+			if err := s.sendPasswordResetEmail(context.WithoutCancel(ctx), mailSender, user); err != nil {
+				slog.Warn("failed to send password reset email", slog.Int("user", int(user.ID)), slog.Any("error", err))
+			}
+		}(user)
+	}
+	return &apiv2pb.RequestPasswordResetResponse{}, nil
+}
+
+func (s *APIV2Service) ResetPassword(ctx context.Context, request *apiv2pb.ResetPasswordRequest) (*apiv2pb.ResetPasswordResponse, error) {
+	if err := s.validatePassword(ctx, request.Password); err != nil {
+		return nil, err
+	}
+	user, claims, err := s.getEmailTokenUser(ctx, request.Token, auth.EmailTokenPasswordReset)
+	if err != nil {
+		return nil, err
+	}
+	// The token is bound to the password hash and the email, so it can only be used once.
+	if claims.Email != user.Email || !claims.MatchState(user.PasswordHash, []byte(s.Secret)) {
+		return nil, status.Errorf(codes.InvalidArgument, "the token has already been used")
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate password hash: %v", err)
+	}
+	passwordHashStr := string(passwordHash)
+	currentTs := time.Now().Unix()
+	if _, err := s.Store.UpdateUser(ctx, &store.UpdateUser{
+		ID:           user.ID,
+		UpdatedTs:    &currentTs,
+		PasswordHash: &passwordHashStr,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
+	}
+	if err := s.recordPasswordChange(ctx, user.ID, false); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record password change: %v", err)
+	}
+	// Whoever knew the previous password is signed out, and loses the access tokens they may have created with it.
+	if err := s.Store.RemoveUserSessions(ctx, user.ID, func(*storepb.SessionsUserSetting_Session) bool {
+		return true
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove sessions: %v", err)
+	}
+	if err := s.Store.RemoveUserAccessTokens(ctx, user.ID, func(*storepb.AccessTokensUserSetting_AccessToken) bool {
+		return true
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove access tokens: %v", err)
+	}
+	s.LoginGuard.Succeed("user:" + user.Username)
+	s.createAuditLog(ctx, &store.AuditLog{
+		ActorID: user.ID,
+		Action:  store.AuditActionPasswordReset,
+		Target:  fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+	})
+	return &apiv2pb.ResetPasswordResponse{}, nil
+}
+
+func (s *APIV2Service) VerifyEmail(ctx context.Context, request *apiv2pb.VerifyEmailRequest) (*apiv2pb.VerifyEmailResponse, error) {
+	user, claims, err := s.getEmailTokenUser(ctx, request.Token, auth.EmailTokenEmailVerification)
+	if err != nil {
+		return nil, err
+	}
+	emailVerification, err := s.Store.GetUserEmailVerification(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get email verification: %v", err)
+	}
+	if emailVerification.PendingEmail == "" || claims.Email != emailVerification.PendingEmail || !claims.MatchState(emailVerification.PendingEmail, []byte(s.Secret)) {
+		return nil, status.Errorf(codes.InvalidArgument, "the token has already been used")
+	}
+
+	if err := s.Store.SetUserPendingEmail(ctx, user.ID, ""); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to clear pending email: %v", err)
+	}
+	s.createAuditLog(ctx, &store.AuditLog{
+		ActorID: user.ID,
+		Action:  store.AuditActionEmailVerify,
+		Target:  fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+		Payload: &storepb.AuditLogPayload{
+			Message: claims.Email,
+		},
+	})
+	return &apiv2pb.VerifyEmailResponse{
+		User: convertUserFromStore(user),
+	}, nil
+}
+
+func (s *APIV2Service) ResendVerificationEmail(ctx context.Context, request *apiv2pb.ResendVerificationEmailRequest) (*apiv2pb.ResendVerificationEmailResponse, error) {
+	if err := s.checkLoginGuard("ip:" + getClientIPFromContext(ctx)); err != nil {
+		return nil, err
+	}
+	if !util.ValidateEmail(request.Email) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email: %s", request.Email)
+	}
+	mailSender, err := s.getMailSender(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get mail sender: %v", err)
+	}
+	if mailSender == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "email verification requires an SMTP server")
+	}
+
+	users, err := s.Store.ListUsers(ctx, &store.FindUser{
+		Email: &request.Email,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+	for _, user := range users {
+		emailVerification, err := s.Store.GetUserEmailVerification(ctx, user.ID)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get email verification: %v", err)
+		}
+		if emailVerification.PendingEmail != user.Email || time.Now().Unix()-emailVerification.SentTs < verificationEmailResendInterval {
+			continue
+		}
+		if err := s.Store.SetUserPendingEmail(ctx, user.ID, user.Email); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to set pending email: %v", err)
+		}
+		go func(user *store.User) {
+			if err := s.sendVerificationEmail(context.WithoutCancel(ctx), mailSender, user); err != nil {
+				slog.Warn("failed to send verification email", slog.Int("user", int(user.ID)), slog.Any("error", err))
+			}
+		}(user)
+	}
+	return &apiv2pb.ResendVerificationEmailResponse{}, nil
+}
+
+func (s *APIV2Service) RequestEmailChange(ctx context.Context, request *apiv2pb.RequestEmailChangeRequest) (*apiv2pb.RequestEmailChangeResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	if !util.ValidateEmail(request.Email) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid email: %s", request.Email)
+	}
+	if request.Email == user.Email {
+		return nil, status.Errorf(codes.InvalidArgument, "the email is not changed")
+	}
+	mailSender, err := s.getMailSender(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get mail sender: %v", err)
+	}
+	if mailSender == nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "email change confirmation requires an SMTP server")
+	}
+
+	// The token is bound to the current email, so it can only be used once.
+	token, err := auth.GenerateEmailToken(auth.EmailTokenEmailChange, user.ID, request.Email, user.Email, time.Now().Add(auth.EmailChangeTokenDuration), []byte(s.Secret))
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+	}
+	link, err := s.buildEmailLink(ctx, "/auth/confirm-email", token)
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition, "failed to build link: %v", err)
+	}
+	if err := s.sendUserMail(ctx, mailSender, user, request.Email, mail.TemplateEmailChange, link, auth.EmailChangeTokenDuration); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to send email change confirmation: %v", err)
+	}
+	return &apiv2pb.RequestEmailChangeResponse{}, nil
+}
+
+func (s *APIV2Service) ConfirmEmailChange(ctx context.Context, request *apiv2pb.ConfirmEmailChangeRequest) (*apiv2pb.ConfirmEmailChangeResponse, error) {
+	user, claims, err := s.getEmailTokenUser(ctx, request.Token, auth.EmailTokenEmailChange)
+	if err != nil {
+		return nil, err
+	}
+	if !claims.MatchState(user.Email, []byte(s.Secret)) {
+		return nil, status.Errorf(codes.InvalidArgument, "the token has already been used")
+	}
+
+	before := convertUserFromStore(user)
+	currentTs := time.Now().Unix()
+	updatedUser, err := s.Store.UpdateUser(ctx, &store.UpdateUser{
+		ID:        user.ID,
+		UpdatedTs: &currentTs,
+		Email:     &claims.Email,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
+	}
+	userMessage := convertUserFromStore(updatedUser)
+	s.createAuditLog(ctx, &store.AuditLog{
+		ActorID: user.ID,
+		Action:  store.AuditActionEmailChange,
+		Target:  userMessage.Name,
+		Payload: &storepb.AuditLogPayload{
+			Before: auth.NewAuditSnapshot(before),
+			After:  auth.NewAuditSnapshot(userMessage),
+		},
+	})
+	return &apiv2pb.ConfirmEmailChangeResponse{
+		User: userMessage,
+	}, nil
+}
+
+// getEmailTokenUser returns the user the token sent by email was issued for.
+func (s *APIV2Service) getEmailTokenUser(ctx context.Context, token string, purpose auth.EmailTokenPurpose) (*store.User, *auth.EmailTokenClaims, error) {
+	claims, err := auth.ParseEmailToken(token, purpose, []byte(s.Secret))
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid or expired token")
+	}
+	userID, err := claims.UserID()
+	if err != nil {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid or expired token")
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		ID: &userID,
+	})
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil || user.RowStatus == store.Archived {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "invalid or expired token")
+	}
+	return user, claims, nil
+}
+
+// sendPasswordResetEmail sends the link to reset the password, bound to the current password of the user.
+func (s *APIV2Service) sendPasswordResetEmail(ctx context.Context, mailSender mail.Sender, user *store.User) error {
+	token, err := auth.GenerateEmailToken(auth.EmailTokenPasswordReset, user.ID, user.Email, user.PasswordHash, time.Now().Add(auth.PasswordResetTokenDuration), []byte(s.Secret))
+	if err != nil {
+		return errors.Wrap(err, "failed to generate token")
+	}
+	link, err := s.buildEmailLink(ctx, "/auth/reset-password", token)
+	if err != nil {
+		return err
+	}
+	return s.sendUserMail(ctx, mailSender, user, user.Email, mail.TemplatePasswordReset, link, auth.PasswordResetTokenDuration)
+}
+
+// sendVerificationEmail sends the link to verify the pending email of the new user.
+func (s *APIV2Service) sendVerificationEmail(ctx context.Context, mailSender mail.Sender, user *store.User) error {
+	token, err := auth.GenerateEmailToken(auth.EmailTokenEmailVerification, user.ID, user.Email, user.Email, time.Now().Add(auth.EmailVerificationTokenDuration), []byte(s.Secret))
+	if err != nil {
+		return errors.Wrap(err, "failed to generate token")
+	}
+	link, err := s.buildEmailLink(ctx, "/auth/verify-email", token)
+	if err != nil {
+		return err
+	}
+	return s.sendUserMail(ctx, mailSender, user, user.Email, mail.TemplateEmailVerification, link, auth.EmailVerificationTokenDuration)
+}
+
+// checkEmailVerified returns an error if the user has to verify the email before signing in.
+func (s *APIV2Service) checkEmailVerified(ctx context.Context, user *store.User) error {
+	emailVerification, err := s.Store.GetUserEmailVerification(ctx, user.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get email verification: %v", err)
+	}
+	if emailVerification.PendingEmail == "" {
+		return nil
+	}
+	workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+	}
+	// The users waiting for verification can sign in once the workspace no longer requires it.
+	if workspaceGeneralSetting.RequireEmailVerification {
+		return status.Errorf(codes.FailedPrecondition, "email is not verified")
+	}
+	return nil
+}
+
+func (s *APIV2Service) ChangePassword(ctx context.Context, request *apiv2pb.ChangePasswordRequest) (*apiv2pb.ChangePasswordResponse, error) {
+	clientIP := getClientIPFromContext(ctx)
+	loginGuardKeys := []string{"ip:" + clientIP, "user:" + request.Username}
+	if err := s.checkLoginGuard(loginGuardKeys...); err != nil {
+		return nil, err
+	}
+	workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+	}
+	if workspaceGeneralSetting.DisallowPasswordLogin {
+		return nil, status.Errorf(codes.PermissionDenied, "password login is not allowed")
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{
+		Username: &request.Username,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to find user by username %s", request.Username)
+	}
+	if user == nil {
+		s.recordLoginFailure(ctx, nil, clientIP, fmt.Sprintf("user not found with username %s", request.Username), loginGuardKeys...)
+		return nil, status.Errorf(codes.InvalidArgument, "unmatched username and password")
+	}
+	if err := bcrypt.CompareHashAndPassword([]byte(user.PasswordHash), []byte(request.Password)); err != nil {
+		s.recordLoginFailure(ctx, user, clientIP, "unmatched password", loginGuardKeys...)
+		return nil, status.Errorf(codes.InvalidArgument, "unmatched username and password")
+	}
+	s.LoginGuard.Succeed("user:" + request.Username)
+	if user.RowStatus == store.Archived {
+		return nil, status.Errorf(codes.PermissionDenied, "user has been archived with username %s", request.Username)
+	}
+	if err := s.checkEmailVerified(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := s.checkUserSuspended(ctx, user); err != nil {
+		return nil, err
+	}
+	if err := s.validatePassword(ctx, request.NewPassword); err != nil {
+		return nil, err
+	}
+	if request.NewPassword == request.Password {
+		return nil, status.Errorf(codes.InvalidArgument, "the new password must be different from the current one")
+	}
+
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.NewPassword), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate password hash: %v", err)
+	}
+	passwordHashStr := string(passwordHash)
+	currentTs := time.Now().Unix()
+	user, err = s.Store.UpdateUser(ctx, &store.UpdateUser{
+		ID:           user.ID,
+		UpdatedTs:    &currentTs,
+		PasswordHash: &passwordHashStr,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
+	}
+	if err := s.recordPasswordChange(ctx, user.ID, false); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record password change: %v", err)
+	}
+	// Whoever knew the previous password is signed out.
+	if err := s.Store.RemoveUserSessions(ctx, user.ID, func(*storepb.SessionsUserSetting_Session) bool {
+		return true
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove sessions: %v", err)
+	}
+	s.createAuditLog(ctx, &store.AuditLog{
+		ActorID: user.ID,
+		Action:  store.AuditActionPasswordChange,
+		Target:  fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+	})
+
+	if err := s.doSignIn(ctx, user, time.Now().Add(auth.AccessTokenDuration)); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to sign in: %v", err)
+	}
+	return &apiv2pb.ChangePasswordResponse{
+		User: convertUserFromStore(user),
+	}, nil
+}
+
+func (s *APIV2Service) ListLoginLockouts(_ context.Context, _ *apiv2pb.ListLoginLockoutsRequest) (*apiv2pb.ListLoginLockoutsResponse, error) {
+	response := &apiv2pb.ListLoginLockoutsResponse{
+		Lockouts: []*apiv2pb.LoginLockout{},
+	}
+	for _, lockout := range s.LoginGuard.ListLockouts() {
+		loginLockout := &apiv2pb.LoginLockout{
+			Key:             lockout.Key,
+			FailedAttempts:  int32(lockout.FailedAttempts),
+			LastFailureTime: timestamppb.New(lockout.LastFailure),
+		}
+		if !lockout.LockedUntil.IsZero() {
+			loginLockout.LockedUntil = timestamppb.New(lockout.LockedUntil)
+		}
+		response.Lockouts = append(response.Lockouts, loginLockout)
+	}
+	return response, nil
+}
+
+func (s *APIV2Service) ClearLoginLockout(ctx context.Context, request *apiv2pb.ClearLoginLockoutRequest) (*apiv2pb.ClearLoginLockoutResponse, error) {
+	if !s.LoginGuard.Clear(request.Key) {
+		return nil, status.Errorf(codes.NotFound, "lockout not found with key %s", request.Key)
+	}
+	s.createAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionLoginLockoutClear,
+		Target: request.Key,
+	})
+	return &apiv2pb.ClearLoginLockoutResponse{}, nil
+}
+
+// checkLoginGuard returns an error if sign-in attempts are delayed or locked out for any of the keys.
+func (s *APIV2Service) checkLoginGuard(keys ...string) error {
+	if wait := s.LoginGuard.Check(keys...); wait > 0 {
+		return status.Errorf(codes.ResourceExhausted, "too many failed sign-in attempts, retry in %s", wait.Round(time.Second))
+	}
+	return nil
+}
+
+// recordLoginFailure records a failed sign-in attempt in the audit log and an activity for every lockout it starts.
+func (s *APIV2Service) recordLoginFailure(ctx context.Context, user *store.User, clientIP, reason string, keys ...string) {
+	creatorID := store.SystemBotID
+	auditLog := &store.AuditLog{
+		Action: store.AuditActionSignInFailed,
+		Payload: &storepb.AuditLogPayload{
+			Message: reason,
+		},
+	}
+	if user != nil {
+		creatorID = user.ID
+		auditLog.Target = fmt.Sprintf("%s%d", UserNamePrefix, user.ID)
+	}
+	s.createAuditLog(ctx, auditLog)
+	for _, lockout := range s.LoginGuard.Fail(keys...) {
+		if _, err := s.Store.CreateActivity(ctx, &store.Activity{
+			CreatorID: creatorID,
+			Type:      store.ActivityTypeLoginLockout,
+			Level:     store.ActivityLevelWarn,
+			Payload: &storepb.ActivityPayload{
+				LoginLockout: &storepb.ActivityLoginLockoutPayload{
+					Key:            lockout.Key,
+					FailedAttempts: int32(lockout.FailedAttempts),
+					LockedUntilTs:  lockout.LockedUntil.Unix(),
+					Ip:             clientIP,
+				},
+			},
+		}); err != nil {
+			slog.Warn("failed to create login lockout activity", slog.Any("error", err))
+		}
+	}
+}
+
 func (s *APIV2Service) clearAccessTokenCookie(ctx context.Context) error {
 	cookie, err := s.buildAccessTokenCookie(ctx, "", time.Time{})
 	if err != nil {
@@ -0,0 +1,32 @@
+package v2
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/usememos/memos/server/route/api/auth"
+)
+
+// ClientIPInterceptor sets the IP of the client of the request into the context.
+// The X-Forwarded-For header is only read from the trusted proxies, so that clients cannot pick the IP
+// the rate limits and the sign-in lockouts are keyed by.
+type ClientIPInterceptor struct {
+	trustedProxies auth.TrustedProxies
+	gatewaySecret  string
+}
+
+func NewClientIPInterceptor(trustedProxies auth.TrustedProxies, gatewaySecret string) *ClientIPInterceptor {
+	return &ClientIPInterceptor{
+		trustedProxies: trustedProxies,
+		gatewaySecret:  gatewaySecret,
+	}
+}
+
+func (in *ClientIPInterceptor) ClientIPInterceptor(ctx context.Context, request any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	// The gateway forwards the X-Forwarded-For header of the HTTP request, with its remote address appended.
+	clientIP := in.trustedProxies.ClientIP(getRemoteAddr(ctx, md, in.gatewaySecret), md.Get("x-forwarded-for"))
+	return handler(context.WithValue(ctx, clientIPContextKey, clientIP), request)
+}
@@ -2,11 +2,16 @@ package v2
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
 	"encoding/base64"
+	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/usememos/memos/plugin/filter"
 	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
 	"github.com/usememos/memos/store"
 )
@@ -47,28 +52,140 @@ func getCurrentUser(ctx context.Context, s *store.Store) (*store.User, error) {
 	return user, nil
 }
 
-func getPageToken(limit int, offset int) (string, error) {
-	return marshalPageToken(&apiv2pb.PageToken{
+func (s *APIV2Service) getPageToken(limit int, offset int) (string, error) {
+	return s.marshalPageToken(&apiv2pb.PageToken{
 		Limit:  int32(limit),
 		Offset: int32(offset),
 	})
 }
 
-func marshalPageToken(pageToken *apiv2pb.PageToken) (string, error) {
+// getCursorPageToken returns the token of the page after the cursor.
+func (s *APIV2Service) getCursorPageToken(limit int, cursor *store.Cursor) (string, error) {
+	return s.marshalPageToken(&apiv2pb.PageToken{
+		Limit: int32(limit),
+		Cursor: &apiv2pb.PageToken_Cursor{
+			Pinned: cursor.Pinned,
+			Ts:     cursor.Ts,
+			Id:     cursor.ID,
+		},
+	})
+}
+
+// getPageLimitAndCursor returns the limit and the cursor of the page requested, the limit is 0 if the page size is not set.
+func (s *APIV2Service) getPageLimitAndCursor(pageSize int32, token string) (int, *store.Cursor, error) {
+	if token == "" {
+		return int(pageSize), nil, nil
+	}
+	var pageToken apiv2pb.PageToken
+	if err := s.unmarshalPageToken(token, &pageToken); err != nil {
+		return 0, nil, err
+	}
+	if pageToken.Cursor == nil {
+		return 0, nil, errors.New("page token has no cursor")
+	}
+	return int(pageToken.Limit), &store.Cursor{
+		Pinned: pageToken.Cursor.Pinned,
+		Ts:     pageToken.Cursor.Ts,
+		ID:     pageToken.Cursor.Id,
+	}, nil
+}
+
+// getPageLimitAndOffset returns the limit and the offset of the page requested, for the listings in an order with no cursor.
+func (s *APIV2Service) getPageLimitAndOffset(pageSize int32, token string) (int, int, error) {
+	if token == "" {
+		return int(pageSize), 0, nil
+	}
+	var pageToken apiv2pb.PageToken
+	if err := s.unmarshalPageToken(token, &pageToken); err != nil {
+		return 0, 0, err
+	}
+	if pageToken.Cursor != nil {
+		return 0, 0, errors.New("page token has a cursor")
+	}
+	return int(pageToken.Limit), int(pageToken.Offset), nil
+}
+
+// marshalPageToken encodes the page token with its signature by the secret of the server,
+// so that the clients can't forge the cursors of the pages.
+func (s *APIV2Service) marshalPageToken(pageToken *apiv2pb.PageToken) (string, error) {
 	b, err := proto.Marshal(pageToken)
 	if err != nil {
 		return "", errors.Wrapf(err, "failed to marshal page token")
 	}
-	return base64.StdEncoding.EncodeToString(b), nil
+	return base64.RawURLEncoding.EncodeToString(b) + "." + base64.RawURLEncoding.EncodeToString(s.signPageToken(b)), nil
 }
 
-func unmarshalPageToken(s string, pageToken *apiv2pb.PageToken) error {
-	b, err := base64.StdEncoding.DecodeString(s)
+func (s *APIV2Service) unmarshalPageToken(token string, pageToken *apiv2pb.PageToken) error {
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return errors.New("malformed page token")
+	}
+	b, err := base64.RawURLEncoding.DecodeString(payload)
 	if err != nil {
 		return errors.Wrapf(err, "failed to decode page token")
 	}
+	mac, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil {
+		return errors.Wrapf(err, "failed to decode page token signature")
+	}
+	if !hmac.Equal(mac, s.signPageToken(b)) {
+		return errors.New("invalid page token signature")
+	}
 	if err := proto.Unmarshal(b, pageToken); err != nil {
 		return errors.Wrapf(err, "failed to unmarshal page token")
 	}
 	return nil
 }
+
+func (s *APIV2Service) signPageToken(b []byte) []byte {
+	mac := hmac.New(sha256.New, []byte(s.Secret))
+	mac.Write(b)
+	return mac.Sum(nil)
+}
+
+// convertUserNameToID converts the user names of the filters to the ids of the users.
+func convertUserNameToID(value any) (any, error) {
+	return ExtractUserIDFromName(value.(string))
+}
+
+// convertGroupNameToID converts the group names of the filters to the ids of the groups.
+func convertGroupNameToID(value any) (any, error) {
+	return ExtractGroupIDFromName(value.(string))
+}
+
+// convertMemoNameToID converts the memo names of the filters to the ids of the memos.
+func convertMemoNameToID(value any) (any, error) {
+	return ExtractMemoIDFromName(value.(string))
+}
+
+// convertTimestampToUnix converts the timestamps of the filters to the unix timestamps stored.
+func convertTimestampToUnix(value any) (any, error) {
+	t, ok := value.(time.Time)
+	if !ok {
+		return nil, errors.New("must be a timestamp")
+	}
+	return t.Unix(), nil
+}
+
+// rewriteEqualTo returns the rewrite of the legacy filter variables whose value is compared with the operator,
+// such as display_time_before == 123 meaning the display time is before 123.
+func rewriteEqualTo(operator filter.Operator) func(filter.Expr) (filter.Expr, error) {
+	return func(e filter.Expr) (filter.Expr, error) {
+		compareExpr, ok := e.(*filter.CompareExpr)
+		if !ok || compareExpr.Operator != filter.OperatorEqual {
+			return nil, errors.New("can only be compared with ==")
+		}
+		compareExpr.Operator = operator
+		return compareExpr, nil
+	}
+}
+
+// rewriteEqualToIn rewrites the legacy list variables compared with == as in conditions,
+// such as visibilities == ["PUBLIC"] meaning the visibility is in the list.
+func rewriteEqualToIn(e filter.Expr) (filter.Expr, error) {
+	compareExpr, ok := e.(*filter.CompareExpr)
+	if !ok || compareExpr.Operator != filter.OperatorEqual {
+		return nil, errors.New("can only be compared with ==")
+	}
+	return &filter.InExpr{Field: compareExpr.Field, Values: compareExpr.Value.([]any)}, nil
+}
@@ -0,0 +1,327 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	"github.com/usememos/memos/server/route/api/auth"
+	"github.com/usememos/memos/store"
+)
+
+const maxGroupNameLength = 64
+
+func (s *APIV2Service) ListGroups(ctx context.Context, _ *apiv2pb.ListGroupsRequest) (*apiv2pb.ListGroupsResponse, error) {
+	groups, err := s.Store.ListUserGroups(ctx, &store.FindUserGroup{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list groups: %v", err)
+	}
+
+	response := &apiv2pb.ListGroupsResponse{
+		Groups: []*apiv2pb.Group{},
+	}
+	for _, group := range groups {
+		response.Groups = append(response.Groups, convertGroupFromStore(group))
+	}
+	return response, nil
+}
+
+func (s *APIV2Service) GetGroup(ctx context.Context, request *apiv2pb.GetGroupRequest) (*apiv2pb.GetGroupResponse, error) {
+	group, err := s.getGroupByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &apiv2pb.GetGroupResponse{
+		Group: convertGroupFromStore(group),
+	}, nil
+}
+
+func (s *APIV2Service) CreateGroup(ctx context.Context, request *apiv2pb.CreateGroupRequest) (*apiv2pb.CreateGroupResponse, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if request.Group == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "group is required")
+	}
+	displayName := strings.TrimSpace(request.Group.DisplayName)
+	if err := validateGroupName(displayName); err != nil {
+		return nil, err
+	}
+
+	group, err := s.Store.CreateUserGroup(ctx, &store.UserGroup{
+		CreatorID:   currentUser.ID,
+		Name:        displayName,
+		Description: request.Group.Description,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create group: %v", err)
+	}
+	// The creator administrates the group.
+	if _, err := s.Store.UpsertUserGroupMember(ctx, &store.UserGroupMember{
+		GroupID: group.ID,
+		UserID:  currentUser.ID,
+		Role:    store.UserGroupMemberAdmin,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add group admin: %v", err)
+	}
+	return &apiv2pb.CreateGroupResponse{
+		Group: convertGroupFromStore(group),
+	}, nil
+}
+
+func (s *APIV2Service) UpdateGroup(ctx context.Context, request *apiv2pb.UpdateGroupRequest) (*apiv2pb.UpdateGroupResponse, error) {
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	group, err := s.getGroupByName(ctx, request.Group.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkGroupAdmin(ctx, group); err != nil {
+		return nil, err
+	}
+
+	currentTs := time.Now().Unix()
+	update := &store.UpdateUserGroup{
+		ID:        group.ID,
+		UpdatedTs: &currentTs,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		if path == "display_name" {
+			displayName := strings.TrimSpace(request.Group.DisplayName)
+			if err := validateGroupName(displayName); err != nil {
+				return nil, err
+			}
+			update.Name = &displayName
+		} else if path == "description" {
+			update.Description = &request.Group.Description
+		} else {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+
+	group, err = s.Store.UpdateUserGroup(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update group: %v", err)
+	}
+	return &apiv2pb.UpdateGroupResponse{
+		Group: convertGroupFromStore(group),
+	}, nil
+}
+
+func (s *APIV2Service) DeleteGroup(ctx context.Context, request *apiv2pb.DeleteGroupRequest) (*apiv2pb.DeleteGroupResponse, error) {
+	group, err := s.getGroupByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkGroupAdmin(ctx, group); err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteUserGroup(ctx, &store.DeleteUserGroup{ID: group.ID}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete group: %v", err)
+	}
+	return &apiv2pb.DeleteGroupResponse{}, nil
+}
+
+func (s *APIV2Service) ListGroupMembers(ctx context.Context, request *apiv2pb.ListGroupMembersRequest) (*apiv2pb.ListGroupMembersResponse, error) {
+	group, err := s.getGroupByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	members, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{
+		GroupID: &group.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list group members: %v", err)
+	}
+
+	response := &apiv2pb.ListGroupMembersResponse{
+		Members: []*apiv2pb.GroupMember{},
+	}
+	for _, member := range members {
+		response.Members = append(response.Members, convertGroupMemberFromStore(member))
+	}
+	return response, nil
+}
+
+func (s *APIV2Service) AddGroupMember(ctx context.Context, request *apiv2pb.AddGroupMemberRequest) (*apiv2pb.AddGroupMemberResponse, error) {
+	group, err := s.getGroupByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkGroupAdmin(ctx, group); err != nil {
+		return nil, err
+	}
+	userID, err := ExtractUserIDFromName(request.Member)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid member name: %v", err)
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+
+	member, err := s.Store.UpsertUserGroupMember(ctx, &store.UserGroupMember{
+		GroupID: group.ID,
+		UserID:  user.ID,
+		Role:    convertGroupMemberRoleToStore(request.Role),
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add group member: %v", err)
+	}
+	return &apiv2pb.AddGroupMemberResponse{
+		Member: convertGroupMemberFromStore(member),
+	}, nil
+}
+
+func (s *APIV2Service) RemoveGroupMember(ctx context.Context, request *apiv2pb.RemoveGroupMemberRequest) (*apiv2pb.RemoveGroupMemberResponse, error) {
+	group, err := s.getGroupByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	userID, err := ExtractUserIDFromName(request.Member)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid member name: %v", err)
+	}
+	// Members can leave the group by themselves.
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if currentUser.ID != userID {
+		if err := s.checkGroupAdmin(ctx, group); err != nil {
+			return nil, err
+		}
+	}
+	if err := s.Store.DeleteUserGroupMember(ctx, &store.DeleteUserGroupMember{
+		GroupID: &group.ID,
+		UserID:  &userID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove group member: %v", err)
+	}
+	return &apiv2pb.RemoveGroupMemberResponse{}, nil
+}
+
+func (s *APIV2Service) getGroupByName(ctx context.Context, name string) (*store.UserGroup, error) {
+	id, err := ExtractGroupIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid group name: %v", err)
+	}
+	group, err := s.Store.GetUserGroup(ctx, &store.FindUserGroup{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group: %v", err)
+	}
+	if group == nil {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	return group, nil
+}
+
+// checkGroupAdmin returns an error unless the current user administrates the group
+// or manages the users of the workspace.
+func (s *APIV2Service) checkGroupAdmin(ctx context.Context, group *store.UserGroup) error {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	member, err := s.Store.GetUserGroupMember(ctx, &store.FindUserGroupMember{
+		GroupID: &group.ID,
+		UserID:  &user.ID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get group member: %v", err)
+	}
+	if member != nil && member.Role == store.UserGroupMemberAdmin {
+		return nil
+	}
+	canManageUsers, err := auth.HasPermission(ctx, s.Store, user, auth.PermissionManageUsers)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	if !canManageUsers {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+// checkGroupMember returns an error unless the current user is a member of the group.
+func (s *APIV2Service) checkGroupMember(ctx context.Context, group *store.UserGroup) error {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	member, err := s.Store.GetUserGroupMember(ctx, &store.FindUserGroupMember{
+		GroupID: &group.ID,
+		UserID:  &user.ID,
+	})
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get group member: %v", err)
+	}
+	if member == nil {
+		return status.Errorf(codes.PermissionDenied, "not a member of the group")
+	}
+	return nil
+}
+
+func validateGroupName(name string) error {
+	if name == "" {
+		return status.Errorf(codes.InvalidArgument, "group display name is required")
+	}
+	if len(name) > maxGroupNameLength {
+		return status.Errorf(codes.InvalidArgument, "group display name is too long")
+	}
+	return nil
+}
+
+func convertGroupFromStore(group *store.UserGroup) *apiv2pb.Group {
+	return &apiv2pb.Group{
+		Name:        fmt.Sprintf("%s%d", GroupNamePrefix, group.ID),
+		DisplayName: group.Name,
+		Description: group.Description,
+		Creator:     fmt.Sprintf("%s%d", UserNamePrefix, group.CreatorID),
+		CreateTime:  timestamppb.New(time.Unix(group.CreatedTs, 0)),
+		UpdateTime:  timestamppb.New(time.Unix(group.UpdatedTs, 0)),
+	}
+}
+
+func convertGroupMemberFromStore(member *store.UserGroupMember) *apiv2pb.GroupMember {
+	return &apiv2pb.GroupMember{
+		Member:     fmt.Sprintf("%s%d", UserNamePrefix, member.UserID),
+		CreateTime: timestamppb.New(time.Unix(member.CreatedTs, 0)),
+		Role:       convertGroupMemberRoleFromStore(member.Role),
+	}
+}
+
+func convertGroupMemberRoleFromStore(role store.UserGroupMemberRole) apiv2pb.GroupMember_Role {
+	switch role {
+	case store.UserGroupMemberAdmin:
+		return apiv2pb.GroupMember_ADMIN
+	case store.UserGroupMemberMember:
+		return apiv2pb.GroupMember_MEMBER
+	default:
+		return apiv2pb.GroupMember_ROLE_UNSPECIFIED
+	}
+}
+
+func convertGroupMemberRoleToStore(role apiv2pb.GroupMember_Role) store.UserGroupMemberRole {
+	if role == apiv2pb.GroupMember_ADMIN {
+		return store.UserGroupMemberAdmin
+	}
+	return store.UserGroupMemberMember
+}
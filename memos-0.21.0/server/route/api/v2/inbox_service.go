@@ -13,15 +13,25 @@ import (
 	"github.com/usememos/memos/store"
 )
 
-func (s *APIV2Service) ListInboxes(ctx context.Context, _ *apiv2pb.ListInboxesRequest) (*apiv2pb.ListInboxesResponse, error) {
+func (s *APIV2Service) ListInboxes(ctx context.Context, request *apiv2pb.ListInboxesRequest) (*apiv2pb.ListInboxesResponse, error) {
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
 	}
 
-	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{
+	inboxFind := &store.FindInbox{
 		ReceiverID: &user.ID,
-	})
+	}
+	limit, cursor, err := s.getPageLimitAndCursor(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	}
+	inboxFind.Cursor = cursor
+	limitPlusOne := limit + 1
+	if limit > 0 {
+		inboxFind.Limit = &limitPlusOne
+	}
+	inboxes, err := s.Store.ListInboxes(ctx, inboxFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list inbox: %v", err)
 	}
@@ -29,6 +39,14 @@ func (s *APIV2Service) ListInboxes(ctx context.Context, _ *apiv2pb.ListInboxesRe
 	response := &apiv2pb.ListInboxesResponse{
 		Inboxes: []*apiv2pb.Inbox{},
 	}
+	if limit > 0 && len(inboxes) == limitPlusOne {
+		inboxes = inboxes[:limit]
+		last := inboxes[len(inboxes)-1]
+		response.NextPageToken, err = s.getCursorPageToken(limit, &store.Cursor{Ts: last.CreatedTs, ID: last.ID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
 	for _, inbox := range inboxes {
 		response.Inboxes = append(response.Inboxes, convertInboxFromStore(inbox))
 	}
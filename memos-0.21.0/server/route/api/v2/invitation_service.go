@@ -0,0 +1,245 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/route/api/auth"
+	"github.com/usememos/memos/store"
+)
+
+const invitationCodeLength = 24
+
+func (s *APIV2Service) ListInvitations(ctx context.Context, _ *apiv2pb.ListInvitationsRequest) (*apiv2pb.ListInvitationsResponse, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	canManageUsers, err := auth.HasPermission(ctx, s.Store, currentUser, auth.PermissionManageUsers)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+	}
+	invitationFind := &store.FindInvitation{}
+	if !canManageUsers {
+		invitationFind.CreatorID = &currentUser.ID
+	}
+	invitations, err := s.Store.ListInvitations(ctx, invitationFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list invitations: %v", err)
+	}
+
+	response := &apiv2pb.ListInvitationsResponse{
+		Invitations: []*apiv2pb.Invitation{},
+	}
+	for _, invitation := range invitations {
+		response.Invitations = append(response.Invitations, convertInvitationFromStore(invitation))
+	}
+	return response, nil
+}
+
+func (s *APIV2Service) CreateInvitation(ctx context.Context, request *apiv2pb.CreateInvitationRequest) (*apiv2pb.CreateInvitationResponse, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if request.Invitation == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invitation is required")
+	}
+	evaluator, err := auth.GetEvaluator(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get permissions: %v", err)
+	}
+	role := convertInvitationRoleToStore(request.Invitation)
+	if evaluator.HasPermission(currentUser, auth.PermissionManageUsers) {
+		if !evaluator.CanAssignRole(currentUser, role) {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied to invite users with role %s", role)
+		}
+	} else {
+		workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
+		}
+		if !workspaceGeneralSetting.AllowUserInvitations {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		// The users only invite other users without any more permissions.
+		if role != store.RoleUser {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied to invite users with role %s", role)
+		}
+	}
+
+	code, err := util.RandomString(invitationCodeLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate invitation code: %v", err)
+	}
+	create := &store.Invitation{
+		CreatorID: currentUser.ID,
+		Code:      code,
+		Role:      role,
+		MaxUses:   request.Invitation.MaxUses,
+	}
+	if create.MaxUses < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max uses must not be negative")
+	}
+	if request.Invitation.ExpireTime != nil {
+		create.ExpiresTs = request.Invitation.ExpireTime.AsTime().Unix()
+		if create.ExpiresTs <= time.Now().Unix() {
+			return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+		}
+	}
+	if request.Invitation.Group != "" {
+		group, err := s.getGroupByName(ctx, request.Invitation.Group)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkGroupAdmin(ctx, group); err != nil {
+			return nil, err
+		}
+		create.GroupID = group.ID
+	}
+
+	invitation, err := s.Store.CreateInvitation(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create invitation: %v", err)
+	}
+	invitationMessage := convertInvitationFromStore(invitation)
+	s.createAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionInvitationCreate,
+		Target: invitationMessage.Name,
+		Payload: &storepb.AuditLogPayload{
+			After: auth.NewAuditSnapshot(invitationMessage),
+		},
+	})
+	return &apiv2pb.CreateInvitationResponse{
+		Invitation: invitationMessage,
+	}, nil
+}
+
+func (s *APIV2Service) RevokeInvitation(ctx context.Context, request *apiv2pb.RevokeInvitationRequest) (*apiv2pb.RevokeInvitationResponse, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	id, err := ExtractInvitationIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid invitation name: %v", err)
+	}
+	invitation, err := s.Store.GetInvitation(ctx, &store.FindInvitation{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get invitation: %v", err)
+	}
+	if invitation == nil {
+		return nil, status.Errorf(codes.NotFound, "invitation not found")
+	}
+	if invitation.CreatorID != currentUser.ID {
+		canManageUsers, err := auth.HasPermission(ctx, s.Store, currentUser, auth.PermissionManageUsers)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+		}
+		if !canManageUsers {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+	}
+	if invitation.RevokedTs != 0 {
+		return &apiv2pb.RevokeInvitationResponse{
+			Invitation: convertInvitationFromStore(invitation),
+		}, nil
+	}
+
+	revokedTs := time.Now().Unix()
+	invitation, err = s.Store.UpdateInvitation(ctx, &store.UpdateInvitation{
+		ID:        invitation.ID,
+		RevokedTs: &revokedTs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke invitation: %v", err)
+	}
+	invitationMessage := convertInvitationFromStore(invitation)
+	s.createAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionInvitationRevoke,
+		Target: invitationMessage.Name,
+	})
+	return &apiv2pb.RevokeInvitationResponse{
+		Invitation: invitationMessage,
+	}, nil
+}
+
+// useInvitation counts a sign up with the invitation code, and returns an error if the invitation cannot be used.
+func (s *APIV2Service) useInvitation(ctx context.Context, code string) (*store.Invitation, error) {
+	invitation, err := s.Store.GetInvitation(ctx, &store.FindInvitation{Code: &code})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get invitation: %v", err)
+	}
+	if invitation == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "invalid invitation")
+	}
+	ok, err := s.Store.IncreaseInvitationUseCount(ctx, invitation.ID, time.Now().Unix())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to use invitation: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "invitation is revoked, expired or used up")
+	}
+	return invitation, nil
+}
+
+// recordInvitationUse adds the user signing up with the invitation to its group, and records the use of the invitation.
+func (s *APIV2Service) recordInvitationUse(ctx context.Context, invitation *store.Invitation, user *store.User) error {
+	if invitation.GroupID != 0 {
+		if _, err := s.Store.UpsertUserGroupMember(ctx, &store.UserGroupMember{
+			GroupID: invitation.GroupID,
+			UserID:  user.ID,
+			Role:    store.UserGroupMemberMember,
+		}); err != nil {
+			return err
+		}
+	}
+	s.createAuditLog(ctx, &store.AuditLog{
+		ActorID: user.ID,
+		Action:  store.AuditActionInvitationUse,
+		Target:  fmt.Sprintf("%s%d", InvitationNamePrefix, invitation.ID),
+	})
+	return nil
+}
+
+// convertInvitationRoleToStore returns the custom role of the invitation if set, or else the built-in role, default to USER.
+func convertInvitationRoleToStore(invitation *apiv2pb.Invitation) store.Role {
+	if invitation.CustomRole != "" {
+		return store.Role(invitation.CustomRole)
+	}
+	if invitation.Role == apiv2pb.User_ROLE_UNSPECIFIED {
+		return store.RoleUser
+	}
+	return convertUserRoleToStore(invitation.Role)
+}
+
+func convertInvitationFromStore(invitation *store.Invitation) *apiv2pb.Invitation {
+	invitationMessage := &apiv2pb.Invitation{
+		Name:       fmt.Sprintf("%s%d", InvitationNamePrefix, invitation.ID),
+		Code:       invitation.Code,
+		Creator:    fmt.Sprintf("%s%d", UserNamePrefix, invitation.CreatorID),
+		CreateTime: timestamppb.New(time.Unix(invitation.CreatedTs, 0)),
+		Role:       convertUserRoleFromStore(invitation.Role),
+		CustomRole: convertUserCustomRoleFromStore(invitation.Role),
+		MaxUses:    invitation.MaxUses,
+		UseCount:   invitation.UseCount,
+	}
+	if invitation.GroupID != 0 {
+		invitationMessage.Group = fmt.Sprintf("%s%d", GroupNamePrefix, invitation.GroupID)
+	}
+	if invitation.ExpiresTs != 0 {
+		invitationMessage.ExpireTime = timestamppb.New(time.Unix(invitation.ExpiresTs, 0))
+	}
+	if invitation.RevokedTs != 0 {
+		invitationMessage.RevokeTime = timestamppb.New(time.Unix(invitation.RevokedTs, 0))
+	}
+	return invitationMessage
+}
@@ -0,0 +1,87 @@
+package v2
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/plugin/mail"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+// getMailSender returns the sender of the emails, or nil if the workspace has no SMTP server configured.
+func (s *APIV2Service) getMailSender(ctx context.Context) (mail.Sender, error) {
+	if s.MailSender != nil {
+		return s.MailSender, nil
+	}
+	smtpSetting, err := s.Store.GetWorkspaceSmtpSetting(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if smtpSetting == nil {
+		return nil, nil
+	}
+	return mail.NewSMTPSender(convertSMTPConfigFromStore(smtpSetting))
+}
+
+func convertSMTPConfigFromStore(setting *storepb.WorkspaceSmtpSetting) *mail.SMTPConfig {
+	security := mail.Security(setting.Security.String())
+	if setting.Security == storepb.WorkspaceSmtpSetting_SECURITY_UNSPECIFIED {
+		security = mail.SecurityStartTLS
+	}
+	return &mail.SMTPConfig{
+		Host:      setting.Host,
+		Port:      int(setting.Port),
+		Username:  setting.Username,
+		Password:  setting.Password,
+		FromEmail: setting.FromEmail,
+		FromName:  setting.FromName,
+		Security:  security,
+	}
+}
+
+// sendUserMail sends the email with the link to the user, in the locale of the user.
+func (s *APIV2Service) sendUserMail(ctx context.Context, sender mail.Sender, user *store.User, to string, template mail.Template, link string, expiresIn time.Duration) error {
+	locale := mail.DefaultLocale
+	userSetting, err := s.Store.GetUserSetting(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_LOCALE,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to get user locale")
+	}
+	if userSetting.GetLocale() != "" {
+		locale = userSetting.GetLocale()
+	}
+	nickname := user.Nickname
+	if nickname == "" {
+		nickname = user.Username
+	}
+
+	message, err := mail.Render(template, locale, &mail.TemplateData{
+		Nickname:       nickname,
+		Link:           link,
+		ExpiresInHours: int(expiresIn.Hours()),
+	})
+	if err != nil {
+		return err
+	}
+	message.To = to
+	return sender.Send(ctx, message)
+}
+
+// buildEmailLink builds the link of the frontend page handling the token sent by email.
+func (s *APIV2Service) buildEmailLink(ctx context.Context, path, token string) (string, error) {
+	workspaceGeneralSetting, err := s.Store.GetWorkspaceGeneralSetting(ctx)
+	if err != nil {
+		return "", err
+	}
+	if workspaceGeneralSetting.InstanceUrl == "" {
+		return "", errors.New("the instance url is required to send links by email")
+	}
+	return strings.TrimSuffix(workspaceGeneralSetting.InstanceUrl, "/") + path + "?token=" + url.QueryEscape(token), nil
+}
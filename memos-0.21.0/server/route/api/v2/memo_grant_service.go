@@ -0,0 +1,187 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	"github.com/usememos/memos/server/route/api/auth"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV2Service) SetMemoGrants(ctx context.Context, request *apiv2pb.SetMemoGrantsRequest) (*apiv2pb.SetMemoGrantsResponse, error) {
+	id, err := ExtractMemoIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	// Only the creator decides who the memo is shared with.
+	if user == nil || memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	grants := []*store.MemoGrant{}
+	for _, grant := range request.Grants {
+		create, err := s.convertMemoGrantToStore(ctx, grant)
+		if err != nil {
+			return nil, err
+		}
+		if create.GranteeType == store.MemoGranteeUser && create.GranteeID == memo.CreatorID {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot share a memo with its creator")
+		}
+		for _, g := range grants {
+			if g.GranteeType == create.GranteeType && g.GranteeID == create.GranteeID {
+				return nil, status.Errorf(codes.InvalidArgument, "duplicate grantee %s", grant.Grantee)
+			}
+		}
+		create.MemoID = memo.ID
+		grants = append(grants, create)
+	}
+
+	// Replace all the grants of the memo.
+	if err := s.Store.DeleteMemoGrant(ctx, &store.DeleteMemoGrant{
+		MemoID: &memo.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memo grants: %v", err)
+	}
+	for _, grant := range grants {
+		if _, err := s.Store.UpsertMemoGrant(ctx, grant); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to upsert memo grant: %v", err)
+		}
+	}
+
+	return &apiv2pb.SetMemoGrantsResponse{}, nil
+}
+
+func (s *APIV2Service) ListMemoGrants(ctx context.Context, request *apiv2pb.ListMemoGrantsRequest) (*apiv2pb.ListMemoGrantsResponse, error) {
+	id, err := ExtractMemoIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	canEdit, err := auth.CanAccessMemo(ctx, s.Store, user, memo, auth.MemoAccessEdit)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check memo access: %v", err)
+	}
+	if !canEdit {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	grants, err := s.Store.ListMemoGrants(ctx, &store.FindMemoGrant{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo grants: %v", err)
+	}
+	response := &apiv2pb.ListMemoGrantsResponse{
+		Grants: []*apiv2pb.MemoGrant{},
+	}
+	for _, grant := range grants {
+		response.Grants = append(response.Grants, convertMemoGrantFromStore(grant))
+	}
+	return response, nil
+}
+
+// checkMemoAccess returns an error unless the current user has at least the access to the memo.
+func (s *APIV2Service) checkMemoAccess(ctx context.Context, memo *store.Memo, access auth.MemoAccess) error {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get user")
+	}
+	ok, err := auth.CanAccessMemo(ctx, s.Store, user, memo, access)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check memo access: %v", err)
+	}
+	if !ok {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return nil
+}
+
+func (s *APIV2Service) convertMemoGrantToStore(ctx context.Context, grant *apiv2pb.MemoGrant) (*store.MemoGrant, error) {
+	create := &store.MemoGrant{}
+	switch grant.Role {
+	case apiv2pb.MemoGrant_VIEWER:
+		create.Role = store.MemoGrantViewer
+	case apiv2pb.MemoGrant_COMMENTER:
+		create.Role = store.MemoGrantCommenter
+	case apiv2pb.MemoGrant_EDITOR:
+		create.Role = store.MemoGrantEditor
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "invalid role of grantee %s", grant.Grantee)
+	}
+
+	if strings.HasPrefix(grant.Grantee, GroupNamePrefix) {
+		groupID, err := ExtractGroupIDFromName(grant.Grantee)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid grantee: %v", err)
+		}
+		group, err := s.Store.GetUserGroup(ctx, &store.FindUserGroup{ID: &groupID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get group: %v", err)
+		}
+		if group == nil {
+			return nil, status.Errorf(codes.NotFound, "group %s not found", grant.Grantee)
+		}
+		create.GranteeType, create.GranteeID = store.MemoGranteeGroup, group.ID
+		return create, nil
+	}
+
+	userID, err := ExtractUserIDFromName(grant.Grantee)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid grantee: %v", err)
+	}
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user %s not found", grant.Grantee)
+	}
+	create.GranteeType, create.GranteeID = store.MemoGranteeUser, user.ID
+	return create, nil
+}
+
+func convertMemoGrantFromStore(grant *store.MemoGrant) *apiv2pb.MemoGrant {
+	memoGrant := &apiv2pb.MemoGrant{}
+	if grant.GranteeType == store.MemoGranteeGroup {
+		memoGrant.Grantee = fmt.Sprintf("%s%d", GroupNamePrefix, grant.GranteeID)
+	} else {
+		memoGrant.Grantee = fmt.Sprintf("%s%d", UserNamePrefix, grant.GranteeID)
+	}
+	switch grant.Role {
+	case store.MemoGrantViewer:
+		memoGrant.Role = apiv2pb.MemoGrant_VIEWER
+	case store.MemoGrantCommenter:
+		memoGrant.Role = apiv2pb.MemoGrant_COMMENTER
+	case store.MemoGrantEditor:
+		memoGrant.Role = apiv2pb.MemoGrant_EDITOR
+	default:
+		memoGrant.Role = apiv2pb.MemoGrant_ROLE_UNSPECIFIED
+	}
+	return memoGrant
+}
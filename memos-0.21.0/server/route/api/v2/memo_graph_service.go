@@ -0,0 +1,380 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/plugin/filter"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	"github.com/usememos/memos/server/route/api/auth"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxMemoGraphSize is the maximum number of memos of a graph.
+	maxMemoGraphSize = 1000
+	// defaultMemoGraphDepth is the depth of the graphs around a memo when the request has none.
+	defaultMemoGraphDepth = 2
+	maxMemoGraphDepth     = 5
+	// memoGraphTagNamePrefix is the prefix of the names of the tag nodes.
+	memoGraphTagNamePrefix = "tags/"
+)
+
+func (s *APIV2Service) GetMemoGraph(ctx context.Context, request *apiv2pb.GetMemoGraphRequest) (*apiv2pb.GetMemoGraphResponse, error) {
+	depth := int(request.Depth)
+	if depth < 0 || depth > maxMemoGraphDepth {
+		return nil, status.Errorf(codes.InvalidArgument, "depth must be between 0 and %d", maxMemoGraphDepth)
+	}
+	if depth == 0 {
+		depth = defaultMemoGraphDepth
+	}
+
+	var root *store.Memo
+	if request.Root != "" {
+		id, err := ExtractMemoIDFromName(request.Root)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid root memo name: %v", err)
+		}
+		root, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo")
+		}
+		if root == nil {
+			return nil, status.Errorf(codes.NotFound, "memo not found")
+		}
+		if err := s.checkMemoAccess(ctx, root, auth.MemoAccessView); err != nil {
+			return nil, err
+		}
+	}
+
+	normalStatus := store.Normal
+	memoFind := &store.FindMemo{
+		RowStatus: &normalStatus,
+	}
+	if err := s.buildMemoFindWithFilter(ctx, memoFind, request.Filter); err != nil {
+		return nil, err
+	}
+	limit := maxMemoGraphSize
+	if memoFind.Limit != nil && *memoFind.Limit < limit {
+		limit = *memoFind.Limit
+	}
+	if root != nil {
+		memos, truncated, err := s.listMemoGraphMemos(ctx, root, memoFind, depth, limit)
+		if err != nil {
+			return nil, err
+		}
+		graph, err := s.buildMemoGraph(ctx, memos)
+		if err != nil {
+			return nil, err
+		}
+		// The tags of the farthest memos are one edge too far.
+		return graph.subgraph(getMemoGraphMemoName(root.ID), depth).toResponse(truncated), nil
+	}
+
+	limitPlusOne := limit + 1
+	memoFind.Limit = &limitPlusOne
+	memos, err := s.Store.ListMemos(ctx, memoFind)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	truncated := len(memos) > limit
+	if truncated {
+		memos = memos[:limit]
+	}
+	graph, err := s.buildMemoGraph(ctx, memos)
+	if err != nil {
+		return nil, err
+	}
+	return graph.toResponse(truncated), nil
+}
+
+// listMemoGraphMemos returns the memos of the find at most depth edges away from the root, walking the relations
+// and the tags out from the root one level at a time, and true if the limit on the number of memos cut the walk short.
+func (s *APIV2Service) listMemoGraphMemos(ctx context.Context, root *store.Memo, memoFind *store.FindMemo, depth, limit int) ([]*store.Memo, bool, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, false, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+
+	memos := []*store.Memo{root}
+	visitedMemoIDs := map[int32]bool{root.ID: true}
+	visitedTags := map[string]bool{}
+	memoIDs, tags := []int32{root.ID}, []string{}
+	for distance := 0; distance < depth && (len(memoIDs) > 0 || len(tags) > 0); distance++ {
+		nextMemoIDs, nextTags := []any{}, []string{}
+		addMemoID := func(id int32) {
+			if !visitedMemoIDs[id] {
+				visitedMemoIDs[id] = true
+				nextMemoIDs = append(nextMemoIDs, id)
+			}
+		}
+		if len(memoIDs) > 0 {
+			// The relations are followed both ways, like the edges of the graph.
+			relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+				MemoIDList: memoIDs,
+			})
+			if err != nil {
+				return nil, false, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+			}
+			for _, relation := range relations {
+				addMemoID(relation.RelatedMemoID)
+			}
+			relations, err = s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+				RelatedMemoIDList: memoIDs,
+			})
+			if err != nil {
+				return nil, false, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+			}
+			for _, relation := range relations {
+				addMemoID(relation.MemoID)
+			}
+			memoTags, err := s.Store.ListMemoTags(ctx, &store.FindMemoTag{
+				MemoIDList: memoIDs,
+			})
+			if err != nil {
+				return nil, false, status.Errorf(codes.Internal, "failed to list memo tags: %v", err)
+			}
+			for _, memoTag := range memoTags {
+				if !visitedTags[memoTag.Tag] {
+					visitedTags[memoTag.Tag] = true
+					nextTags = append(nextTags, memoTag.Tag)
+				}
+			}
+		}
+		if len(tags) > 0 {
+			memoTags, err := s.Store.ListMemoTags(ctx, &store.FindMemoTag{
+				TagList: tags,
+			})
+			if err != nil {
+				return nil, false, status.Errorf(codes.Internal, "failed to list memo tags: %v", err)
+			}
+			for _, memoTag := range memoTags {
+				addMemoID(memoTag.MemoID)
+			}
+		}
+		memoIDs, tags = []int32{}, nextTags
+		if len(nextMemoIDs) == 0 {
+			continue
+		}
+
+		// The memos of the level still go through the filter and the visibility of the find.
+		levelFind := *memoFind
+		levelFind.Filter = filter.And(memoFind.Filter, &filter.InExpr{Field: "id", Values: nextMemoIDs})
+		levelLimit := limit - len(memos) + 1
+		levelFind.Limit = &levelLimit
+		levelMemos, err := s.Store.ListMemos(ctx, &levelFind)
+		if err != nil {
+			return nil, false, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		}
+		for _, memo := range levelMemos {
+			canView, err := auth.CanAccessMemo(ctx, s.Store, user, memo, auth.MemoAccessView)
+			if err != nil {
+				return nil, false, status.Errorf(codes.Internal, "failed to check memo access: %v", err)
+			}
+			if !canView {
+				continue
+			}
+			if len(memos) == limit {
+				return memos, true, nil
+			}
+			memos = append(memos, memo)
+			memoIDs = append(memoIDs, memo.ID)
+		}
+	}
+	return memos, false, nil
+}
+
+// buildMemoGraph returns the graph of the memos, with the relations between them and their tags.
+func (s *APIV2Service) buildMemoGraph(ctx context.Context, memos []*store.Memo) (*memoGraph, error) {
+	graph := newMemoGraph()
+	memoIDs := []int32{}
+	for _, memo := range memos {
+		title := ""
+		if !memo.Encrypted {
+			title = store.GetMemoTitle(memo.Content)
+		}
+		graph.addNode(getMemoGraphMemoName(memo.ID), apiv2pb.MemoGraphNode_MEMO, title)
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	if len(memoIDs) == 0 {
+		return graph, nil
+	}
+
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		MemoIDList: memoIDs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo relations: %v", err)
+	}
+	for _, relation := range relations {
+		target := getMemoGraphMemoName(relation.RelatedMemoID)
+		// The relations to the memos out of the graph are left out.
+		if _, ok := graph.nodes[target]; !ok {
+			continue
+		}
+		edgeType := apiv2pb.MemoGraphEdge_REFERENCE
+		if relation.Type == store.MemoRelationComment {
+			edgeType = apiv2pb.MemoGraphEdge_COMMENT
+		}
+		graph.addEdge(getMemoGraphMemoName(relation.MemoID), target, edgeType)
+	}
+
+	memoTags, err := s.Store.ListMemoTags(ctx, &store.FindMemoTag{
+		MemoIDList: memoIDs,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo tags: %v", err)
+	}
+	for _, memoTag := range memoTags {
+		tagName := memoGraphTagNamePrefix + memoTag.Tag
+		if _, ok := graph.nodes[tagName]; !ok {
+			graph.addNode(tagName, apiv2pb.MemoGraphNode_TAG, memoTag.Tag)
+		}
+		graph.addEdge(getMemoGraphMemoName(memoTag.MemoID), tagName, apiv2pb.MemoGraphEdge_TAG)
+	}
+	return graph, nil
+}
+
+// memoGraph is an undirected graph of memos and tags, keeping the order the nodes are added in.
+type memoGraph struct {
+	nodes     map[string]*apiv2pb.MemoGraphNode
+	nodeNames []string
+	edges     []*apiv2pb.MemoGraphEdge
+	neighbors map[string][]string
+}
+
+func newMemoGraph() *memoGraph {
+	return &memoGraph{
+		nodes:     map[string]*apiv2pb.MemoGraphNode{},
+		nodeNames: []string{},
+		edges:     []*apiv2pb.MemoGraphEdge{},
+		neighbors: map[string][]string{},
+	}
+}
+
+func (g *memoGraph) addNode(name string, nodeType apiv2pb.MemoGraphNode_Type, title string) {
+	g.nodes[name] = &apiv2pb.MemoGraphNode{
+		Name:  name,
+		Type:  nodeType,
+		Title: title,
+	}
+	g.nodeNames = append(g.nodeNames, name)
+}
+
+func (g *memoGraph) addEdge(source, target string, edgeType apiv2pb.MemoGraphEdge_Type) {
+	g.edges = append(g.edges, &apiv2pb.MemoGraphEdge{
+		Source: source,
+		Target: target,
+		Type:   edgeType,
+	})
+	g.neighbors[source] = append(g.neighbors[source], target)
+	g.neighbors[target] = append(g.neighbors[target], source)
+}
+
+// subgraph returns the graph of the nodes at most depth edges away from the root.
+func (g *memoGraph) subgraph(root string, depth int) *memoGraph {
+	distances := map[string]int{root: 0}
+	queue := []string{root}
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if distances[name] == depth {
+			continue
+		}
+		for _, neighbor := range g.neighbors[name] {
+			if _, ok := distances[neighbor]; !ok {
+				distances[neighbor] = distances[name] + 1
+				queue = append(queue, neighbor)
+			}
+		}
+	}
+
+	subgraph := newMemoGraph()
+	for _, name := range g.nodeNames {
+		if _, ok := distances[name]; ok {
+			node := g.nodes[name]
+			subgraph.addNode(name, node.Type, node.Title)
+		}
+	}
+	for _, edge := range g.edges {
+		_, hasSource := distances[edge.Source]
+		_, hasTarget := distances[edge.Target]
+		if hasSource && hasTarget {
+			subgraph.addEdge(edge.Source, edge.Target, edge.Type)
+		}
+	}
+	return subgraph
+}
+
+// toResponse sets the degrees and the clusters of the nodes, and returns the graph with its metrics.
+func (g *memoGraph) toResponse(truncated bool) *apiv2pb.GetMemoGraphResponse {
+	metrics := &apiv2pb.MemoGraphMetrics{
+		NodeCount: int32(len(g.nodeNames)),
+		EdgeCount: int32(len(g.edges)),
+	}
+	for _, name := range g.nodeNames {
+		node := g.nodes[name]
+		node.Degree = int32(len(g.neighbors[name]))
+		if node.Type == apiv2pb.MemoGraphNode_MEMO && node.Degree == 0 {
+			metrics.OrphanCount++
+		}
+	}
+	if len(g.nodeNames) > 0 {
+		metrics.AverageDegree = float64(2*len(g.edges)) / float64(len(g.nodeNames))
+	}
+
+	// The clusters are the connected parts of the graph.
+	clusters := [][]string{}
+	visited := map[string]bool{}
+	for _, name := range g.nodeNames {
+		if visited[name] {
+			continue
+		}
+		cluster := []string{}
+		visited[name] = true
+		queue := []string{name}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			cluster = append(cluster, current)
+			for _, neighbor := range g.neighbors[current] {
+				if !visited[neighbor] {
+					visited[neighbor] = true
+					queue = append(queue, neighbor)
+				}
+			}
+		}
+		clusters = append(clusters, cluster)
+	}
+	sort.SliceStable(clusters, func(i, j int) bool {
+		return len(clusters[i]) > len(clusters[j])
+	})
+	for index, cluster := range clusters {
+		for _, name := range cluster {
+			g.nodes[name].Cluster = int32(index)
+		}
+	}
+	metrics.ClusterCount = int32(len(clusters))
+	if len(clusters) > 0 {
+		metrics.LargestClusterSize = int32(len(clusters[0]))
+	}
+
+	response := &apiv2pb.GetMemoGraphResponse{
+		Nodes:     []*apiv2pb.MemoGraphNode{},
+		Edges:     g.edges,
+		Metrics:   metrics,
+		Truncated: truncated,
+	}
+	for _, name := range g.nodeNames {
+		response.Nodes = append(response.Nodes, g.nodes[name])
+	}
+	return response
+}
+
+func getMemoGraphMemoName(id int32) string {
+	return fmt.Sprintf("%s%d", MemoNamePrefix, id)
+}
@@ -3,11 +3,15 @@ package v2
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/usememos/memos/plugin/filter"
 	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	"github.com/usememos/memos/server/route/api/auth"
 	"github.com/usememos/memos/store"
 )
 
@@ -16,11 +20,12 @@ func (s *APIV2Service) SetMemoRelations(ctx context.Context, request *apiv2pb.Se
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
 	}
-	referenceType := store.MemoRelationReference
-	// Delete all reference relations first.
+	referenceType, manualSource := store.MemoRelationReference, store.MemoRelationSourceManual
+	// Delete all manual reference relations first, the ones parsed from the content follow the content.
 	if err := s.Store.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{
 		MemoID: &id,
 		Type:   &referenceType,
+		Source: &manualSource,
 	}); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete memo relation")
 	}
@@ -35,6 +40,9 @@ func (s *APIV2Service) SetMemoRelations(ctx context.Context, request *apiv2pb.Se
 		if relation.Type == apiv2pb.MemoRelation_COMMENT {
 			continue
 		}
+		if relation.Source == apiv2pb.MemoRelation_CONTENT {
+			continue
+		}
 		relatedMemoID, err := ExtractMemoIDFromName(relation.RelatedMemo)
 		if err != nil {
 			return nil, status.Errorf(codes.InvalidArgument, "invalid related memo name: %v", err)
@@ -43,6 +51,7 @@ func (s *APIV2Service) SetMemoRelations(ctx context.Context, request *apiv2pb.Se
 			MemoID:        id,
 			RelatedMemoID: relatedMemoID,
 			Type:          convertMemoRelationTypeToStore(relation.Type),
+			Source:        store.MemoRelationSourceManual,
 		}); err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to upsert memo relation")
 		}
@@ -82,11 +91,113 @@ func (s *APIV2Service) ListMemoRelations(ctx context.Context, request *apiv2pb.L
 	return response, nil
 }
 
+func (s *APIV2Service) ListMemoBacklinks(ctx context.Context, request *apiv2pb.ListMemoBacklinksRequest) (*apiv2pb.ListMemoBacklinksResponse, error) {
+	id, err := ExtractMemoIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if err := s.checkMemoAccess(ctx, memo, auth.MemoAccessView); err != nil {
+		return nil, err
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+
+	referenceType := store.MemoRelationReference
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
+		RelatedMemoID: &id,
+		Type:          &referenceType,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo relations")
+	}
+	response := &apiv2pb.ListMemoBacklinksResponse{
+		Backlinks: []*apiv2pb.MemoBacklink{},
+	}
+	if len(relations) == 0 {
+		return response, nil
+	}
+
+	memoIDs := []any{}
+	for _, relation := range relations {
+		memoIDs = append(memoIDs, relation.MemoID)
+	}
+	normalStatus := store.Normal
+	referencingMemos, err := s.Store.ListMemos(ctx, &store.FindMemo{
+		RowStatus: &normalStatus,
+		Filter:    &filter.InExpr{Field: "id", Values: memoIDs},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	}
+	visibleMemos := []*store.Memo{}
+	for _, referencingMemo := range referencingMemos {
+		canView, err := auth.CanAccessMemo(ctx, s.Store, user, referencingMemo, auth.MemoAccessView)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check memo access: %v", err)
+		}
+		if canView {
+			visibleMemos = append(visibleMemos, referencingMemo)
+		}
+	}
+	memoMessages, err := s.convertMemosFromStore(ctx, visibleMemos)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert memos: %v", err)
+	}
+	for i, referencingMemo := range visibleMemos {
+		response.Backlinks = append(response.Backlinks, &apiv2pb.MemoBacklink{
+			Memo:     memoMessages[i],
+			Snippets: getMemoBacklinkSnippets(referencingMemo, memo),
+		})
+	}
+	return response, nil
+}
+
+// getMemoBacklinkSnippets returns the snippets of the links to the memo in the content of the referencing memo.
+// The links by title only point to the memos of the same creator.
+func getMemoBacklinkSnippets(referencingMemo, memo *store.Memo) []string {
+	snippets := []string{}
+	if referencingMemo.Encrypted {
+		return snippets
+	}
+	title := store.GetMemoTitle(memo.Content)
+	for _, link := range store.ExtractMemoLinks(referencingMemo.Content) {
+		linked := link.MemoID == memo.ID
+		if link.Title != "" {
+			linked = referencingMemo.CreatorID == memo.CreatorID && strings.EqualFold(link.Title, title)
+		}
+		if linked && !slices.Contains(snippets, link.Snippet) {
+			snippets = append(snippets, link.Snippet)
+		}
+	}
+	return snippets
+}
+
 func convertMemoRelationFromStore(memoRelation *store.MemoRelation) *apiv2pb.MemoRelation {
 	return &apiv2pb.MemoRelation{
 		Memo:        fmt.Sprintf("%s%d", MemoNamePrefix, memoRelation.MemoID),
 		RelatedMemo: fmt.Sprintf("%s%d", MemoNamePrefix, memoRelation.RelatedMemoID),
 		Type:        convertMemoRelationTypeFromStore(memoRelation.Type),
+		Source:      convertMemoRelationSourceFromStore(memoRelation.Source),
+	}
+}
+
+func convertMemoRelationSourceFromStore(source store.MemoRelationSource) apiv2pb.MemoRelation_Source {
+	switch source {
+	case store.MemoRelationSourceManual:
+		return apiv2pb.MemoRelation_MANUAL
+	case store.MemoRelationSourceContent:
+		return apiv2pb.MemoRelation_CONTENT
+	default:
+		return apiv2pb.MemoRelation_SOURCE_UNSPECIFIED
 	}
 }
 
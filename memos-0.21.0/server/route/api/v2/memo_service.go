@@ -7,20 +7,25 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"slices"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/cel-go/cel"
 	"github.com/lithammer/shortuuid/v4"
 	"github.com/pkg/errors"
-	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/usememos/memos/internal/envelope"
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/filter"
 	"github.com/usememos/memos/plugin/webhook"
 	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/route/api/auth"
 	apiv1 "github.com/usememos/memos/server/route/api/v1"
 	"github.com/usememos/memos/store"
 )
@@ -57,6 +62,25 @@ func (s *APIV2Service) CreateMemo(ctx context.Context, request *apiv2pb.CreateMe
 	if disablePublicMemosSystem && create.Visibility == store.Public {
 		return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
 	}
+	if request.Group != "" {
+		group, err := s.getGroupByName(ctx, request.Group)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkGroupMember(ctx, group); err != nil {
+			return nil, err
+		}
+		// Memos of group spaces are only visible to the members.
+		create.GroupID = group.ID
+		create.Visibility = store.Private
+	}
+	if request.Encrypted {
+		if err := validateEncryptedMemo(create.Content, request.Visibility); err != nil {
+			return nil, err
+		}
+		create.Encrypted = true
+		create.Visibility = store.Private
+	}
 
 	memo, err := s.Store.CreateMemo(ctx, create)
 	if err != nil {
@@ -83,47 +107,68 @@ func (s *APIV2Service) ListMemos(ctx context.Context, request *apiv2pb.ListMemos
 		// Exclude comments by default.
 		ExcludeComments: true,
 	}
+	var view *store.MemoView
+	if request.View != "" {
+		var err error
+		if view, err = s.getMemoViewByName(ctx, request.View); err != nil {
+			return nil, err
+		}
+	}
 	if err := s.buildMemoFindWithFilter(ctx, memoFind, request.Filter); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "failed to build find memos with filter")
+		return nil, err
 	}
-
-	var limit, offset int
-	if request.PageToken != "" {
-		var pageToken apiv2pb.PageToken
-		if err := unmarshalPageToken(request.PageToken, &pageToken); err != nil {
-			return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	if view != nil {
+		displayWithUpdatedTs, err := s.getMemoDisplayWithUpdatedTsSettingValue(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo display with updated ts setting value")
+		}
+		if err := ApplyMemoView(memoFind, view, displayWithUpdatedTs); err != nil {
+			return nil, status.Errorf(codes.FailedPrecondition, "failed to apply view: %v", err)
 		}
-		limit = int(pageToken.Limit)
-		offset = int(pageToken.Offset)
+	}
+
+	// The memos sorted by a property are paged by offset, as the values of the properties make no cursor.
+	limit, offset := 0, 0
+	var cursor *store.Cursor
+	var err error
+	if memoFind.OrderByProperty != "" {
+		limit, offset, err = s.getPageLimitAndOffset(request.PageSize, request.PageToken)
 	} else {
-		limit = int(request.PageSize)
+		limit, cursor, err = s.getPageLimitAndCursor(request.PageSize, request.PageToken)
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
 	}
 	if limit <= 0 {
 		limit = DefaultPageSize
 	}
 	limitPlusOne := limit + 1
 	memoFind.Limit = &limitPlusOne
-	memoFind.Offset = &offset
+	if memoFind.OrderByProperty != "" {
+		memoFind.Offset = &offset
+	}
+	memoFind.Cursor = cursor
 	memos, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
 	}
 
-	memoMessages := []*apiv2pb.Memo{}
 	nextPageToken := ""
 	if len(memos) == limitPlusOne {
 		memos = memos[:limit]
-		nextPageToken, err = getPageToken(limit, offset+limit)
+		// The memos in random order have no next page, as they have no position to continue from.
+		if memoFind.OrderByProperty != "" {
+			nextPageToken, err = s.getPageToken(limit, offset+limit)
+		} else if !memoFind.Random {
+			nextPageToken, err = s.getCursorPageToken(limit, store.GetMemoCursor(memoFind, memos[len(memos)-1]))
+		}
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
 		}
 	}
-	for _, memo := range memos {
-		memoMessage, err := s.convertMemoFromStore(ctx, memo)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert memo")
-		}
-		memoMessages = append(memoMessages, memoMessage)
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
 	}
 
 	response := &apiv2pb.ListMemosResponse{
@@ -140,9 +185,8 @@ func (s *APIV2Service) SearchMemos(ctx context.Context, request *apiv2pb.SearchM
 		ExcludeComments: true,
 		Limit:           &defaultSearchLimit,
 	}
-	err := s.buildMemoFindWithFilter(ctx, memoFind, request.Filter)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "failed to build find memos with filter")
+	if err := s.buildMemoFindWithFilter(ctx, memoFind, request.Filter); err != nil {
+		return nil, err
 	}
 
 	memos, err := s.Store.ListMemos(ctx, memoFind)
@@ -150,13 +194,9 @@ func (s *APIV2Service) SearchMemos(ctx context.Context, request *apiv2pb.SearchM
 		return nil, status.Errorf(codes.Internal, "failed to search memos")
 	}
 
-	memoMessages := []*apiv2pb.Memo{}
-	for _, memo := range memos {
-		memoMessage, err := s.convertMemoFromStore(ctx, memo)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert memo")
-		}
-		memoMessages = append(memoMessages, memoMessage)
+	memoMessages, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
 	}
 
 	response := &apiv2pb.SearchMemosResponse{
@@ -179,17 +219,8 @@ func (s *APIV2Service) GetMemo(ctx context.Context, request *apiv2pb.GetMemoRequ
 	if memo == nil {
 		return nil, status.Errorf(codes.NotFound, "memo not found")
 	}
-	if memo.Visibility != store.Public {
-		user, err := getCurrentUser(ctx, s.Store)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to get user")
-		}
-		if user == nil {
-			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
-		}
-		if memo.Visibility == store.Private && memo.CreatorID != user.ID {
-			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
-		}
+	if err := s.checkMemoAccess(ctx, memo, auth.MemoAccessView); err != nil {
+		return nil, err
 	}
 
 	memoMessage, err := s.convertMemoFromStore(ctx, memo)
@@ -221,7 +252,27 @@ func (s *APIV2Service) UpdateMemo(ctx context.Context, request *apiv2pb.UpdateMe
 
 	user, _ := getCurrentUser(ctx, s.Store)
 	if memo.CreatorID != user.ID {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		canModerate, err := s.canModerateMemo(ctx, user, memo)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+		}
+		canEdit, err := auth.CanAccessMemo(ctx, s.Store, user, memo, auth.MemoAccessEdit)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check memo access: %v", err)
+		}
+		// Moderators can only hide or archive the memos of others,
+		// and the users the memo is shared with as editors can only change its content and properties.
+		for _, path := range request.UpdateMask.Paths {
+			allowed := false
+			if path == "visibility" || path == "row_status" {
+				allowed = canModerate
+			} else if path == "content" || path == "properties" {
+				allowed = canEdit
+			}
+			if !allowed {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied to update %s", path)
+			}
+		}
 	}
 
 	currentTs := time.Now().Unix()
@@ -229,9 +280,18 @@ func (s *APIV2Service) UpdateMemo(ctx context.Context, request *apiv2pb.UpdateMe
 		ID:        id,
 		UpdatedTs: &currentTs,
 	}
+	var properties []*store.MemoProperty
 	for _, path := range request.UpdateMask.Paths {
 		if path == "content" {
 			update.Content = &request.Memo.Content
+		} else if path == "properties" {
+			properties = []*store.MemoProperty{}
+			for _, property := range request.Memo.Properties {
+				properties = append(properties, convertMemoPropertyToStore(property))
+			}
+			if err := store.NormalizeMemoProperties(properties); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid properties: %v", err)
+			}
 		} else if path == "uid" {
 			update.UID = &request.Memo.Name
 			if !util.UIDMatcher.MatchString(*update.UID) {
@@ -247,7 +307,12 @@ func (s *APIV2Service) UpdateMemo(ctx context.Context, request *apiv2pb.UpdateMe
 			if disablePublicMemosSystem && visibility == store.Public {
 				return nil, status.Errorf(codes.PermissionDenied, "disable public memos system setting is enabled")
 			}
+			if memo.GroupID != 0 && visibility != store.Private {
+				return nil, status.Errorf(codes.InvalidArgument, "memos of group spaces must be private")
+			}
 			update.Visibility = &visibility
+		} else if path == "encrypted" {
+			update.Encrypted = &request.Memo.Encrypted
 		} else if path == "row_status" {
 			rowStatus := convertRowStatusToStore(request.Memo.RowStatus)
 			update.RowStatus = &rowStatus
@@ -267,10 +332,38 @@ func (s *APIV2Service) UpdateMemo(ctx context.Context, request *apiv2pb.UpdateMe
 	if update.Content != nil && len(*update.Content) > MaxContentLength {
 		return nil, status.Errorf(codes.InvalidArgument, "content too long")
 	}
+	// The content of an encrypted memo must stay an envelope, including when a plaintext memo gets encrypted.
+	encrypted := memo.Encrypted
+	if update.Encrypted != nil {
+		encrypted = *update.Encrypted
+	}
+	if encrypted && (update.Content != nil || update.Visibility != nil || !memo.Encrypted) {
+		content := memo.Content
+		if update.Content != nil {
+			content = *update.Content
+		}
+		visibility := apiv2pb.Visibility_VISIBILITY_UNSPECIFIED
+		if update.Visibility != nil {
+			visibility = convertVisibilityFromStore(*update.Visibility)
+		}
+		if err := validateEncryptedMemo(content, visibility); err != nil {
+			return nil, err
+		}
+		private := store.Private
+		update.Visibility = &private
+	}
 
 	if err = s.Store.UpdateMemo(ctx, update); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update memo")
 	}
+	if properties != nil {
+		if err := s.Store.SetMemoProperties(ctx, &store.SetMemoProperties{
+			MemoID:     id,
+			Properties: properties,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to set memo properties")
+		}
+	}
 
 	memo, err = s.Store.GetMemo(ctx, &store.FindMemo{
 		ID: &id,
@@ -309,7 +402,13 @@ func (s *APIV2Service) DeleteMemo(ctx context.Context, request *apiv2pb.DeleteMe
 
 	user, _ := getCurrentUser(ctx, s.Store)
 	if memo.CreatorID != user.ID {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		canModerate, err := s.canModerateMemo(ctx, user, memo)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to check permission: %v", err)
+		}
+		if !canModerate {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
 	}
 
 	if memoMessage, err := s.convertMemoFromStore(ctx, memo); err == nil {
@@ -326,6 +425,15 @@ func (s *APIV2Service) DeleteMemo(ctx context.Context, request *apiv2pb.DeleteMe
 	return &apiv2pb.DeleteMemoResponse{}, nil
 }
 
+// canModerateMemo returns true if the user is allowed to moderate the memo of another user.
+// Only public memos can be moderated.
+func (s *APIV2Service) canModerateMemo(ctx context.Context, user *store.User, memo *store.Memo) (bool, error) {
+	if memo.Visibility != store.Public {
+		return false, nil
+	}
+	return auth.HasPermission(ctx, s.Store, user, auth.PermissionModeratePublicMemos)
+}
+
 func (s *APIV2Service) CreateMemoComment(ctx context.Context, request *apiv2pb.CreateMemoCommentRequest) (*apiv2pb.CreateMemoCommentResponse, error) {
 	id, err := ExtractMemoIDFromName(request.Name)
 	if err != nil {
@@ -335,6 +443,12 @@ func (s *APIV2Service) CreateMemoComment(ctx context.Context, request *apiv2pb.C
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get memo")
 	}
+	if relatedMemo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if err := s.checkMemoAccess(ctx, relatedMemo, auth.MemoAccessComment); err != nil {
+		return nil, err
+	}
 
 	// Create the comment memo first.
 	createMemoResponse, err := s.CreateMemo(ctx, request.Comment)
@@ -399,6 +513,21 @@ func (s *APIV2Service) ListMemoComments(ctx context.Context, request *apiv2pb.Li
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
 	}
+	relatedMemo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo")
+	}
+	if relatedMemo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	if err := s.checkMemoAccess(ctx, relatedMemo, auth.MemoAccessView); err != nil {
+		return nil, err
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+
 	memoRelationComment := store.MemoRelationComment
 	memoRelations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{
 		RelatedMemoID: &id,
@@ -408,7 +537,7 @@ func (s *APIV2Service) ListMemoComments(ctx context.Context, request *apiv2pb.Li
 		return nil, status.Errorf(codes.Internal, "failed to list memo relations")
 	}
 
-	var memos []*apiv2pb.Memo
+	comments := []*store.Memo{}
 	for _, memoRelation := range memoRelations {
 		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
 			ID: &memoRelation.MemoID,
@@ -416,14 +545,25 @@ func (s *APIV2Service) ListMemoComments(ctx context.Context, request *apiv2pb.Li
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to get memo")
 		}
-		if memo != nil {
-			memoMessage, err := s.convertMemoFromStore(ctx, memo)
+		if memo == nil {
+			continue
+		}
+		// The creator of the memo sees all its comments, others only the ones they can access.
+		canView := user != nil && user.ID == relatedMemo.CreatorID
+		if !canView {
+			canView, err = auth.CanAccessMemo(ctx, s.Store, user, memo, auth.MemoAccessView)
 			if err != nil {
-				return nil, errors.Wrap(err, "failed to convert memo")
+				return nil, status.Errorf(codes.Internal, "failed to check memo access: %v", err)
 			}
-			memos = append(memos, memoMessage)
+		}
+		if canView {
+			comments = append(comments, memo)
 		}
 	}
+	memos, err := s.convertMemosFromStore(ctx, comments)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to convert memos")
+	}
 
 	response := &apiv2pb.ListMemoCommentsResponse{
 		Memos: memos,
@@ -454,7 +594,7 @@ func (s *APIV2Service) GetUserMemosStats(ctx context.Context, request *apiv2pb.G
 		ExcludeContent:  true,
 	}
 	if err := s.buildMemoFindWithFilter(ctx, memoFind, request.Filter); err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "failed to build find memos with filter")
+		return nil, err
 	}
 
 	memos, err := s.Store.ListMemos(ctx, memoFind)
@@ -494,26 +634,36 @@ func (s *APIV2Service) ExportMemos(ctx context.Context, request *apiv2pb.ExportM
 		ExcludeComments: true,
 	}
 	if err := s.buildMemoFindWithFilter(ctx, memoFind, request.Filter); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to build find memos with filter: %v", err)
+		return nil, err
 	}
 
 	memos, err := s.Store.ListMemos(ctx, memoFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
 	}
+	propertyMap := map[int32][]*store.MemoProperty{}
+	if len(memos) > 0 {
+		memoIDs := []int32{}
+		for _, memo := range memos {
+			memoIDs = append(memoIDs, memo.ID)
+		}
+		properties, err := s.Store.ListMemoProperties(ctx, &store.FindMemoProperty{MemoIDList: memoIDs})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list memo properties: %v", err)
+		}
+		for _, property := range properties {
+			propertyMap[property.MemoID] = append(propertyMap[property.MemoID], property)
+		}
+	}
 
 	buf := new(bytes.Buffer)
 	writer := zip.NewWriter(buf)
 	for _, memo := range memos {
-		memoMessage, err := s.convertMemoFromStore(ctx, memo)
-		if err != nil {
-			return nil, errors.Wrap(err, "failed to convert memo")
-		}
 		file, err := writer.Create(time.Unix(memo.CreatedTs, 0).Format(time.RFC3339) + ".md")
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Failed to create memo file")
 		}
-		_, err = file.Write([]byte(memoMessage.Content))
+		_, err = file.Write([]byte(getMemoFrontMatter(propertyMap[memo.ID]) + memo.Content))
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "Failed to write to memo file")
 		}
@@ -527,49 +677,143 @@ func (s *APIV2Service) ExportMemos(ctx context.Context, request *apiv2pb.ExportM
 	}, nil
 }
 
+// getMemoFrontMatter returns the properties as the YAML front matter of the exported memo, or nothing without properties.
+func getMemoFrontMatter(properties []*store.MemoProperty) string {
+	if len(properties) == 0 {
+		return ""
+	}
+	lines := []string{"---"}
+	for _, property := range properties {
+		value := property.Value
+		if property.Type != store.MemoPropertyNumber && property.Type != store.MemoPropertyBoolean {
+			value = strconv.Quote(value)
+		}
+		lines = append(lines, property.Key+": "+value)
+	}
+	lines = append(lines, "---", "")
+	return strings.Join(lines, "\n") + "\n"
+}
+
 func (s *APIV2Service) convertMemoFromStore(ctx context.Context, memo *store.Memo) (*apiv2pb.Memo, error) {
-	displayTs := memo.CreatedTs
-	if displayWithUpdatedTs, err := s.getMemoDisplayWithUpdatedTsSettingValue(ctx); err == nil && displayWithUpdatedTs {
-		displayTs = memo.UpdatedTs
+	memoMessages, err := s.convertMemosFromStore(ctx, []*store.Memo{memo})
+	if err != nil {
+		return nil, err
+	}
+	return memoMessages[0], nil
+}
+
+// convertMemosFromStore converts the memos with the creators, relations, resources and reactions of all of them
+// loaded together, so that the number of queries doesn't grow with the number of memos.
+func (s *APIV2Service) convertMemosFromStore(ctx context.Context, memos []*store.Memo) ([]*apiv2pb.Memo, error) {
+	memoMessages := []*apiv2pb.Memo{}
+	if len(memos) == 0 {
+		return memoMessages, nil
 	}
+	// The memos are displayed with their creation time if the setting can't be read.
+	displayWithUpdatedTs, _ := s.getMemoDisplayWithUpdatedTsSettingValue(ctx)
 
-	creator, err := s.Store.GetUser(ctx, &store.FindUser{ID: &memo.CreatorID})
+	memoIDs, creatorIDs, names := []int32{}, []int32{}, []string{}
+	for _, memo := range memos {
+		memoIDs = append(memoIDs, memo.ID)
+		if !slices.Contains(creatorIDs, memo.CreatorID) {
+			creatorIDs = append(creatorIDs, memo.CreatorID)
+		}
+		names = append(names, fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID))
+	}
+
+	creators, err := s.Store.ListUsers(ctx, &store.FindUser{IDList: creatorIDs})
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to get creator")
+		return nil, errors.Wrap(err, "failed to list creators")
+	}
+	creatorMap := map[int32]*store.User{}
+	for _, creator := range creators {
+		creatorMap[creator.ID] = creator
 	}
 
-	name := fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)
-	listMemoRelationsResponse, err := s.ListMemoRelations(ctx, &apiv2pb.ListMemoRelationsRequest{Name: name})
+	// The relations of a memo are the ones from it followed by the ones to it.
+	relationMap := map[int32][]*apiv2pb.MemoRelation{}
+	relations, err := s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{MemoIDList: memoIDs})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list memo relations")
 	}
+	for _, relation := range relations {
+		relationMap[relation.MemoID] = append(relationMap[relation.MemoID], convertMemoRelationFromStore(relation))
+	}
+	relations, err = s.Store.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoIDList: memoIDs})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo relations")
+	}
+	for _, relation := range relations {
+		relationMap[relation.RelatedMemoID] = append(relationMap[relation.RelatedMemoID], convertMemoRelationFromStore(relation))
+	}
 
-	listMemoResourcesResponse, err := s.ListMemoResources(ctx, &apiv2pb.ListMemoResourcesRequest{Name: name})
+	resourceMap := map[int32][]*apiv2pb.Resource{}
+	resources, err := s.Store.ListResources(ctx, &store.FindResource{MemoIDList: memoIDs})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list memo resources")
 	}
+	for _, resource := range resources {
+		memoName := fmt.Sprintf("%s%d", MemoNamePrefix, *resource.MemoID)
+		resourceMap[*resource.MemoID] = append(resourceMap[*resource.MemoID], convertResourceFromStoreWithMemoName(resource, &memoName))
+	}
 
-	listMemoReactionsResponse, err := s.ListMemoReactions(ctx, &apiv2pb.ListMemoReactionsRequest{Name: name})
+	reactionMap := map[string][]*apiv2pb.Reaction{}
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{ContentIDList: names})
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to list memo reactions")
 	}
+	for _, reaction := range reactions {
+		reactionMap[reaction.ContentId] = append(reactionMap[reaction.ContentId], convertReactionFromStore(reaction))
+	}
 
-	return &apiv2pb.Memo{
-		Name:        name,
-		Uid:         memo.UID,
-		RowStatus:   convertRowStatusFromStore(memo.RowStatus),
-		Creator:     fmt.Sprintf("%s%d", UserNamePrefix, creator.ID),
-		CreateTime:  timestamppb.New(time.Unix(memo.CreatedTs, 0)),
-		UpdateTime:  timestamppb.New(time.Unix(memo.UpdatedTs, 0)),
-		DisplayTime: timestamppb.New(time.Unix(displayTs, 0)),
-		Content:     memo.Content,
-		Visibility:  convertVisibilityFromStore(memo.Visibility),
-		Pinned:      memo.Pinned,
-		ParentId:    memo.ParentID,
-		Relations:   listMemoRelationsResponse.Relations,
-		Resources:   listMemoResourcesResponse.Resources,
-		Reactions:   listMemoReactionsResponse.Reactions,
-	}, nil
+	propertyMap := map[int32][]*apiv2pb.MemoProperty{}
+	properties, err := s.Store.ListMemoProperties(ctx, &store.FindMemoProperty{MemoIDList: memoIDs})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list memo properties")
+	}
+	for _, property := range properties {
+		propertyMap[property.MemoID] = append(propertyMap[property.MemoID], convertMemoPropertyFromStore(property))
+	}
+
+	for i, memo := range memos {
+		creator, ok := creatorMap[memo.CreatorID]
+		if !ok {
+			return nil, errors.Errorf("creator %d of memo %d not found", memo.CreatorID, memo.ID)
+		}
+		displayTs := memo.CreatedTs
+		if displayWithUpdatedTs {
+			displayTs = memo.UpdatedTs
+		}
+		group := ""
+		if memo.GroupID != 0 {
+			group = fmt.Sprintf("%s%d", GroupNamePrefix, memo.GroupID)
+		}
+		memoMessage := &apiv2pb.Memo{
+			Name:        names[i],
+			Uid:         memo.UID,
+			RowStatus:   convertRowStatusFromStore(memo.RowStatus),
+			Creator:     fmt.Sprintf("%s%d", UserNamePrefix, creator.ID),
+			CreateTime:  timestamppb.New(time.Unix(memo.CreatedTs, 0)),
+			UpdateTime:  timestamppb.New(time.Unix(memo.UpdatedTs, 0)),
+			DisplayTime: timestamppb.New(time.Unix(displayTs, 0)),
+			Content:     memo.Content,
+			Visibility:  convertVisibilityFromStore(memo.Visibility),
+			Pinned:      memo.Pinned,
+			ParentId:    memo.ParentID,
+			Relations:   []*apiv2pb.MemoRelation{},
+			Resources:   []*apiv2pb.Resource{},
+			Reactions:   []*apiv2pb.Reaction{},
+			Group:       group,
+			Encrypted:   memo.Encrypted,
+			Properties:  []*apiv2pb.MemoProperty{},
+		}
+		memoMessage.Relations = append(memoMessage.Relations, relationMap[memo.ID]...)
+		memoMessage.Resources = append(memoMessage.Resources, resourceMap[memo.ID]...)
+		memoMessage.Reactions = append(memoMessage.Reactions, reactionMap[names[i]]...)
+		memoMessage.Properties = append(memoMessage.Properties, propertyMap[memo.ID]...)
+		memoMessages = append(memoMessages, memoMessage)
+	}
+	return memoMessages, nil
 }
 
 func (s *APIV2Service) getMemoDisplayWithUpdatedTsSettingValue(ctx context.Context) (bool, error) {
@@ -608,6 +852,18 @@ func (s *APIV2Service) getDisablePublicMemosSystemSettingValue(ctx context.Conte
 	return disablePublicMemos, nil
 }
 
+// validateEncryptedMemo checks the content of an encrypted memo is a valid envelope.
+// Encrypted memos are private, so the requested visibility can only be private or unspecified.
+func validateEncryptedMemo(content string, visibility apiv2pb.Visibility) error {
+	if visibility != apiv2pb.Visibility_VISIBILITY_UNSPECIFIED && visibility != apiv2pb.Visibility_PRIVATE {
+		return status.Errorf(codes.InvalidArgument, "encrypted memos must be private")
+	}
+	if _, err := envelope.Parse(content); err != nil {
+		return status.Errorf(codes.InvalidArgument, "invalid encrypted content: %v", err)
+	}
+	return nil
+}
+
 func convertVisibilityFromStore(visibility store.Visibility) apiv2pb.Visibility {
 	switch visibility {
 	case store.Private:
@@ -634,197 +890,246 @@ func convertVisibilityToStore(visibility apiv2pb.Visibility) store.Visibility {
 	}
 }
 
-func (s *APIV2Service) buildMemoFindWithFilter(ctx context.Context, find *store.FindMemo, filter string) error {
+func (s *APIV2Service) buildMemoFindWithFilter(ctx context.Context, find *store.FindMemo, expression string) error {
 	user, _ := getCurrentUser(ctx, s.Store)
 	if find == nil {
 		find = &store.FindMemo{}
 	}
-	if filter != "" {
-		filter, err := parseSearchMemosFilter(filter)
-		if err != nil {
+	displayWithUpdatedTs, err := s.getMemoDisplayWithUpdatedTsSettingValue(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get memo display with updated ts setting value")
+	}
+	if expression != "" {
+		if err := applyMemoFilter(find, expression, displayWithUpdatedTs); err != nil {
 			return status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 		}
-		if len(filter.ContentSearch) > 0 {
-			find.ContentSearch = filter.ContentSearch
-		}
-		if len(filter.Visibilities) > 0 {
-			find.VisibilityList = filter.Visibilities
-		}
-		if filter.OrderByPinned {
-			find.OrderByPinned = filter.OrderByPinned
-		}
-		if filter.DisplayTimeAfter != nil {
-			displayWithUpdatedTs, err := s.getMemoDisplayWithUpdatedTsSettingValue(ctx)
-			if err != nil {
-				return status.Errorf(codes.Internal, "failed to get memo display with updated ts setting value")
-			}
-			if displayWithUpdatedTs {
-				find.UpdatedTsAfter = filter.DisplayTimeAfter
-			} else {
-				find.CreatedTsAfter = filter.DisplayTimeAfter
-			}
-		}
-		if filter.DisplayTimeBefore != nil {
-			displayWithUpdatedTs, err := s.getMemoDisplayWithUpdatedTsSettingValue(ctx)
-			if err != nil {
-				return status.Errorf(codes.Internal, "failed to get memo display with updated ts setting value")
-			}
-			if displayWithUpdatedTs {
-				find.UpdatedTsBefore = filter.DisplayTimeBefore
-			} else {
-				find.CreatedTsBefore = filter.DisplayTimeBefore
-			}
-		}
-		if filter.Creator != nil {
-			userID, err := ExtractUserIDFromName(*filter.Creator)
-			if err != nil {
-				return errors.Wrap(err, "invalid user name")
-			}
-			user, err := s.Store.GetUser(ctx, &store.FindUser{
-				ID: &userID,
-			})
-			if err != nil {
-				return status.Errorf(codes.Internal, "failed to get user")
-			}
-			if user == nil {
-				return status.Errorf(codes.NotFound, "user not found")
-			}
-			find.CreatorID = &user.ID
-		}
-		if filter.UID != nil {
-			find.UID = filter.UID
-		}
-		if filter.RowStatus != nil {
-			find.RowStatus = filter.RowStatus
-		}
-		if filter.Random {
-			find.Random = filter.Random
-		}
-		if filter.Limit != nil {
-			find.Limit = filter.Limit
-		}
 	}
 
 	// If the user is not authenticated, only public memos are visible.
 	if user == nil {
-		if filter == "" {
+		if expression == "" {
 			// If no filter is provided, return an error.
 			return status.Errorf(codes.InvalidArgument, "filter is required")
 		}
 
 		find.VisibilityList = []store.Visibility{store.Public}
-	} else if find.CreatorID != nil && *find.CreatorID != user.ID {
-		find.VisibilityList = []store.Visibility{store.Public, store.Protected}
+	} else {
+		// Others' memos are only visible if they are public, protected or shared with the user.
+		find.VisibleToUserID = &user.ID
 	}
 
-	displayWithUpdatedTs, err := s.getMemoDisplayWithUpdatedTsSettingValue(ctx)
-	if err != nil {
-		return status.Errorf(codes.Internal, "failed to get memo display with updated ts setting value")
-	}
 	if displayWithUpdatedTs {
 		find.OrderByUpdatedTs = true
 	}
 	return nil
 }
 
-// SearchMemosFilterCELAttributes are the CEL attributes.
-var SearchMemosFilterCELAttributes = []cel.EnvOption{
-	cel.Variable("content_search", cel.ListType(cel.StringType)),
-	cel.Variable("visibilities", cel.ListType(cel.StringType)),
-	cel.Variable("order_by_pinned", cel.BoolType),
-	cel.Variable("display_time_before", cel.IntType),
-	cel.Variable("display_time_after", cel.IntType),
-	cel.Variable("creator", cel.StringType),
-	cel.Variable("uid", cel.StringType),
-	cel.Variable("row_status", cel.StringType),
-	cel.Variable("random", cel.BoolType),
-	cel.Variable("limit", cel.IntType),
-}
-
-type SearchMemosFilter struct {
-	ContentSearch     []string
-	Visibilities      []store.Visibility
-	OrderByPinned     bool
-	DisplayTimeBefore *int64
-	DisplayTimeAfter  *int64
-	Creator           *string
-	UID               *string
-	RowStatus         *store.RowStatus
-	Random            bool
-	Limit             *int
-}
-
-func parseSearchMemosFilter(expression string) (*SearchMemosFilter, error) {
-	e, err := cel.NewEnv(SearchMemosFilterCELAttributes...)
+// applyMemoFilter adds the conditions and the options of the filter to the find, with the relative times resolved now.
+func applyMemoFilter(find *store.FindMemo, expression string, displayWithUpdatedTs bool) error {
+	memoFilter, err := filter.Parse(filter.ResolveRelativeTimes(expression, time.Now()), getSearchMemosFilterFields(displayWithUpdatedTs))
 	if err != nil {
-		return nil, err
+		return err
 	}
-	ast, issues := e.Compile(expression)
-	if issues != nil {
-		return nil, errors.Errorf("found issue %v", issues)
+	// The conditions of the filter are added to the ones of the find, so they can only narrow the memos down.
+	find.Filter = filter.And(find.Filter, memoFilter.Expr)
+	if v, ok := memoFilter.Options["order_by_pinned"]; ok {
+		find.OrderByPinned = v.(bool)
 	}
-	filter := &SearchMemosFilter{}
-	expr, err := cel.AstToParsedExpr(ast)
-	if err != nil {
-		return nil, err
+	if v, ok := memoFilter.Options["random"]; ok {
+		find.Random = v.(bool)
 	}
-	callExpr := expr.GetExpr().GetCallExpr()
-	findSearchMemosField(callExpr, filter)
-	return filter, nil
+	if v, ok := memoFilter.Options["limit"]; ok {
+		limit := int(v.(int64))
+		find.Limit = &limit
+	}
+	if v, ok := memoFilter.Options["order_by"]; ok {
+		if err := applyMemoOrderBy(find, v.(string), displayWithUpdatedTs); err != nil {
+			return errors.Wrap(err, "invalid order_by")
+		}
+	}
+	return nil
 }
 
-func findSearchMemosField(callExpr *expr.Expr_Call, filter *SearchMemosFilter) {
-	if len(callExpr.Args) == 2 {
-		idExpr := callExpr.Args[0].GetIdentExpr()
-		if idExpr != nil {
-			if idExpr.Name == "content_search" {
-				contentSearch := []string{}
-				for _, expr := range callExpr.Args[1].GetListExpr().GetElements() {
-					value := expr.GetConstExpr().GetStringValue()
-					contentSearch = append(contentSearch, value)
-				}
-				filter.ContentSearch = contentSearch
-			} else if idExpr.Name == "visibilities" {
-				visibilities := []store.Visibility{}
-				for _, expr := range callExpr.Args[1].GetListExpr().GetElements() {
-					value := expr.GetConstExpr().GetStringValue()
-					visibilities = append(visibilities, store.Visibility(value))
-				}
-				filter.Visibilities = visibilities
-			} else if idExpr.Name == "order_by_pinned" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.OrderByPinned = value
-			} else if idExpr.Name == "display_time_before" {
-				displayTimeBefore := callExpr.Args[1].GetConstExpr().GetInt64Value()
-				filter.DisplayTimeBefore = &displayTimeBefore
-			} else if idExpr.Name == "display_time_after" {
-				displayTimeAfter := callExpr.Args[1].GetConstExpr().GetInt64Value()
-				filter.DisplayTimeAfter = &displayTimeAfter
-			} else if idExpr.Name == "creator" {
-				creator := callExpr.Args[1].GetConstExpr().GetStringValue()
-				filter.Creator = &creator
-			} else if idExpr.Name == "uid" {
-				uid := callExpr.Args[1].GetConstExpr().GetStringValue()
-				filter.UID = &uid
-			} else if idExpr.Name == "row_status" {
-				rowStatus := store.RowStatus(callExpr.Args[1].GetConstExpr().GetStringValue())
-				filter.RowStatus = &rowStatus
-			} else if idExpr.Name == "random" {
-				value := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.Random = value
-			} else if idExpr.Name == "limit" {
-				limit := int(callExpr.Args[1].GetConstExpr().GetInt64Value())
-				filter.Limit = &limit
+// getSearchMemosFilterFields returns the variables of the memo filters.
+// The display time is the update time of the memos if they are displayed with it, the creation time otherwise.
+func getSearchMemosFilterFields(displayWithUpdatedTs bool) []*filter.Field {
+	displayTimeColumn := "created_ts"
+	if displayWithUpdatedTs {
+		displayTimeColumn = "updated_ts"
+	}
+	return []*filter.Field{
+		{Name: "content", Type: cel.StringType, Rewrite: rewriteMemoContentCondition},
+		{Name: "visibility", Type: cel.StringType},
+		{Name: "creator", Type: cel.StringType, Column: "creator_id", Convert: convertUserNameToID},
+		{Name: "uid", Type: cel.StringType},
+		{Name: "group", Type: cel.StringType, Column: "group_id", Convert: convertGroupNameToID},
+		{Name: "row_status", Type: cel.StringType},
+		{Name: "pinned", Type: cel.BoolType},
+		{Name: "tag", Type: cel.StringType, Rewrite: rewriteMemoTagCondition},
+		{Name: "has_resource", Type: cel.BoolType},
+		{Name: "has_task", Type: cel.BoolType},
+		{Name: "has_reference", Type: cel.BoolType},
+		{Name: "create_time", Type: cel.TimestampType, Column: "created_ts", Convert: convertTimestampToUnix},
+		{Name: "update_time", Type: cel.TimestampType, Column: "updated_ts", Convert: convertTimestampToUnix},
+		{Name: "display_time", Type: cel.TimestampType, Column: displayTimeColumn, Convert: convertTimestampToUnix},
+		{Name: "property", Type: cel.MapType(cel.StringType, cel.DynType), Keyed: true, Convert: convertMemoPropertyValue, Rewrite: rewriteMemoPropertyCondition},
+		// The fields kept for the filters built by the clients before the conditions were supported.
+		{Name: "content_search", Type: cel.ListType(cel.StringType), Column: "content", Rewrite: rewriteMemoContentSearchCondition},
+		{Name: "visibilities", Type: cel.ListType(cel.StringType), Column: "visibility", Rewrite: rewriteEqualToIn},
+		{Name: "display_time_before", Type: cel.IntType, Column: displayTimeColumn, Rewrite: rewriteEqualTo(filter.OperatorLess)},
+		{Name: "display_time_after", Type: cel.IntType, Column: displayTimeColumn, Rewrite: rewriteEqualTo(filter.OperatorGreater)},
+		// Options.
+		{Name: "order_by_pinned", Type: cel.BoolType, Option: true},
+		{Name: "random", Type: cel.BoolType, Option: true},
+		{Name: "limit", Type: cel.IntType, Option: true},
+		// order_by is an order such as "property.priority desc, display_time desc".
+		{Name: "order_by", Type: cel.StringType, Option: true},
+	}
+}
+
+// excludeEncryptedMemos adds the condition that the memos are not encrypted, as the encrypted content never matches.
+func excludeEncryptedMemos(e filter.Expr) filter.Expr {
+	return filter.And(&filter.CompareExpr{Field: "encrypted", Operator: filter.OperatorEqual, Value: false}, e)
+}
+
+func rewriteMemoContentCondition(e filter.Expr) (filter.Expr, error) {
+	return excludeEncryptedMemos(e), nil
+}
+
+// rewriteMemoContentSearchCondition rewrites content_search == [...] into the memos containing all the words.
+func rewriteMemoContentSearchCondition(e filter.Expr) (filter.Expr, error) {
+	compareExpr, ok := e.(*filter.CompareExpr)
+	if !ok || compareExpr.Operator != filter.OperatorEqual {
+		return nil, errors.New("can only be compared with ==")
+	}
+	conditions := []filter.Expr{}
+	for _, word := range compareExpr.Value.([]any) {
+		conditions = append(conditions, &filter.MatchExpr{Field: compareExpr.Field, Kind: filter.MatchContains, Value: word.(string)})
+	}
+	if len(conditions) == 0 {
+		return &filter.AndExpr{}, nil
+	}
+	return excludeEncryptedMemos(filter.And(conditions...)), nil
+}
+
+// rewriteMemoTagCondition rewrites the conditions on the tags to include their child tags.
+func rewriteMemoTagCondition(e filter.Expr) (filter.Expr, error) {
+	switch e := e.(type) {
+	case *filter.CompareExpr:
+		if name, ok := e.Value.(string); ok {
+			if e.Operator == filter.OperatorEqual {
+				return getTagFilter(name), nil
+			} else if e.Operator == filter.OperatorNotEqual {
+				return &filter.NotExpr{Expr: getTagFilter(name)}, nil
 			}
-			return
 		}
+	case *filter.InExpr:
+		conditions := []filter.Expr{}
+		for _, value := range e.Values {
+			conditions = append(conditions, getTagFilter(value.(string)))
+		}
+		return &filter.OrExpr{Exprs: conditions}, nil
+	}
+	return e, nil
+}
+
+// convertMemoPropertyValue converts the timestamps compared with the properties to unix timestamps,
+// which are the number values of the dates.
+func convertMemoPropertyValue(value any) (any, error) {
+	if t, ok := value.(time.Time); ok {
+		return t.Unix(), nil
 	}
-	for _, arg := range callExpr.Args {
-		callExpr := arg.GetCallExpr()
-		if callExpr != nil {
-			findSearchMemosField(callExpr, filter)
+	return value, nil
+}
+
+// rewriteMemoPropertyCondition compares the numbers, the booleans and the timestamps with the number values of the properties,
+// and the strings with their text values.
+func rewriteMemoPropertyCondition(e filter.Expr) (filter.Expr, error) {
+	switch e := e.(type) {
+	case *filter.CompareExpr:
+		if isMemoPropertyNumberValue(e.Value) {
+			e.Field = getMemoPropertyNumberField(e.Field)
+		}
+	case *filter.InExpr:
+		numbers := 0
+		for _, value := range e.Values {
+			if isMemoPropertyNumberValue(value) {
+				numbers++
+			}
+		}
+		if numbers > 0 && numbers < len(e.Values) {
+			return nil, errors.New("the values must all be strings or all be numbers")
+		}
+		if numbers > 0 {
+			e.Field = getMemoPropertyNumberField(e.Field)
 		}
 	}
+	return e, nil
+}
+
+func isMemoPropertyNumberValue(value any) bool {
+	switch value.(type) {
+	case int64, float64, bool:
+		return true
+	default:
+		return false
+	}
+}
+
+// getMemoPropertyNumberField returns the field of the number value of the property field, such as property_number.priority.
+func getMemoPropertyNumberField(field string) string {
+	return "property_number." + strings.TrimPrefix(field, "property.")
+}
+
+func convertMemoPropertyFromStore(property *store.MemoProperty) *apiv2pb.MemoProperty {
+	return &apiv2pb.MemoProperty{
+		Key:   property.Key,
+		Type:  convertMemoPropertyTypeFromStore(property.Type),
+		Value: property.Value,
+	}
+}
+
+func convertMemoPropertyToStore(property *apiv2pb.MemoProperty) *store.MemoProperty {
+	return &store.MemoProperty{
+		Key:   property.Key,
+		Type:  convertMemoPropertyTypeToStore(property.Type),
+		Value: property.Value,
+	}
+}
+
+func convertMemoPropertyTypeFromStore(propertyType store.MemoPropertyType) apiv2pb.MemoProperty_Type {
+	switch propertyType {
+	case store.MemoPropertyString:
+		return apiv2pb.MemoProperty_STRING
+	case store.MemoPropertyNumber:
+		return apiv2pb.MemoProperty_NUMBER
+	case store.MemoPropertyBoolean:
+		return apiv2pb.MemoProperty_BOOLEAN
+	case store.MemoPropertyDate:
+		return apiv2pb.MemoProperty_DATE
+	case store.MemoPropertyURL:
+		return apiv2pb.MemoProperty_URL
+	default:
+		return apiv2pb.MemoProperty_TYPE_UNSPECIFIED
+	}
+}
+
+// convertMemoPropertyTypeToStore returns an empty type for the unspecified one, which is invalid in the store.
+func convertMemoPropertyTypeToStore(propertyType apiv2pb.MemoProperty_Type) store.MemoPropertyType {
+	switch propertyType {
+	case apiv2pb.MemoProperty_STRING:
+		return store.MemoPropertyString
+	case apiv2pb.MemoProperty_NUMBER:
+		return store.MemoPropertyNumber
+	case apiv2pb.MemoProperty_BOOLEAN:
+		return store.MemoPropertyBoolean
+	case apiv2pb.MemoProperty_DATE:
+		return store.MemoPropertyDate
+	case apiv2pb.MemoProperty_URL:
+		return store.MemoPropertyURL
+	default:
+		return ""
+	}
 }
 
 // DispatchMemoCreatedWebhook dispatches webhook when memo is created.
@@ -847,12 +1152,27 @@ func (s *APIV2Service) dispatchMemoRelatedWebhook(ctx context.Context, memo *api
 	if err != nil {
 		return status.Errorf(codes.InvalidArgument, "invalid memo creator")
 	}
+	personalGroupID := int32(0)
 	webhooks, err := s.Store.ListWebhooks(ctx, &store.FindWebhook{
 		CreatorID: &creatorID,
+		GroupID:   &personalGroupID,
 	})
 	if err != nil {
 		return err
 	}
+	if memo.Group != "" {
+		groupID, err := ExtractGroupIDFromName(memo.Group)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "invalid memo group")
+		}
+		groupWebhooks, err := s.Store.ListWebhooks(ctx, &store.FindWebhook{
+			GroupID: &groupID,
+		})
+		if err != nil {
+			return err
+		}
+		webhooks = append(webhooks, groupWebhooks...)
+	}
 	for _, hook := range webhooks {
 		payload, err := convertMemoToWebhookPayload(memo)
 		if err != nil {
@@ -900,6 +1220,17 @@ func convertMemoToWebhookPayload(memo *apiv2pb.Memo) (*webhook.WebhookPayload, e
 				}
 				return resources
 			}(),
+			PropertyList: func() []*webhook.MemoProperty {
+				properties := []*webhook.MemoProperty{}
+				for _, property := range memo.Properties {
+					properties = append(properties, &webhook.MemoProperty{
+						Key:   property.Key,
+						Type:  property.Type.String(),
+						Value: property.Value,
+					})
+				}
+				return properties
+			}(),
 		},
 	}, nil
 }
@@ -0,0 +1,200 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	"github.com/usememos/memos/server/route/api/auth"
+	"github.com/usememos/memos/store"
+)
+
+// memoShareLinkTokenLength is the length of share link tokens, about 190 bits of randomness.
+const memoShareLinkTokenLength = 32
+
+func (s *APIV2Service) CreateMemoShareLink(ctx context.Context, request *apiv2pb.CreateMemoShareLinkRequest) (*apiv2pb.CreateMemoShareLinkResponse, error) {
+	memo, err := s.getOwnMemoByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if request.MaxViews < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "max views must not be negative")
+	}
+
+	token, err := util.RandomString(memoShareLinkTokenLength)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate token: %v", err)
+	}
+	create := &store.MemoShareLink{
+		CreatorID: memo.CreatorID,
+		MemoID:    memo.ID,
+		Token:     token,
+		MaxViews:  request.MaxViews,
+	}
+	if request.ExpireTime != nil {
+		expireTime := request.ExpireTime.AsTime()
+		if !expireTime.After(time.Now()) {
+			return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+		}
+		create.ExpiresTs = expireTime.Unix()
+	}
+	if request.Password != "" {
+		passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.Password), bcrypt.DefaultCost)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to generate password hash: %v", err)
+		}
+		create.PasswordHash = string(passwordHash)
+	}
+
+	link, err := s.Store.CreateMemoShareLink(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create memo share link: %v", err)
+	}
+	return &apiv2pb.CreateMemoShareLinkResponse{
+		ShareLink: convertMemoShareLinkFromStore(link),
+	}, nil
+}
+
+func (s *APIV2Service) ListMemoShareLinks(ctx context.Context, request *apiv2pb.ListMemoShareLinksRequest) (*apiv2pb.ListMemoShareLinksResponse, error) {
+	memo, err := s.getOwnMemoByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	links, err := s.Store.ListMemoShareLinks(ctx, &store.FindMemoShareLink{
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo share links: %v", err)
+	}
+	response := &apiv2pb.ListMemoShareLinksResponse{
+		ShareLinks: []*apiv2pb.MemoShareLink{},
+	}
+	for _, link := range links {
+		response.ShareLinks = append(response.ShareLinks, convertMemoShareLinkFromStore(link))
+	}
+	return response, nil
+}
+
+func (s *APIV2Service) DeleteMemoShareLink(ctx context.Context, request *apiv2pb.DeleteMemoShareLinkRequest) (*apiv2pb.DeleteMemoShareLinkResponse, error) {
+	memoID, id, err := ExtractMemoShareLinkIDFromName(request.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid share link name: %v", err)
+	}
+	memo, err := s.getOwnMemoByName(ctx, fmt.Sprintf("%s%d", MemoNamePrefix, memoID))
+	if err != nil {
+		return nil, err
+	}
+	link, err := s.Store.GetMemoShareLink(ctx, &store.FindMemoShareLink{
+		ID:     &id,
+		MemoID: &memo.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo share link: %v", err)
+	}
+	if link == nil {
+		return nil, status.Errorf(codes.NotFound, "share link not found")
+	}
+
+	if err := s.Store.DeleteMemoShareLink(ctx, &store.DeleteMemoShareLink{
+		ID: &link.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete memo share link: %v", err)
+	}
+	return &apiv2pb.DeleteMemoShareLinkResponse{}, nil
+}
+
+func (s *APIV2Service) GetSharedMemo(ctx context.Context, request *apiv2pb.GetSharedMemoRequest) (*apiv2pb.GetSharedMemoResponse, error) {
+	if request.Token == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "token is required")
+	}
+	link, err := s.Store.GetMemoShareLink(ctx, &store.FindMemoShareLink{
+		Token: &request.Token,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo share link: %v", err)
+	}
+	// Expired links look the same as revoked ones.
+	if link == nil || auth.IsMemoShareLinkExpired(link, time.Now()) {
+		return nil, status.Errorf(codes.NotFound, "share link not found")
+	}
+	if link.PasswordHash != "" {
+		if request.Password == "" {
+			return nil, status.Errorf(codes.Unauthenticated, "password required")
+		}
+		if err := bcrypt.CompareHashAndPassword([]byte(link.PasswordHash), []byte(request.Password)); err != nil {
+			return nil, status.Errorf(codes.PermissionDenied, "incorrect password")
+		}
+	}
+
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
+		ID: &link.MemoID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "share link not found")
+	}
+	counted, err := s.Store.IncreaseMemoShareLinkViewCount(ctx, link.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to count view: %v", err)
+	}
+	if !counted {
+		return nil, status.Errorf(codes.NotFound, "share link not found")
+	}
+
+	memoMessage, err := s.convertMemoFromStore(ctx, memo)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert memo: %v", err)
+	}
+	return &apiv2pb.GetSharedMemoResponse{
+		Memo:     memoMessage,
+		ShareKey: auth.GenerateMemoShareKey(link, []byte(s.Secret), time.Now()),
+	}, nil
+}
+
+// getOwnMemoByName returns the memo if it was created by the current user.
+func (s *APIV2Service) getOwnMemoByName(ctx context.Context, name string) (*store.Memo, error) {
+	id, err := ExtractMemoIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid memo name: %v", err)
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user")
+	}
+	if user == nil || memo.CreatorID != user.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return memo, nil
+}
+
+func convertMemoShareLinkFromStore(link *store.MemoShareLink) *apiv2pb.MemoShareLink {
+	shareLink := &apiv2pb.MemoShareLink{
+		Name:              fmt.Sprintf("%s%d/%s%d", MemoNamePrefix, link.MemoID, ShareLinkNamePrefix, link.ID),
+		Token:             link.Token,
+		CreateTime:        timestamppb.New(time.Unix(link.CreatedTs, 0)),
+		MaxViews:          link.MaxViews,
+		ViewCount:         link.ViewCount,
+		PasswordProtected: link.PasswordHash != "",
+	}
+	if link.ExpiresTs > 0 {
+		shareLink.ExpireTime = timestamppb.New(time.Unix(link.ExpiresTs, 0))
+	}
+	return shareLink
+}
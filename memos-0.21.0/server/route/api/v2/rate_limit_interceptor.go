@@ -0,0 +1,53 @@
+package v2
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/usememos/memos/internal/ratelimit"
+	"github.com/usememos/memos/store"
+)
+
+// RateLimitInterceptor limits the request rate of each client IP, user and access token.
+type RateLimitInterceptor struct {
+	limiter *ratelimit.Limiter
+}
+
+func NewRateLimitInterceptor(limiter *ratelimit.Limiter) *RateLimitInterceptor {
+	return &RateLimitInterceptor{
+		limiter: limiter,
+	}
+}
+
+// IPRateLimitInterceptor limits the request rate of each client IP.
+// It runs before the auth interceptor so that the requests failing authentication are limited too.
+func (in *RateLimitInterceptor) IPRateLimitInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if ip := getClientIPFromContext(ctx); ip != "" && !in.limiter.Allow("ip:"+ip) {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many requests to %s", serverInfo.FullMethod)
+	}
+	return handler(ctx, request)
+}
+
+// RateLimitInterceptor limits the request rate of each user and access token.
+// It runs after the auth interceptor so that the user and access token are known.
+func (in *RateLimitInterceptor) RateLimitInterceptor(ctx context.Context, request any, serverInfo *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !in.limiter.Allow(getRateLimitKeys(ctx)...) {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many requests to %s", serverInfo.FullMethod)
+	}
+	return handler(ctx, request)
+}
+
+// getRateLimitKeys returns the keys identifying the user and the access token of the request.
+func getRateLimitKeys(ctx context.Context) []string {
+	keys := []string{}
+	if username, ok := ctx.Value(usernameContextKey).(string); ok {
+		keys = append(keys, "user:"+username)
+	}
+	if accessToken, ok := ctx.Value(accessTokenContextKey).(string); ok {
+		keys = append(keys, "token:"+store.HashAccessToken(accessToken))
+	}
+	return keys
+}
@@ -24,11 +24,7 @@ func (s *APIV2Service) ListMemoReactions(ctx context.Context, request *apiv2pb.L
 		Reactions: []*apiv2pb.Reaction{},
 	}
 	for _, reaction := range reactions {
-		reactionMessage, err := s.convertReactionFromStore(ctx, reaction)
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to convert reaction")
-		}
-		response.Reactions = append(response.Reactions, reactionMessage)
+		response.Reactions = append(response.Reactions, convertReactionFromStore(reaction))
 	}
 	return response, nil
 }
@@ -47,12 +43,8 @@ func (s *APIV2Service) UpsertMemoReaction(ctx context.Context, request *apiv2pb.
 		return nil, status.Errorf(codes.Internal, "failed to upsert reaction")
 	}
 
-	reactionMessage, err := s.convertReactionFromStore(ctx, reaction)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to convert reaction")
-	}
 	return &apiv2pb.UpsertMemoReactionResponse{
-		Reaction: reactionMessage,
+		Reaction: convertReactionFromStore(reaction),
 	}, nil
 }
 
@@ -66,17 +58,11 @@ func (s *APIV2Service) DeleteMemoReaction(ctx context.Context, request *apiv2pb.
 	return &apiv2pb.DeleteMemoReactionResponse{}, nil
 }
 
-func (s *APIV2Service) convertReactionFromStore(ctx context.Context, reaction *storepb.Reaction) (*apiv2pb.Reaction, error) {
-	creator, err := s.Store.GetUser(ctx, &store.FindUser{
-		ID: &reaction.CreatorId,
-	})
-	if err != nil {
-		return nil, err
-	}
+func convertReactionFromStore(reaction *storepb.Reaction) *apiv2pb.Reaction {
 	return &apiv2pb.Reaction{
 		Id:           reaction.Id,
-		Creator:      fmt.Sprintf("%s%d", UserNamePrefix, creator.ID),
+		Creator:      fmt.Sprintf("%s%d", UserNamePrefix, reaction.CreatorId),
 		ContentId:    reaction.ContentId,
 		ReactionType: apiv2pb.Reaction_Type(reaction.ReactionType),
-	}, nil
+	}
 }
@@ -15,6 +15,11 @@ const (
 	MemoNamePrefix             = "memos/"
 	ResourceNamePrefix         = "resources/"
 	InboxNamePrefix            = "inboxes/"
+	GroupNamePrefix            = "groups/"
+	ShareLinkNamePrefix        = "shareLinks/"
+	InvitationNamePrefix       = "invitations/"
+	ViewNamePrefix             = "views/"
+	TaskNamePrefix             = "tasks/"
 )
 
 // GetNameParentTokens returns the tokens from a resource name.
@@ -96,3 +101,76 @@ func ExtractInboxIDFromName(name string) (int32, error) {
 	}
 	return id, nil
 }
+
+// ExtractGroupIDFromName returns the group ID from a resource name.
+func ExtractGroupIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, GroupNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid group ID %q", tokens[0])
+	}
+	return id, nil
+}
+
+// ExtractMemoShareLinkIDFromName returns the memo ID and the share link ID from a resource name.
+func ExtractMemoShareLinkIDFromName(name string) (int32, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, ShareLinkNamePrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	memoID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid memo ID %q", tokens[0])
+	}
+	id, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid share link ID %q", tokens[1])
+	}
+	return memoID, id, nil
+}
+
+// ExtractMemoTaskFromName returns the memo ID and the task position from a resource name.
+func ExtractMemoTaskFromName(name string) (int32, int32, error) {
+	tokens, err := GetNameParentTokens(name, MemoNamePrefix, TaskNamePrefix)
+	if err != nil {
+		return 0, 0, err
+	}
+	memoID, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, 0, errors.Errorf("invalid memo ID %q", tokens[0])
+	}
+	position, err := util.ConvertStringToInt32(tokens[1])
+	if err != nil || position < 0 {
+		return 0, 0, errors.Errorf("invalid task position %q", tokens[1])
+	}
+	return memoID, position, nil
+}
+
+// ExtractInvitationIDFromName returns the invitation ID from a resource name.
+func ExtractInvitationIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, InvitationNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid invitation ID %q", tokens[0])
+	}
+	return id, nil
+}
+
+// ExtractViewIDFromName returns the view ID from a resource name.
+func ExtractViewIDFromName(name string) (int32, error) {
+	tokens, err := GetNameParentTokens(name, ViewNamePrefix)
+	if err != nil {
+		return 0, err
+	}
+	id, err := util.ConvertStringToInt32(tokens[0])
+	if err != nil {
+		return 0, errors.Errorf("invalid view ID %q", tokens[0])
+	}
+	return id, nil
+}
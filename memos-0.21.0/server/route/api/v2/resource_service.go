@@ -8,12 +8,11 @@ import (
 
 	"github.com/google/cel-go/cel"
 	"github.com/lithammer/shortuuid/v4"
-	"github.com/pkg/errors"
-	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/usememos/memos/plugin/filter"
 	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
 	"github.com/usememos/memos/store"
 )
@@ -58,19 +57,37 @@ func (s *APIV2Service) CreateResource(ctx context.Context, request *apiv2pb.Crea
 	}, nil
 }
 
-func (s *APIV2Service) ListResources(ctx context.Context, _ *apiv2pb.ListResourcesRequest) (*apiv2pb.ListResourcesResponse, error) {
+func (s *APIV2Service) ListResources(ctx context.Context, request *apiv2pb.ListResourcesRequest) (*apiv2pb.ListResourcesResponse, error) {
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
-	resources, err := s.Store.ListResources(ctx, &store.FindResource{
+	resourceFind := &store.FindResource{
 		CreatorID: &user.ID,
-	})
+	}
+	limit, cursor, err := s.getPageLimitAndCursor(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	}
+	resourceFind.Cursor = cursor
+	limitPlusOne := limit + 1
+	if limit > 0 {
+		resourceFind.Limit = &limitPlusOne
+	}
+	resources, err := s.Store.ListResources(ctx, resourceFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list resources: %v", err)
 	}
 
 	response := &apiv2pb.ListResourcesResponse{}
+	if limit > 0 && len(resources) == limitPlusOne {
+		resources = resources[:limit]
+		last := resources[len(resources)-1]
+		response.NextPageToken, err = s.getCursorPageToken(limit, &store.Cursor{Ts: last.UpdatedTs, ID: last.ID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
 	for _, resource := range resources {
 		response.Resources = append(response.Resources, s.convertResourceFromStore(ctx, resource))
 	}
@@ -81,13 +98,12 @@ func (s *APIV2Service) SearchResources(ctx context.Context, request *apiv2pb.Sea
 	if request.Filter == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "filter is empty")
 	}
-	filter, err := parseSearchResourcesFilter(request.Filter)
+	resourceFilter, err := filter.Parse(request.Filter, SearchResourcesFilterFields)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "failed to parse filter: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
-	resourceFind := &store.FindResource{}
-	if filter.UID != nil {
-		resourceFind.UID = filter.UID
+	resourceFind := &store.FindResource{
+		Filter: resourceFilter.Expr,
 	}
 	user, err := getCurrentUser(ctx, s.Store)
 	if err != nil {
@@ -193,71 +209,40 @@ func (s *APIV2Service) DeleteResource(ctx context.Context, request *apiv2pb.Dele
 }
 
 func (s *APIV2Service) convertResourceFromStore(ctx context.Context, resource *store.Resource) *apiv2pb.Resource {
-	resourceMessage := &apiv2pb.Resource{
-		Name:         fmt.Sprintf("%s%d", ResourceNamePrefix, resource.ID),
-		Uid:          resource.UID,
-		CreateTime:   timestamppb.New(time.Unix(resource.CreatedTs, 0)),
-		Filename:     resource.Filename,
-		ExternalLink: resource.ExternalLink,
-		Type:         resource.Type,
-		Size:         resource.Size,
-	}
+	var memoName *string
 	if resource.MemoID != nil {
 		memo, _ := s.Store.GetMemo(ctx, &store.FindMemo{
 			ID: resource.MemoID,
 		})
 		if memo != nil {
-			memoName := fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)
-			resourceMessage.Memo = &memoName
+			name := fmt.Sprintf("%s%d", MemoNamePrefix, memo.ID)
+			memoName = &name
 		}
 	}
-
-	return resourceMessage
-}
-
-// SearchResourcesFilterCELAttributes are the CEL attributes for SearchResourcesFilter.
-var SearchResourcesFilterCELAttributes = []cel.EnvOption{
-	cel.Variable("uid", cel.StringType),
-}
-
-type SearchResourcesFilter struct {
-	UID *string
+	return convertResourceFromStoreWithMemoName(resource, memoName)
 }
 
-func parseSearchResourcesFilter(expression string) (*SearchResourcesFilter, error) {
-	e, err := cel.NewEnv(SearchResourcesFilterCELAttributes...)
-	if err != nil {
-		return nil, err
-	}
-	ast, issues := e.Compile(expression)
-	if issues != nil {
-		return nil, errors.Errorf("found issue %v", issues)
-	}
-	filter := &SearchResourcesFilter{}
-	expr, err := cel.AstToParsedExpr(ast)
-	if err != nil {
-		return nil, err
+// convertResourceFromStoreWithMemoName converts the resource of the memo whose name is already known.
+func convertResourceFromStoreWithMemoName(resource *store.Resource, memoName *string) *apiv2pb.Resource {
+	return &apiv2pb.Resource{
+		Name:         fmt.Sprintf("%s%d", ResourceNamePrefix, resource.ID),
+		Uid:          resource.UID,
+		CreateTime:   timestamppb.New(time.Unix(resource.CreatedTs, 0)),
+		Filename:     resource.Filename,
+		ExternalLink: resource.ExternalLink,
+		Type:         resource.Type,
+		Size:         resource.Size,
+		Memo:         memoName,
 	}
-	callExpr := expr.GetExpr().GetCallExpr()
-	findSearchResourcesField(callExpr, filter)
-	return filter, nil
 }
 
-func findSearchResourcesField(callExpr *expr.Expr_Call, filter *SearchResourcesFilter) {
-	if len(callExpr.Args) == 2 {
-		idExpr := callExpr.Args[0].GetIdentExpr()
-		if idExpr != nil {
-			if idExpr.Name == "uid" {
-				uid := callExpr.Args[1].GetConstExpr().GetStringValue()
-				filter.UID = &uid
-			}
-			return
-		}
-	}
-	for _, arg := range callExpr.Args {
-		callExpr := arg.GetCallExpr()
-		if callExpr != nil {
-			findSearchResourcesField(callExpr, filter)
-		}
-	}
+// SearchResourcesFilterFields are the variables of the resource filters.
+var SearchResourcesFilterFields = []*filter.Field{
+	{Name: "uid", Type: cel.StringType},
+	{Name: "filename", Type: cel.StringType},
+	{Name: "type", Type: cel.StringType},
+	{Name: "size", Type: cel.IntType},
+	{Name: "memo", Type: cel.StringType, Column: "memo_id", Convert: convertMemoNameToID},
+	{Name: "create_time", Type: cel.TimestampType, Column: "created_ts", Convert: convertTimestampToUnix},
+	{Name: "update_time", Type: cel.TimestampType, Column: "updated_ts", Convert: convertTimestampToUnix},
 }
@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 	"slices"
-	"sort"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/yourselfhosted/gomark/ast"
@@ -14,6 +14,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"github.com/usememos/memos/plugin/filter"
 	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
 	"github.com/usememos/memos/store"
 )
@@ -23,6 +24,27 @@ func (s *APIV2Service) UpsertTag(ctx context.Context, request *apiv2pb.UpsertTag
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user")
 	}
+	if request.Group != "" {
+		group, err := s.getGroupByName(ctx, request.Group)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkGroupMember(ctx, group); err != nil {
+			return nil, err
+		}
+		if _, err := s.Store.UpsertUserGroupTag(ctx, &store.UserGroupTag{
+			GroupID: group.ID,
+			Name:    request.Name,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to upsert group tag: %v", err)
+		}
+		return &apiv2pb.UpsertTagResponse{
+			Tag: &apiv2pb.Tag{
+				Name:  request.Name,
+				Group: request.Group,
+			},
+		}, nil
+	}
 
 	tag, err := s.Store.UpsertTag(ctx, &store.Tag{
 		Name:      request.Name,
@@ -51,6 +73,10 @@ func (s *APIV2Service) BatchUpsertTag(ctx context.Context, request *apiv2pb.Batc
 }
 
 func (s *APIV2Service) ListTags(ctx context.Context, request *apiv2pb.ListTagsRequest) (*apiv2pb.ListTagsResponse, error) {
+	if request.Group != "" {
+		return s.listGroupTags(ctx, request.Group)
+	}
+
 	tagFind := &store.FindTag{}
 	userID, err := ExtractUserIDFromName(request.User)
 	if err != nil {
@@ -62,83 +88,142 @@ func (s *APIV2Service) ListTags(ctx context.Context, request *apiv2pb.ListTagsRe
 		return nil, status.Errorf(codes.Internal, "failed to list tags: %v", err)
 	}
 
+	normalRowStatus := store.Normal
+	memoTagCounts, err := s.Store.ListMemoTagCounts(ctx, &store.FindMemoTagCount{
+		CreatorID: &userID,
+		RowStatus: &normalRowStatus,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list memo tag counts: %v", err)
+	}
+	memoCounts := map[string]int32{}
+	for _, memoTagCount := range memoTagCounts {
+		memoCounts[memoTagCount.Tag] = memoTagCount.Count
+	}
+
 	response := &apiv2pb.ListTagsResponse{}
 	for _, tag := range tags {
 		t, err := s.convertTagFromStore(ctx, tag)
 		if err != nil {
 			return nil, status.Errorf(codes.Internal, "failed to convert tag: %v", err)
 		}
+		t.MemoCount = memoCounts[tag.Name]
 		response.Tags = append(response.Tags, t)
 	}
 	return response, nil
 }
 
-func (s *APIV2Service) RenameTag(ctx context.Context, request *apiv2pb.RenameTagRequest) (*apiv2pb.RenameTagResponse, error) {
-	userID, err := ExtractUserIDFromName(request.User)
-	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+func (s *APIV2Service) UpdateTag(ctx context.Context, request *apiv2pb.UpdateTagRequest) (*apiv2pb.UpdateTagResponse, error) {
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
 	}
-	user, err := s.Store.GetUser(ctx, &store.FindUser{
-		ID: &userID,
-	})
+	if request.Tag == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "tag is required")
+	}
+	user, err := s.getTagCreator(ctx, request.Tag.Creator)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		return nil, err
 	}
-	if user == nil {
-		return nil, status.Errorf(codes.NotFound, "user not found")
+	if err := validateTagName(request.Tag.Name); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag name: %v", err)
 	}
 
-	// Find all related memos.
-	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{
-		CreatorID:     &user.ID,
-		ContentSearch: []string{fmt.Sprintf("#%s", request.OldName)},
-	})
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+	update := &store.UpdateTag{
+		Name:      request.Tag.Name,
+		CreatorID: user.ID,
 	}
-	// Replace tag name in memo content.
-	for _, memo := range memos {
-		nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
-		if err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to parse memo: %v", err)
-		}
-		TraverseASTNodes(nodes, func(node ast.Node) {
-			if tag, ok := node.(*ast.Tag); ok && tag.Content == request.OldName {
-				tag.Content = request.NewName
-			}
-		})
-		content := restore.Restore(nodes)
-		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
-			ID:      memo.ID,
-			Content: &content,
-		}); err != nil {
-			return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
+	for _, path := range request.UpdateMask.Paths {
+		if path == "color" {
+			update.Color = &request.Tag.Color
+		} else if path == "emoji" {
+			update.Emoji = &request.Tag.Emoji
+		} else if path == "description" {
+			update.Description = &request.Tag.Description
+		} else if path == "pinned" {
+			update.Pinned = &request.Tag.Pinned
+		} else {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
 		}
 	}
-
-	// Delete old tag and create new tag.
-	if err := s.Store.DeleteTag(ctx, &store.DeleteTag{
+	// The tags only used in the memos are saved to keep their metadata.
+	if _, err := s.Store.UpsertTag(ctx, &store.Tag{
+		Name:      request.Tag.Name,
 		CreatorID: user.ID,
-		Name:      request.OldName,
 	}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to delete tag: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to upsert tag: %v", err)
 	}
-	tag, err := s.Store.UpsertTag(ctx, &store.Tag{
-		CreatorID: user.ID,
-		Name:      request.NewName,
-	})
+	if err := s.Store.UpdateTag(ctx, update); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update tag: %v", err)
+	}
+
+	tagMessage, err := s.getTagMessage(ctx, user.ID, request.Tag.Name)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to upsert tag: %v", err)
+		return nil, err
 	}
+	return &apiv2pb.UpdateTagResponse{Tag: tagMessage}, nil
+}
 
-	tagMessage, err := s.convertTagFromStore(ctx, tag)
+func (s *APIV2Service) RenameTag(ctx context.Context, request *apiv2pb.RenameTagRequest) (*apiv2pb.RenameTagResponse, error) {
+	user, err := s.getTagCreator(ctx, request.User)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to convert tag: %v", err)
+		return nil, err
+	}
+	if err := validateTagName(request.NewName); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag name: %v", err)
+	}
+
+	if err := s.moveTags(ctx, user.ID, []string{request.OldName}, request.NewName); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to rename tag: %v", err)
+	}
+
+	tagMessage, err := s.getTagMessage(ctx, user.ID, request.NewName)
+	if err != nil {
+		return nil, err
 	}
 	return &apiv2pb.RenameTagResponse{Tag: tagMessage}, nil
 }
 
+func (s *APIV2Service) MergeTags(ctx context.Context, request *apiv2pb.MergeTagsRequest) (*apiv2pb.MergeTagsResponse, error) {
+	user, err := s.getTagCreator(ctx, request.User)
+	if err != nil {
+		return nil, err
+	}
+	if len(request.SourceNames) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "source names are required")
+	}
+	if err := validateTagName(request.TargetName); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid tag name: %v", err)
+	}
+
+	if err := s.moveTags(ctx, user.ID, request.SourceNames, request.TargetName); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to merge tags: %v", err)
+	}
+
+	tagMessage, err := s.getTagMessage(ctx, user.ID, request.TargetName)
+	if err != nil {
+		return nil, err
+	}
+	return &apiv2pb.MergeTagsResponse{Tag: tagMessage}, nil
+}
+
 func (s *APIV2Service) DeleteTag(ctx context.Context, request *apiv2pb.DeleteTagRequest) (*apiv2pb.DeleteTagResponse, error) {
+	if request.Tag.Group != "" {
+		group, err := s.getGroupByName(ctx, request.Tag.Group)
+		if err != nil {
+			return nil, err
+		}
+		if err := s.checkGroupAdmin(ctx, group); err != nil {
+			return nil, err
+		}
+		if err := s.Store.DeleteUserGroupTag(ctx, &store.DeleteUserGroupTag{
+			GroupID: group.ID,
+			Name:    request.Tag.Name,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to delete group tag: %v", err)
+		}
+		return &apiv2pb.DeleteTagResponse{}, nil
+	}
+
 	userID, err := ExtractUserIDFromName(request.Tag.Creator)
 	if err != nil {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
@@ -177,14 +262,12 @@ func (s *APIV2Service) GetTagSuggestions(ctx context.Context, request *apiv2pb.G
 		return nil, status.Errorf(codes.NotFound, "user not found")
 	}
 	normalRowStatus := store.Normal
-	memoFind := &store.FindMemo{
-		CreatorID:     &user.ID,
-		ContentSearch: []string{"#"},
-		RowStatus:     &normalRowStatus,
-	}
-	memos, err := s.Store.ListMemos(ctx, memoFind)
+	memoTagCounts, err := s.Store.ListMemoTagCounts(ctx, &store.FindMemoTagCount{
+		CreatorID: &user.ID,
+		RowStatus: &normalRowStatus,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list memos: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list memo tag counts: %v", err)
 	}
 
 	tagList, err := s.Store.ListTags(ctx, &store.FindTag{
@@ -198,32 +281,204 @@ func (s *APIV2Service) GetTagSuggestions(ctx context.Context, request *apiv2pb.G
 	for _, tag := range tagList {
 		tagNameList = append(tagNameList, tag.Name)
 	}
-	tagMapSet := make(map[string]bool)
+	// The tags used in the memos are sorted by name, only the ones not saved yet are suggested.
+	suggestions := []string{}
+	for _, memoTagCount := range memoTagCounts {
+		if !slices.Contains(tagNameList, memoTagCount.Tag) {
+			suggestions = append(suggestions, memoTagCount.Tag)
+		}
+	}
+
+	return &apiv2pb.GetTagSuggestionsResponse{
+		Tags: suggestions,
+	}, nil
+}
+
+// getTagCreator returns the current user, who must be the user of the name as the tags are personal.
+func (s *APIV2Service) getTagCreator(ctx context.Context, name string) (*store.User, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	if name != fmt.Sprintf("%s%d", UserNamePrefix, user.ID) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return user, nil
+}
+
+func (s *APIV2Service) getTagMessage(ctx context.Context, userID int32, name string) (*apiv2pb.Tag, error) {
+	tag, err := s.Store.GetTag(ctx, &store.FindTag{
+		CreatorID: userID,
+		Name:      &name,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get tag: %v", err)
+	}
+	if tag == nil {
+		return nil, status.Errorf(codes.NotFound, "tag not found")
+	}
+	tagMessage, err := s.convertTagFromStore(ctx, tag)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to convert tag: %v", err)
+	}
+	return tagMessage, nil
+}
+
+// moveTags renames the tags and their child tags to the new name in the memos and the saved tags of the user,
+// in the order of the old names, and saves the tag with the new name. It is done in a single transaction.
+// The tags already saved under the new names keep their metadata.
+func (s *APIV2Service) moveTags(ctx context.Context, userID int32, oldNames []string, newName string) error {
+	oldNames = slices.DeleteFunc(slices.Clone(oldNames), func(oldName string) bool {
+		return oldName == newName
+	})
+	rename := func(name string) (string, bool) {
+		moved := false
+		for _, oldName := range oldNames {
+			if movedName, ok := renameTagPath(name, oldName, newName); ok {
+				name, moved = movedName, true
+			}
+		}
+		return name, moved
+	}
+
+	memos := []*store.Memo{}
+	if len(oldNames) > 0 {
+		filters := []filter.Expr{}
+		for _, oldName := range oldNames {
+			filters = append(filters, getTagFilter(oldName))
+		}
+		var err error
+		memos, err = s.Store.ListMemos(ctx, &store.FindMemo{
+			CreatorID: &userID,
+			Filter:    &filter.OrExpr{Exprs: filters},
+		})
+		if err != nil {
+			return errors.Wrap(err, "failed to list memos")
+		}
+	}
 	for _, memo := range memos {
 		nodes, err := parser.Parse(tokenizer.Tokenize(memo.Content))
 		if err != nil {
-			return nil, errors.Wrap(err, "failed to parse memo content")
+			return errors.Wrap(err, "failed to parse memo")
 		}
-
-		// Dynamically upsert tags from memo content.
-		TraverseASTNodes(nodes, func(node ast.Node) {
-			if tagNode, ok := node.(*ast.Tag); ok {
-				tag := tagNode.Content
-				if !slices.Contains(tagNameList, tag) {
-					tagMapSet[tag] = true
-				}
+		store.TraverseASTNodes(nodes, func(node ast.Node) {
+			if tag, ok := node.(*ast.Tag); ok {
+				tag.Content, _ = rename(tag.Content)
 			}
 		})
+		memo.Content = restore.Restore(nodes)
 	}
-	suggestions := []string{}
-	for tag := range tagMapSet {
-		suggestions = append(suggestions, tag)
+
+	tags, err := s.Store.ListTags(ctx, &store.FindTag{
+		CreatorID: userID,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list tags")
+	}
+	move := &store.MoveTags{
+		CreatorID:   userID,
+		Memos:       memos,
+		DeleteNames: []string{},
+		Tags:        []*store.Tag{},
 	}
-	sort.Strings(suggestions)
+	keptTags, movedTags := map[string]bool{}, map[string]*store.Tag{}
+	for _, tag := range tags {
+		name, ok := rename(tag.Name)
+		if !ok {
+			keptTags[tag.Name] = true
+			continue
+		}
+		if _, ok := movedTags[name]; !ok {
+			movedTags[name] = tag
+		}
+		move.DeleteNames = append(move.DeleteNames, tag.Name)
+	}
+	if _, ok := movedTags[newName]; !ok && !keptTags[newName] {
+		move.Tags = append(move.Tags, &store.Tag{
+			Name:      newName,
+			CreatorID: userID,
+		})
+	}
+	for name, tag := range movedTags {
+		if keptTags[name] {
+			continue
+		}
+		move.Tags = append(move.Tags, &store.Tag{
+			Name:        name,
+			CreatorID:   userID,
+			Color:       tag.Color,
+			Emoji:       tag.Emoji,
+			Description: tag.Description,
+			Pinned:      tag.Pinned,
+		})
+	}
+	if err := s.Store.MoveTags(ctx, move); err != nil {
+		return errors.Wrap(err, "failed to move tags")
+	}
+	return nil
+}
 
-	return &apiv2pb.GetTagSuggestionsResponse{
-		Tags: suggestions,
-	}, nil
+// renameTagPath returns the new name of the tag if it is the renamed tag or one of its child tags.
+func renameTagPath(tag, oldName, newName string) (string, bool) {
+	if tag == oldName {
+		return newName, true
+	}
+	if store.IsTagDescendant(tag, oldName) {
+		return newName + strings.TrimPrefix(tag, oldName), true
+	}
+	return "", false
+}
+
+// getTagFilter returns the filter of the memos with the tag or one of its child tags.
+func getTagFilter(name string) filter.Expr {
+	return &filter.OrExpr{Exprs: []filter.Expr{
+		&filter.CompareExpr{Field: "tag", Operator: filter.OperatorEqual, Value: name},
+		&filter.MatchExpr{Field: "tag", Kind: filter.MatchStartsWith, Value: name + store.TagSeparator},
+	}}
+}
+
+// validateTagName checks the name can be written in the content of the memos as a tag.
+func validateTagName(name string) error {
+	if name == "" {
+		return errors.New("tag name is required")
+	}
+	if strings.ContainsAny(name, " \t\r\n#") {
+		return errors.New("tag name cannot contain spaces or #")
+	}
+	for _, part := range strings.Split(name, store.TagSeparator) {
+		if part == "" {
+			return errors.Errorf("tag name cannot have empty parts between %s", store.TagSeparator)
+		}
+	}
+	return nil
+}
+
+func (s *APIV2Service) listGroupTags(ctx context.Context, name string) (*apiv2pb.ListTagsResponse, error) {
+	group, err := s.getGroupByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkGroupMember(ctx, group); err != nil {
+		return nil, err
+	}
+	tags, err := s.Store.ListUserGroupTags(ctx, &store.FindUserGroupTag{
+		GroupID: group.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list group tags: %v", err)
+	}
+
+	response := &apiv2pb.ListTagsResponse{}
+	for _, tag := range tags {
+		response.Tags = append(response.Tags, &apiv2pb.Tag{
+			Name:  tag.Name,
+			Group: name,
+		})
+	}
+	return response, nil
 }
 
 func (s *APIV2Service) convertTagFromStore(ctx context.Context, tag *store.Tag) (*apiv2pb.Tag, error) {
@@ -234,29 +489,11 @@ func (s *APIV2Service) convertTagFromStore(ctx context.Context, tag *store.Tag)
 		return nil, errors.Wrap(err, "failed to get user")
 	}
 	return &apiv2pb.Tag{
-		Name:    tag.Name,
-		Creator: fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+		Name:        tag.Name,
+		Creator:     fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+		Color:       tag.Color,
+		Emoji:       tag.Emoji,
+		Description: tag.Description,
+		Pinned:      tag.Pinned,
 	}, nil
 }
-
-func TraverseASTNodes(nodes []ast.Node, fn func(ast.Node)) {
-	for _, node := range nodes {
-		fn(node)
-		switch n := node.(type) {
-		case *ast.Paragraph:
-			TraverseASTNodes(n.Children, fn)
-		case *ast.Heading:
-			TraverseASTNodes(n.Children, fn)
-		case *ast.Blockquote:
-			TraverseASTNodes(n.Children, fn)
-		case *ast.OrderedList:
-			TraverseASTNodes(n.Children, fn)
-		case *ast.UnorderedList:
-			TraverseASTNodes(n.Children, fn)
-		case *ast.TaskList:
-			TraverseASTNodes(n.Children, fn)
-		case *ast.Bold:
-			TraverseASTNodes(n.Children, fn)
-		}
-	}
-}
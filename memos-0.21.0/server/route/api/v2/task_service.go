@@ -0,0 +1,183 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/yourselfhosted/gomark/ast"
+	"github.com/yourselfhosted/gomark/parser"
+	"github.com/yourselfhosted/gomark/parser/tokenizer"
+	"github.com/yourselfhosted/gomark/restore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	"github.com/usememos/memos/server/route/api/auth"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV2Service) ListTasks(ctx context.Context, request *apiv2pb.ListTasksRequest) (*apiv2pb.ListTasksResponse, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	normalStatus := store.Normal
+	// The tasks assigned to the user in memos they cannot see are not listed.
+	find := &store.FindMemoTask{
+		CreatorOrAssigneeID: &currentUser.ID,
+		VisibleToUserID:     &currentUser.ID,
+		RowStatus:           &normalStatus,
+	}
+	if !request.IncludeCompleted {
+		completed := false
+		find.Completed = &completed
+	}
+	tasks, err := s.Store.ListMemoTasks(ctx, find)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list tasks: %v", err)
+	}
+
+	now := time.Now()
+	response := &apiv2pb.ListTasksResponse{
+		Tasks: []*apiv2pb.Task{},
+	}
+	for _, task := range tasks {
+		response.Tasks = append(response.Tasks, convertTaskFromStore(task, now))
+	}
+	return response, nil
+}
+
+func (s *APIV2Service) UpdateTask(ctx context.Context, request *apiv2pb.UpdateTaskRequest) (*apiv2pb.UpdateTaskResponse, error) {
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	if request.Task == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "task is required")
+	}
+	for _, path := range request.UpdateMask.Paths {
+		if path != "completed" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+	memoID, position, err := ExtractMemoTaskFromName(request.Task.Name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid task name: %v", err)
+	}
+	task, err := s.getMemoTask(ctx, memoID, position)
+	if err != nil {
+		return nil, err
+	}
+	memo, err := s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+	}
+	if memo == nil {
+		return nil, status.Errorf(codes.NotFound, "memo not found")
+	}
+
+	// The assignees of a task can check it off without being able to edit the rest of the memo.
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	ok, err := auth.CanCompleteMemoTask(ctx, s.Store, currentUser, memo, task)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check memo access: %v", err)
+	}
+	if !ok {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	if task.Completed != request.Task.Completed {
+		content, err := setTaskCompleted(memo.Content, position, request.Task.Completed)
+		if err != nil {
+			return nil, err
+		}
+		currentTs := time.Now().Unix()
+		if err := s.Store.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:        memo.ID,
+			UpdatedTs: &currentTs,
+			Content:   &content,
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update memo: %v", err)
+		}
+
+		memo, err = s.Store.GetMemo(ctx, &store.FindMemo{ID: &memoID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get memo: %v", err)
+		}
+		memoMessage, err := s.convertMemoFromStore(ctx, memo)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to convert memo: %v", err)
+		}
+		if err := s.DispatchMemoUpdatedWebhook(ctx, memoMessage); err != nil {
+			slog.Warn("Failed to dispatch memo updated webhook", slog.Any("error", err))
+		}
+
+		task, err = s.getMemoTask(ctx, memoID, position)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &apiv2pb.UpdateTaskResponse{
+		Task: convertTaskFromStore(task, time.Now()),
+	}, nil
+}
+
+func (s *APIV2Service) getMemoTask(ctx context.Context, memoID, position int32) (*store.MemoTask, error) {
+	tasks, err := s.Store.ListMemoTasks(ctx, &store.FindMemoTask{
+		MemoID:   &memoID,
+		Position: &position,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get task: %v", err)
+	}
+	if len(tasks) == 0 {
+		return nil, status.Errorf(codes.NotFound, "task not found")
+	}
+	return tasks[0], nil
+}
+
+// setTaskCompleted checks or unchecks the task at the position in the content, counting the tasks the way store.ExtractMemoTasks does.
+func setTaskCompleted(content string, position int32, completed bool) (string, error) {
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return "", status.Errorf(codes.Internal, "failed to parse content: %v", err)
+	}
+	var taskList *ast.TaskList
+	count := int32(0)
+	store.TraverseASTNodes(nodes, func(node ast.Node) {
+		if node, ok := node.(*ast.TaskList); ok {
+			if count == position {
+				taskList = node
+			}
+			count++
+		}
+	})
+	if taskList == nil {
+		return "", status.Errorf(codes.NotFound, "task not found")
+	}
+	taskList.Complete = completed
+	return restore.Restore(nodes), nil
+}
+
+func convertTaskFromStore(task *store.MemoTask, now time.Time) *apiv2pb.Task {
+	taskMessage := &apiv2pb.Task{
+		Name:      fmt.Sprintf("%s%d/%s%d", MemoNamePrefix, task.MemoID, TaskNamePrefix, task.Position),
+		Memo:      fmt.Sprintf("%s%d", MemoNamePrefix, task.MemoID),
+		Content:   task.Content,
+		Completed: task.Completed,
+	}
+	if task.DueTs != 0 {
+		taskMessage.DueTime = timestamppb.New(time.Unix(task.DueTs, 0))
+		// A task is overdue once the whole due date has passed.
+		taskMessage.Overdue = !task.Completed && now.Unix() >= task.DueTs+int64((24*time.Hour).Seconds())
+	}
+	if task.AssigneeID != 0 {
+		taskMessage.Assignee = fmt.Sprintf("%s%d", UserNamePrefix, task.AssigneeID)
+	}
+	return taskMessage
+}
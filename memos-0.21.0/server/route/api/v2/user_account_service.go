@@ -0,0 +1,476 @@
+package v2
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/usememos/memos/internal/util"
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/route/api/auth"
+	"github.com/usememos/memos/store"
+)
+
+const (
+	// maxImportUsers is the max number of rows of the CSV file of ImportUsers.
+	maxImportUsers = 1000
+	// initialPasswordLength is the length of the passwords generated for the users imported without one.
+	initialPasswordLength = 16
+)
+
+var userCSVImportColumns = []string{"username", "email", "nickname", "role", "password"}
+
+func (s *APIV2Service) GetUserAccountStatus(ctx context.Context, request *apiv2pb.GetUserAccountStatusRequest) (*apiv2pb.GetUserAccountStatusResponse, error) {
+	// The users can see their own account status, and the users managing them too.
+	user, err := s.getSessionOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	accountStatus, err := s.getUserAccountStatus(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	return &apiv2pb.GetUserAccountStatusResponse{
+		Status: accountStatus,
+	}, nil
+}
+
+func (s *APIV2Service) SuspendUser(ctx context.Context, request *apiv2pb.SuspendUserRequest) (*apiv2pb.SuspendUserResponse, error) {
+	currentUser, user, err := s.getManagedOtherUser(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	suspension := &storepb.SuspensionUserSetting{
+		SuspendedTs: time.Now().Unix(),
+		Reason:      strings.TrimSpace(request.Reason),
+		SuspenderId: currentUser.ID,
+	}
+	if request.ExpireTime != nil {
+		suspension.ExpiresTs = request.ExpireTime.AsTime().Unix()
+		if suspension.ExpiresTs <= suspension.SuspendedTs {
+			return nil, status.Errorf(codes.InvalidArgument, "expire time must be in the future")
+		}
+	}
+	if err := s.Store.UpsertUserSuspension(ctx, user.ID, suspension); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to suspend user: %v", err)
+	}
+
+	accountStatus, err := s.getUserAccountStatus(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	s.createAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionUserSuspend,
+		Target: accountStatus.Name,
+		Payload: &storepb.AuditLogPayload{
+			After: auth.NewAuditSnapshot(accountStatus),
+		},
+	})
+	return &apiv2pb.SuspendUserResponse{
+		Status: accountStatus,
+	}, nil
+}
+
+func (s *APIV2Service) UnsuspendUser(ctx context.Context, request *apiv2pb.UnsuspendUserRequest) (*apiv2pb.UnsuspendUserResponse, error) {
+	_, user, err := s.getManagedOtherUser(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.UpsertUserSuspension(ctx, user.ID, &storepb.SuspensionUserSetting{}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to unsuspend user: %v", err)
+	}
+
+	accountStatus, err := s.getUserAccountStatus(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	s.createAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionUserUnsuspend,
+		Target: accountStatus.Name,
+	})
+	return &apiv2pb.UnsuspendUserResponse{
+		Status: accountStatus,
+	}, nil
+}
+
+func (s *APIV2Service) RequirePasswordChange(ctx context.Context, request *apiv2pb.RequirePasswordChangeRequest) (*apiv2pb.RequirePasswordChangeResponse, error) {
+	_, user, err := s.getManagedOtherUser(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	password, err := s.Store.GetUserPassword(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get password: %v", err)
+	}
+	if err := s.Store.UpsertUserPassword(ctx, user.ID, &storepb.PasswordUserSetting{
+		UpdatedTs:      password.UpdatedTs,
+		ChangeRequired: true,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to require password change: %v", err)
+	}
+	// The user signs in again, which requires the change.
+	if err := s.Store.RemoveUserSessions(ctx, user.ID, func(*storepb.SessionsUserSetting_Session) bool {
+		return true
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove sessions: %v", err)
+	}
+
+	accountStatus, err := s.getUserAccountStatus(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	s.createAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionPasswordChangeRequire,
+		Target: accountStatus.Name,
+	})
+	return &apiv2pb.RequirePasswordChangeResponse{
+		Status: accountStatus,
+	}, nil
+}
+
+func (s *APIV2Service) ImportUsers(ctx context.Context, request *apiv2pb.ImportUsersRequest) (*apiv2pb.ImportUsersResponse, error) {
+	// The caller is granted the permission to manage the users by the auth interceptor.
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	evaluator, err := auth.GetEvaluator(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get permissions: %v", err)
+	}
+	passwordPolicy, err := s.Store.GetWorkspacePasswordPolicySetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get password policy: %v", err)
+	}
+	rows, err := parseUserCSV(request.Content)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid CSV file: %v", err)
+	}
+
+	response := &apiv2pb.ImportUsersResponse{
+		Users:  []*apiv2pb.ImportUsersResponse_ImportedUser{},
+		Errors: []*apiv2pb.ImportUsersResponse_RowError{},
+	}
+	for _, row := range rows {
+		importedUser, err := s.importUser(ctx, evaluator, currentUser, passwordPolicy, row)
+		if err != nil {
+			response.Errors = append(response.Errors, &apiv2pb.ImportUsersResponse_RowError{
+				Line:    int32(row.line),
+				Message: err.Error(),
+			})
+			continue
+		}
+		response.Users = append(response.Users, importedUser)
+	}
+	return response, nil
+}
+
+func (s *APIV2Service) ExportUsers(ctx context.Context, _ *apiv2pb.ExportUsersRequest) (*apiv2pb.ExportUsersResponse, error) {
+	// The caller is granted the permission to manage the users by the auth interceptor.
+	users, err := s.Store.ListUsers(ctx, &store.FindUser{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
+	}
+
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	if err := writer.Write([]string{"id", "username", "email", "nickname", "role", "row_status", "create_time"}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write CSV: %v", err)
+	}
+	for _, user := range users {
+		if err := writer.Write([]string{
+			strconv.Itoa(int(user.ID)),
+			user.Username,
+			user.Email,
+			user.Nickname,
+			string(user.Role),
+			string(user.RowStatus),
+			time.Unix(user.CreatedTs, 0).UTC().Format(time.RFC3339),
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to write CSV: %v", err)
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write CSV: %v", err)
+	}
+
+	s.createAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionUserExport,
+		Target: strings.TrimSuffix(UserNamePrefix, "/"),
+	})
+	return &apiv2pb.ExportUsersResponse{
+		Content: buffer.Bytes(),
+	}, nil
+}
+
+// importUser creates the user of a row of the CSV file of ImportUsers.
+func (s *APIV2Service) importUser(ctx context.Context, evaluator *auth.Evaluator, currentUser *store.User, passwordPolicy *storepb.WorkspacePasswordPolicySetting, row *userCSVRow) (*apiv2pb.ImportUsersResponse_ImportedUser, error) {
+	if !util.UIDMatcher.MatchString(strings.ToLower(row.username)) {
+		return nil, errors.Errorf("invalid username: %s", row.username)
+	}
+	if row.email != "" && !util.ValidateEmail(row.email) {
+		return nil, errors.Errorf("invalid email: %s", row.email)
+	}
+	role := store.RoleUser
+	if row.role != "" {
+		role = store.Role(strings.ToUpper(row.role))
+	}
+	if !evaluator.CanAssignRole(currentUser, role) {
+		return nil, errors.Errorf("permission denied to create user with role %s", role)
+	}
+	existedUser, err := s.Store.GetUser(ctx, &store.FindUser{Username: &row.username})
+	if err != nil {
+		return nil, errors.Errorf("failed to get user: %v", err)
+	}
+	if existedUser != nil {
+		return nil, errors.Errorf("username already exists: %s", row.username)
+	}
+
+	importedUser := &apiv2pb.ImportUsersResponse_ImportedUser{}
+	password := row.password
+	if password == "" {
+		if password, err = generateInitialPassword(passwordPolicy); err != nil {
+			return nil, errors.Errorf("failed to generate password: %v", err)
+		}
+		importedUser.InitialPassword = password
+	} else if err := auth.ValidatePassword(passwordPolicy, password); err != nil {
+		return nil, err
+	}
+	passwordHash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return nil, errors.Errorf("failed to generate password hash: %v", err)
+	}
+	nickname := row.nickname
+	if nickname == "" {
+		nickname = row.username
+	}
+
+	user, err := s.Store.CreateUser(ctx, &store.User{
+		Username:     row.username,
+		Role:         role,
+		Email:        row.email,
+		Nickname:     nickname,
+		PasswordHash: string(passwordHash),
+	})
+	if err != nil {
+		return nil, errors.Errorf("failed to create user: %v", err)
+	}
+	// The password is known to whoever imported the user, so the user replaces it first.
+	if err := s.recordPasswordChange(ctx, user.ID, true); err != nil {
+		return nil, errors.Errorf("failed to require password change: %v", err)
+	}
+
+	importedUser.User = convertUserFromStore(user)
+	s.createAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionUserImport,
+		Target: importedUser.User.Name,
+		Payload: &storepb.AuditLogPayload{
+			After: auth.NewAuditSnapshot(importedUser.User),
+		},
+	})
+	return importedUser, nil
+}
+
+// getManagedOtherUser returns the current user and the user of the name, which the current user manages and is someone else.
+func (s *APIV2Service) getManagedOtherUser(ctx context.Context, name string) (*store.User, *store.User, error) {
+	user, err := s.getSessionOwner(ctx, name)
+	if err != nil {
+		return nil, nil, err
+	}
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser.ID == user.ID {
+		return nil, nil, status.Errorf(codes.InvalidArgument, "cannot change the account status of yourself")
+	}
+	return currentUser, user, nil
+}
+
+func (s *APIV2Service) getUserAccountStatus(ctx context.Context, user *store.User) (*apiv2pb.UserAccountStatus, error) {
+	passwordPolicy, err := s.Store.GetWorkspacePasswordPolicySetting(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get password policy: %v", err)
+	}
+	password, err := s.Store.GetUserPassword(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get password: %v", err)
+	}
+	suspension, err := s.Store.GetUserSuspension(ctx, user.ID)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get suspension: %v", err)
+	}
+
+	now := time.Now()
+	accountStatus := &apiv2pb.UserAccountStatus{
+		Name:                   fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+		PasswordChangeRequired: auth.IsPasswordChangeRequired(passwordPolicy, password, user.CreatedTs, now),
+		Suspended:              auth.IsSuspended(suspension, now),
+	}
+	passwordUpdatedTs := user.CreatedTs
+	if password.UpdatedTs != 0 {
+		passwordUpdatedTs = password.UpdatedTs
+		accountStatus.PasswordUpdateTime = timestamppb.New(time.Unix(password.UpdatedTs, 0))
+	}
+	if expireTime := auth.GetPasswordExpireTime(passwordPolicy, passwordUpdatedTs); !expireTime.IsZero() {
+		accountStatus.PasswordExpireTime = timestamppb.New(expireTime)
+	}
+	if accountStatus.Suspended {
+		accountStatus.SuspendReason = suspension.Reason
+		accountStatus.SuspendTime = timestamppb.New(time.Unix(suspension.SuspendedTs, 0))
+		if suspension.ExpiresTs != 0 {
+			accountStatus.SuspendExpireTime = timestamppb.New(time.Unix(suspension.ExpiresTs, 0))
+		}
+	}
+	return accountStatus, nil
+}
+
+// validatePassword returns an error unless the password follows the password policy of the workspace.
+func (s *APIV2Service) validatePassword(ctx context.Context, password string) error {
+	passwordPolicy, err := s.Store.GetWorkspacePasswordPolicySetting(ctx)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get password policy: %v", err)
+	}
+	if err := auth.ValidatePassword(passwordPolicy, password); err != nil {
+		return status.Errorf(codes.InvalidArgument, "%v", err)
+	}
+	return nil
+}
+
+// recordPasswordChange records that the password of the user changed now,
+// and whether the user has to change it again before signing in.
+func (s *APIV2Service) recordPasswordChange(ctx context.Context, userID int32, changeRequired bool) error {
+	return s.Store.UpsertUserPassword(ctx, userID, &storepb.PasswordUserSetting{
+		UpdatedTs:      time.Now().Unix(),
+		ChangeRequired: changeRequired,
+	})
+}
+
+// checkUserSuspended returns an error telling the user about the suspension, if the user is suspended.
+func (s *APIV2Service) checkUserSuspended(ctx context.Context, user *store.User) error {
+	suspension, err := auth.GetActiveSuspension(ctx, s.Store, user.ID)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get suspension: %v", err)
+	}
+	if suspension != nil {
+		return status.Error(codes.PermissionDenied, auth.FormatSuspension(suspension))
+	}
+	return nil
+}
+
+// checkPasswordChangeRequired returns an error if the user has to change the password with ChangePassword before signing in.
+func (s *APIV2Service) checkPasswordChangeRequired(ctx context.Context, user *store.User) error {
+	changeRequired, err := auth.CheckPasswordChangeRequired(ctx, s.Store, user)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check password: %v", err)
+	}
+	if changeRequired {
+		return status.Errorf(codes.FailedPrecondition, "password change required")
+	}
+	return nil
+}
+
+// generateInitialPassword returns a random password following the password policy.
+func generateInitialPassword(policy *storepb.WorkspacePasswordPolicySetting) (string, error) {
+	length := initialPasswordLength
+	if int(policy.MinLength) > length {
+		length = int(policy.MinLength)
+	}
+	for {
+		password, err := util.RandomString(length)
+		if err != nil {
+			return "", err
+		}
+		// The random letters and digits lack a symbol.
+		password += "-"
+		if auth.ValidatePassword(policy, password) == nil {
+			return password, nil
+		}
+	}
+}
+
+type userCSVRow struct {
+	// line is the line number of the row, starting from 1 for the header.
+	line     int
+	username string
+	email    string
+	nickname string
+	role     string
+	password string
+}
+
+// parseUserCSV parses the CSV file of ImportUsers, whose header row names the columns.
+func parseUserCSV(content []byte) ([]*userCSVRow, error) {
+	reader := csv.NewReader(bytes.NewReader(content))
+	reader.TrimLeadingSpace = true
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errors.New("missing header row")
+		}
+		return nil, err
+	}
+	columns := map[string]int{}
+	for i, column := range header {
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\ufeff")))
+		if !slices.Contains(userCSVImportColumns, column) {
+			return nil, errors.Errorf("unknown column %q", column)
+		}
+		if _, ok := columns[column]; ok {
+			return nil, errors.Errorf("duplicate column %q", column)
+		}
+		columns[column] = i
+	}
+	if _, ok := columns["username"]; !ok {
+		return nil, errors.Errorf("missing column \"username\"")
+	}
+	reader.FieldsPerRecord = len(header)
+
+	rows := []*userCSVRow{}
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if len(rows) == maxImportUsers {
+			return nil, errors.Errorf("too many rows, the max is %d", maxImportUsers)
+		}
+		line, _ := reader.FieldPos(0)
+		getField := func(column string) string {
+			if i, ok := columns[column]; ok {
+				return strings.TrimSpace(record[i])
+			}
+			return ""
+		}
+		row := &userCSVRow{
+			line:     line,
+			username: getField("username"),
+			email:    getField("email"),
+			nickname: getField("nickname"),
+			role:     getField("role"),
+		}
+		// The passwords are taken as is, spaces included.
+		if i, ok := columns["password"]; ok {
+			row.password = record[i]
+		}
+		rows = append(rows, row)
+	}
+	return rows, nil
+}
@@ -0,0 +1,260 @@
+package v2
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"path"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	apiv1 "github.com/usememos/memos/server/route/api/v1"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV2Service) ExportMyData(ctx context.Context, _ *apiv2pb.ExportMyDataRequest) (*apiv2pb.ExportMyDataResponse, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+
+	var buffer bytes.Buffer
+	writer := zip.NewWriter(&buffer)
+	if err := s.writeUserData(ctx, writer, currentUser); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to export data: %v", err)
+	}
+	if err := writer.Close(); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to write zip archive: %v", err)
+	}
+
+	s.createAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionUserDataExport,
+		Target: fmt.Sprintf("%s%d", UserNamePrefix, currentUser.ID),
+	})
+	return &apiv2pb.ExportMyDataResponse{
+		Content: buffer.Bytes(),
+	}, nil
+}
+
+// writeUserData writes the rows of the user as JSON files, and the files of the resources of the user into the zip archive.
+func (s *APIV2Service) writeUserData(ctx context.Context, writer *zip.Writer, user *store.User) error {
+	if err := writeJSONFile(writer, "user.json", []proto.Message{convertUserFromStore(user)}); err != nil {
+		return err
+	}
+
+	userSettings, err := s.Store.ListUserSettings(ctx, &store.FindUserSetting{UserID: &user.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list user settings")
+	}
+	if err := writeJSONFile(writer, "settings.json", toProtoMessages(userSettings)); err != nil {
+		return err
+	}
+
+	memos, err := s.Store.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos")
+	}
+	convertedMemos, err := s.convertMemosFromStore(ctx, memos)
+	if err != nil {
+		return errors.Wrap(err, "failed to convert memos")
+	}
+	memoMessages, shareLinkMessages := []proto.Message{}, []proto.Message{}
+	for i, memo := range memos {
+		memoMessages = append(memoMessages, convertedMemos[i])
+
+		shareLinks, err := s.Store.ListMemoShareLinks(ctx, &store.FindMemoShareLink{MemoID: &memo.ID})
+		if err != nil {
+			return errors.Wrap(err, "failed to list memo share links")
+		}
+		for _, shareLink := range shareLinks {
+			shareLinkMessages = append(shareLinkMessages, convertMemoShareLinkFromStore(shareLink))
+		}
+	}
+	if err := writeJSONFile(writer, "memos.json", memoMessages); err != nil {
+		return err
+	}
+	if err := writeJSONFile(writer, "memo_share_links.json", shareLinkMessages); err != nil {
+		return err
+	}
+
+	if err := s.writeUserResources(ctx, writer, user); err != nil {
+		return err
+	}
+
+	tags, err := s.Store.ListTags(ctx, &store.FindTag{CreatorID: user.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list tags")
+	}
+	tagMessages := []proto.Message{}
+	for _, tag := range tags {
+		tagMessage, err := s.convertTagFromStore(ctx, tag)
+		if err != nil {
+			return errors.Wrap(err, "failed to convert tag")
+		}
+		tagMessages = append(tagMessages, tagMessage)
+	}
+	if err := writeJSONFile(writer, "tags.json", tagMessages); err != nil {
+		return err
+	}
+
+	reactions, err := s.Store.ListReactions(ctx, &store.FindReaction{CreatorID: &user.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list reactions")
+	}
+	reactionMessages := []proto.Message{}
+	for _, reaction := range reactions {
+		reactionMessages = append(reactionMessages, convertReactionFromStore(reaction))
+	}
+	if err := writeJSONFile(writer, "reactions.json", reactionMessages); err != nil {
+		return err
+	}
+
+	inboxes, err := s.Store.ListInboxes(ctx, &store.FindInbox{ReceiverID: &user.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list inboxes")
+	}
+	inboxMessages := []proto.Message{}
+	for _, inbox := range inboxes {
+		inboxMessages = append(inboxMessages, convertInboxFromStore(inbox))
+	}
+	if err := writeJSONFile(writer, "inboxes.json", inboxMessages); err != nil {
+		return err
+	}
+
+	webhooks, err := s.Store.ListWebhooks(ctx, &store.FindWebhook{CreatorID: &user.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list webhooks")
+	}
+	webhookMessages := []proto.Message{}
+	for _, webhook := range webhooks {
+		webhookMessages = append(webhookMessages, convertWebhookFromStore(webhook))
+	}
+	if err := writeJSONFile(writer, "webhooks.json", webhookMessages); err != nil {
+		return err
+	}
+
+	views, err := s.Store.ListMemoViews(ctx, &store.FindMemoView{CreatorID: &user.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list views")
+	}
+	viewMessages := []proto.Message{}
+	for _, view := range views {
+		viewMessages = append(viewMessages, convertViewFromStore(view))
+	}
+	if err := writeJSONFile(writer, "views.json", viewMessages); err != nil {
+		return err
+	}
+
+	groupMembers, err := s.Store.ListUserGroupMembers(ctx, &store.FindUserGroupMember{UserID: &user.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list group members")
+	}
+	groupMemberMessages := []proto.Message{}
+	for _, groupMember := range groupMembers {
+		groupMemberMessages = append(groupMemberMessages, convertGroupMemberFromStore(groupMember))
+	}
+	if err := writeJSONFile(writer, "group_members.json", groupMemberMessages); err != nil {
+		return err
+	}
+
+	invitations, err := s.Store.ListInvitations(ctx, &store.FindInvitation{CreatorID: &user.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list invitations")
+	}
+	invitationMessages := []proto.Message{}
+	for _, invitation := range invitations {
+		invitationMessages = append(invitationMessages, convertInvitationFromStore(invitation))
+	}
+	if err := writeJSONFile(writer, "invitations.json", invitationMessages); err != nil {
+		return err
+	}
+
+	auditLogs, err := s.Store.ListAuditLogs(ctx, &store.FindAuditLog{ActorID: &user.ID})
+	if err != nil {
+		return errors.Wrap(err, "failed to list audit logs")
+	}
+	auditLogMessages := []proto.Message{}
+	for _, auditLog := range auditLogs {
+		auditLogMessages = append(auditLogMessages, convertAuditLogFromStore(auditLog))
+	}
+	return writeJSONFile(writer, "audit_logs.json", auditLogMessages)
+}
+
+// writeUserResources writes the resources of the user as a JSON file, and their files under the resources directory.
+func (s *APIV2Service) writeUserResources(ctx context.Context, writer *zip.Writer, user *store.User) error {
+	resources, err := s.Store.ListResources(ctx, &store.FindResource{CreatorID: &user.ID, GetBlob: true})
+	if err != nil {
+		return errors.Wrap(err, "failed to list resources")
+	}
+	resourceMessages := []proto.Message{}
+	for _, resource := range resources {
+		resourceMessages = append(resourceMessages, s.convertResourceFromStore(ctx, resource))
+
+		blob, err := apiv1.ReadResourceBlob(ctx, s.Store, resource)
+		if err != nil {
+			return errors.Wrapf(err, "failed to read resource %s", resource.UID)
+		}
+		// The external links not stored in any of the storages are only exported as rows.
+		if blob == nil {
+			continue
+		}
+		file, err := writer.Create(path.Join("resources", resource.UID, getExportFilename(resource)))
+		if err != nil {
+			return errors.Wrap(err, "failed to create file")
+		}
+		if _, err := file.Write(blob); err != nil {
+			return errors.Wrap(err, "failed to write file")
+		}
+	}
+	return writeJSONFile(writer, "resources.json", resourceMessages)
+}
+
+// writeJSONFile writes the messages as a JSON array into the zip archive.
+func writeJSONFile(writer *zip.Writer, name string, messages []proto.Message) error {
+	list := []json.RawMessage{}
+	for _, message := range messages {
+		content, err := protojson.Marshal(message)
+		if err != nil {
+			return errors.Wrapf(err, "failed to marshal %s", name)
+		}
+		list = append(list, content)
+	}
+	content, err := json.MarshalIndent(list, "", "  ")
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal %s", name)
+	}
+
+	file, err := writer.Create(name)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create %s", name)
+	}
+	if _, err := file.Write(content); err != nil {
+		return errors.Wrapf(err, "failed to write %s", name)
+	}
+	return nil
+}
+
+func toProtoMessages[T proto.Message](list []T) []proto.Message {
+	messages := make([]proto.Message, 0, len(list))
+	for _, message := range list {
+		messages = append(messages, message)
+	}
+	return messages
+}
+
+// getExportFilename returns the name of the file of the resource in the zip archive, which must not leave its directory.
+func getExportFilename(resource *store.Resource) string {
+	filename := path.Base(strings.ReplaceAll(resource.Filename, "\\", "/"))
+	if filename == "." || filename == "/" || filename == ".." {
+		return resource.UID
+	}
+	return filename
+}
@@ -3,6 +3,7 @@ package v2
 import (
 	"context"
 	"fmt"
+	"log/slog"
 	"net/http"
 	"slices"
 	"strings"
@@ -13,37 +14,56 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 	"golang.org/x/crypto/bcrypt"
-	expr "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/filter"
 	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/server/route/api/auth"
+	apiv1 "github.com/usememos/memos/server/route/api/v1"
 	"github.com/usememos/memos/store"
 )
 
-func (s *APIV2Service) ListUsers(ctx context.Context, _ *apiv2pb.ListUsersRequest) (*apiv2pb.ListUsersResponse, error) {
-	currentUser, err := getCurrentUser(ctx, s.Store)
+func (s *APIV2Service) ListUsers(ctx context.Context, request *apiv2pb.ListUsersRequest) (*apiv2pb.ListUsersResponse, error) {
+	// The caller is granted the permission to manage users by the auth interceptor.
+	userFind := &store.FindUser{}
+	limit, cursor, err := s.getPageLimitAndCursor(request.PageSize, request.PageToken)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
 	}
-	if currentUser.Role != store.RoleHost && currentUser.Role != store.RoleAdmin {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	userFind.Cursor = cursor
+	limitPlusOne := limit + 1
+	if limit > 0 {
+		userFind.Limit = &limitPlusOne
 	}
-
-	users, err := s.Store.ListUsers(ctx, &store.FindUser{})
+	users, err := s.Store.ListUsers(ctx, userFind)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list users: %v", err)
 	}
+	evaluator, err := auth.GetEvaluator(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get permissions: %v", err)
+	}
 
 	response := &apiv2pb.ListUsersResponse{
 		Users: []*apiv2pb.User{},
 	}
+	if limit > 0 && len(users) == limitPlusOne {
+		users = users[:limit]
+		last := users[len(users)-1]
+		response.NextPageToken, err = s.getCursorPageToken(limit, &store.Cursor{Ts: last.CreatedTs, ID: last.ID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
 	for _, user := range users {
-		response.Users = append(response.Users, convertUserFromStore(user))
+		userMessage := convertUserFromStore(user)
+		userMessage.Permissions = convertPermissionsFromStore(evaluator.RolePermissions(user.Role))
+		response.Users = append(response.Users, userMessage)
 	}
 	return response, nil
 }
@@ -52,19 +72,19 @@ func (s *APIV2Service) SearchUsers(ctx context.Context, request *apiv2pb.SearchU
 	if request.Filter == "" {
 		return nil, status.Errorf(codes.InvalidArgument, "filter is empty")
 	}
-	filter, err := parseSearchUsersFilter(request.Filter)
+	userFilter, err := filter.Parse(request.Filter, SearchUsersFilterFields)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "failed to parse filter: %v", err)
+		return nil, status.Errorf(codes.InvalidArgument, "invalid filter: %v", err)
 	}
-	userFind := &store.FindUser{}
-	if filter.Username != nil {
-		userFind.Username = filter.Username
+	userFind := &store.FindUser{
+		Filter: userFilter.Expr,
 	}
-	if filter.Random {
-		userFind.Random = true
+	if v, ok := userFilter.Options["random"]; ok {
+		userFind.Random = v.(bool)
 	}
-	if filter.Limit != nil {
-		userFind.Limit = filter.Limit
+	if v, ok := userFilter.Options["limit"]; ok {
+		limit := int(v.(int64))
+		userFind.Limit = &limit
 	}
 
 	users, err := s.Store.ListUsers(ctx, userFind)
@@ -97,6 +117,9 @@ func (s *APIV2Service) GetUser(ctx context.Context, request *apiv2pb.GetUserRequ
 	}
 
 	userMessage := convertUserFromStore(user)
+	if userMessage.Permissions, err = s.getUserPermissions(ctx, user); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get permissions: %v", err)
+	}
 	response := &apiv2pb.GetUserResponse{
 		User: userMessage,
 	}
@@ -108,12 +131,20 @@ func (s *APIV2Service) CreateUser(ctx context.Context, request *apiv2pb.CreateUs
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
-	if currentUser.Role != store.RoleHost {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	evaluator, err := auth.GetEvaluator(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get permissions: %v", err)
+	}
+	role := convertUserRoleToStoreWithCustomRole(request.User)
+	if !evaluator.CanAssignRole(currentUser, role) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied to create user with role %s", role)
 	}
 	if !util.UIDMatcher.MatchString(strings.ToLower(request.User.Username)) {
 		return nil, status.Errorf(codes.InvalidArgument, "invalid username: %s", request.User.Username)
 	}
+	if err := s.validatePassword(ctx, request.User.Password); err != nil {
+		return nil, err
+	}
 	passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.User.Password), bcrypt.DefaultCost)
 	if err != nil {
 		return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to generate password hash").SetInternal(err)
@@ -121,7 +152,7 @@ func (s *APIV2Service) CreateUser(ctx context.Context, request *apiv2pb.CreateUs
 
 	user, err := s.Store.CreateUser(ctx, &store.User{
 		Username:     request.User.Username,
-		Role:         convertUserRoleToStore(request.User.Role),
+		Role:         role,
 		Email:        request.User.Email,
 		Nickname:     request.User.Nickname,
 		PasswordHash: string(passwordHash),
@@ -129,9 +160,20 @@ func (s *APIV2Service) CreateUser(ctx context.Context, request *apiv2pb.CreateUs
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create user: %v", err)
 	}
+	if err := s.recordPasswordChange(ctx, user.ID, false); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to record password change: %v", err)
+	}
 
+	userMessage := convertUserFromStore(user)
+	s.createAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionUserCreate,
+		Target: userMessage.Name,
+		Payload: &storepb.AuditLogPayload{
+			After: auth.NewAuditSnapshot(userMessage),
+		},
+	})
 	response := &apiv2pb.CreateUserResponse{
-		User: convertUserFromStore(user),
+		User: userMessage,
 	}
 	return response, nil
 }
@@ -145,9 +187,6 @@ func (s *APIV2Service) UpdateUser(ctx context.Context, request *apiv2pb.UpdateUs
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
-	if currentUser.ID != userID && currentUser.Role != store.RoleAdmin && currentUser.Role != store.RoleHost {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
-	}
 	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
 		return nil, status.Errorf(codes.InvalidArgument, "update mask is empty")
 	}
@@ -159,6 +198,13 @@ func (s *APIV2Service) UpdateUser(ctx context.Context, request *apiv2pb.UpdateUs
 	if user == nil {
 		return nil, status.Errorf(codes.NotFound, "user not found")
 	}
+	evaluator, err := auth.GetEvaluator(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get permissions: %v", err)
+	}
+	if !evaluator.CanManageUser(currentUser, user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
 
 	currentTs := time.Now().Unix()
 	update := &store.UpdateUser{
@@ -174,15 +220,32 @@ func (s *APIV2Service) UpdateUser(ctx context.Context, request *apiv2pb.UpdateUs
 		} else if field == "nickname" {
 			update.Nickname = &request.User.Nickname
 		} else if field == "email" {
+			// The users changing their own email confirm it by email when the workspace can send emails.
+			if currentUser.ID == user.ID && request.User.Email != user.Email {
+				mailSender, err := s.getMailSender(ctx)
+				if err != nil {
+					return nil, status.Errorf(codes.Internal, "failed to get mail sender: %v", err)
+				}
+				if mailSender != nil {
+					return nil, status.Errorf(codes.FailedPrecondition, "the email change must be confirmed with RequestEmailChange")
+				}
+			}
 			update.Email = &request.User.Email
 		} else if field == "avatar_url" {
 			update.AvatarURL = &request.User.AvatarUrl
 		} else if field == "description" {
 			update.Description = &request.User.Description
-		} else if field == "role" {
-			role := convertUserRoleToStore(request.User.Role)
+		} else if field == "role" || field == "custom_role" {
+			role := convertUserRoleToStoreWithCustomRole(request.User)
+			// The host role cannot be given or taken away, so that the workspace always has its host.
+			if user.Role == store.RoleHost || !evaluator.CanAssignRole(currentUser, role) {
+				return nil, status.Errorf(codes.PermissionDenied, "permission denied to assign role %s", role)
+			}
 			update.Role = &role
 		} else if field == "password" {
+			if err := s.validatePassword(ctx, request.User.Password); err != nil {
+				return nil, err
+			}
 			passwordHash, err := bcrypt.GenerateFromPassword([]byte(request.User.Password), bcrypt.DefaultCost)
 			if err != nil {
 				return nil, echo.NewHTTPError(http.StatusInternalServerError, "failed to generate password hash").SetInternal(err)
@@ -197,13 +260,21 @@ func (s *APIV2Service) UpdateUser(ctx context.Context, request *apiv2pb.UpdateUs
 		}
 	}
 
+	before := convertUserFromStore(user)
 	updatedUser, err := s.Store.UpdateUser(ctx, update)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to update user: %v", err)
 	}
+	if update.PasswordHash != nil {
+		if err := s.recordPasswordChange(ctx, user.ID, false); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to record password change: %v", err)
+		}
+	}
 
+	userMessage := convertUserFromStore(updatedUser)
+	s.createAuditLog(ctx, newUserUpdateAuditLog(before, userMessage, update))
 	response := &apiv2pb.UpdateUserResponse{
-		User: convertUserFromStore(updatedUser),
+		User: userMessage,
 	}
 	return response, nil
 }
@@ -217,9 +288,6 @@ func (s *APIV2Service) DeleteUser(ctx context.Context, request *apiv2pb.DeleteUs
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
-	if currentUser.ID != userID && currentUser.Role != store.RoleAdmin && currentUser.Role != store.RoleHost {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
-	}
 
 	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
 	if err != nil {
@@ -228,16 +296,97 @@ func (s *APIV2Service) DeleteUser(ctx context.Context, request *apiv2pb.DeleteUs
 	if user == nil {
 		return nil, status.Errorf(codes.NotFound, "user not found")
 	}
+	evaluator, err := auth.GetEvaluator(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get permissions: %v", err)
+	}
+	if !evaluator.CanManageUser(currentUser, user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
 
-	if err := s.Store.DeleteUser(ctx, &store.DeleteUser{
+	userDelete := &store.DeleteUser{
 		ID: user.ID,
-	}); err != nil {
+	}
+	var resources []*store.Resource
+	if request.TransferTo != "" {
+		transferTo, err := s.getUserToTransferTo(ctx, user, request.TransferTo)
+		if err != nil {
+			return nil, err
+		}
+		userDelete.TransferToID = &transferTo.ID
+	} else {
+		// The files of the resources are deleted once their rows are.
+		resources, err = s.Store.ListResources(ctx, &store.FindResource{CreatorID: &user.ID})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to list resources: %v", err)
+		}
+	}
+	if err := s.Store.DeleteUser(ctx, userDelete); err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to delete user: %v", err)
 	}
+	for _, resource := range resources {
+		if err := apiv1.DeleteResourceBlob(ctx, s.Store, resource); err != nil {
+			slog.Warn("failed to delete resource blob", slog.String("resource", resource.UID), slog.Any("error", err))
+		}
+	}
 
+	userMessage := convertUserFromStore(user)
+	auditLog := &store.AuditLog{
+		Action: store.AuditActionUserDelete,
+		Target: userMessage.Name,
+		Payload: &storepb.AuditLogPayload{
+			Before: auth.NewAuditSnapshot(userMessage),
+		},
+	}
+	if userDelete.TransferToID != nil {
+		auditLog.Payload.Message = fmt.Sprintf("content transferred to %s%d", UserNamePrefix, *userDelete.TransferToID)
+	}
+	s.createAuditLog(ctx, auditLog)
 	return &apiv2pb.DeleteUserResponse{}, nil
 }
 
+// getUserToTransferTo returns the user to transfer the content of the user to delete to.
+func (s *APIV2Service) getUserToTransferTo(ctx context.Context, user *store.User, name string) (*store.User, error) {
+	transferToID, err := ExtractUserIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name to transfer to: %v", err)
+	}
+	if transferToID == user.ID {
+		return nil, status.Errorf(codes.InvalidArgument, "cannot transfer the content to the user to delete")
+	}
+	transferTo, err := s.Store.GetUser(ctx, &store.FindUser{ID: &transferToID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if transferTo == nil || transferToID == store.SystemBotID {
+		return nil, status.Errorf(codes.NotFound, "user to transfer to not found")
+	}
+	if transferTo.RowStatus == store.Archived {
+		return nil, status.Errorf(codes.FailedPrecondition, "cannot transfer the content to an archived user")
+	}
+	return transferTo, nil
+}
+
+// newUserUpdateAuditLog returns the audit log of the update of the user,
+// telling the role changes apart so that they can be filtered on.
+func newUserUpdateAuditLog(before, after *apiv2pb.User, update *store.UpdateUser) *store.AuditLog {
+	auditLog := &store.AuditLog{
+		Action: store.AuditActionUserUpdate,
+		Target: after.Name,
+		Payload: &storepb.AuditLogPayload{
+			Before: auth.NewAuditSnapshot(before),
+			After:  auth.NewAuditSnapshot(after),
+		},
+	}
+	if before.Role != after.Role || before.CustomRole != after.CustomRole {
+		auditLog.Action = store.AuditActionUserRoleUpdate
+	}
+	if update.PasswordHash != nil {
+		auditLog.Payload.Message = "password changed"
+	}
+	return auditLog
+}
+
 func getDefaultUserSetting() *apiv2pb.UserSetting {
 	return &apiv2pb.UserSetting{
 		Locale:         "en",
@@ -356,32 +505,23 @@ func (s *APIV2Service) ListUserAccessTokens(ctx context.Context, _ *apiv2pb.List
 
 	accessTokens := []*apiv2pb.UserAccessToken{}
 	for _, userAccessToken := range userAccessTokens {
-		claims := &auth.ClaimsMessage{}
-		_, err := jwt.ParseWithClaims(userAccessToken.AccessToken, claims, func(t *jwt.Token) (any, error) {
-			if t.Method.Alg() != jwt.SigningMethodHS256.Name {
-				return nil, errors.Errorf("unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
+		accessToken := convertUserAccessTokenFromStore(userAccessToken)
+		if userAccessToken.AccessToken != "" {
+			// Tokens stored before hashing was introduced keep their timestamps in the JWT claims.
+			claims, err := s.parseAccessToken(userAccessToken.AccessToken)
+			if err != nil {
+				// If the access token is invalid or expired, just ignore it.
+				continue
 			}
-			if kid, ok := t.Header["kid"].(string); ok {
-				if kid == "v1" {
-					return []byte(s.Secret), nil
-				}
+			accessToken.IssuedAt = timestamppb.New(claims.IssuedAt.Time)
+			if claims.ExpiresAt != nil {
+				accessToken.ExpiresAt = timestamppb.New(claims.ExpiresAt.Time)
 			}
-			return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
-		})
-		if err != nil {
-			// If the access token is invalid or expired, just ignore it.
-			continue
-		}
-
-		userAccessToken := &apiv2pb.UserAccessToken{
-			AccessToken: userAccessToken.AccessToken,
-			Description: userAccessToken.Description,
-			IssuedAt:    timestamppb.New(claims.IssuedAt.Time),
 		}
-		if claims.ExpiresAt != nil {
-			userAccessToken.ExpiresAt = timestamppb.New(claims.ExpiresAt.Time)
+		if accessToken.ExpiresAt != nil && accessToken.ExpiresAt.AsTime().Before(time.Now()) {
+			continue
 		}
-		accessTokens = append(accessTokens, userAccessToken)
+		accessTokens = append(accessTokens, accessToken)
 	}
 
 	// Sort by issued time in descending order.
@@ -399,6 +539,11 @@ func (s *APIV2Service) CreateUserAccessToken(ctx context.Context, request *apiv2
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
 	}
+	for _, scope := range request.Scopes {
+		if !auth.IsValidScope(scope) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid scope: %s", scope)
+		}
+	}
 
 	expiresAt := time.Time{}
 	if request.ExpiresAt != nil {
@@ -410,8 +555,67 @@ func (s *APIV2Service) CreateUserAccessToken(ctx context.Context, request *apiv2
 		return nil, status.Errorf(codes.Internal, "failed to generate access token: %v", err)
 	}
 
+	// Upsert the access token to user setting store.
+	userAccessToken, err := s.UpsertAccessTokenToStore(ctx, user, accessToken, request.Description, request.Scopes...)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert access token to store: %v", err)
+	}
+
+	s.createAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionAccessTokenCreate,
+		Target: fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+		Payload: &storepb.AuditLogPayload{
+			After: auth.NewAuditSnapshot(convertUserAccessTokenFromStore(userAccessToken)),
+		},
+	})
+	// The raw access token is only returned once, it is not kept by the server.
+	response := &apiv2pb.CreateUserAccessTokenResponse{
+		AccessToken: convertUserAccessTokenFromStore(userAccessToken),
+	}
+	response.AccessToken.AccessToken = accessToken
+	return response, nil
+}
+
+func (s *APIV2Service) DeleteUserAccessToken(ctx context.Context, request *apiv2pb.DeleteUserAccessTokenRequest) (*apiv2pb.DeleteUserAccessTokenResponse, error) {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+
+	if err := s.Store.RemoveUserAccessToken(ctx, user.ID, request.AccessToken); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+	}
+
+	s.createAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionAccessTokenDelete,
+		Target: fmt.Sprintf("%s%d", UserNamePrefix, user.ID),
+	})
+	return &apiv2pb.DeleteUserAccessTokenResponse{}, nil
+}
+
+// UpsertAccessTokenToStore stores the hash of the access token along with its metadata.
+func (s *APIV2Service) UpsertAccessTokenToStore(ctx context.Context, user *store.User, accessToken, description string, scopes ...string) (*storepb.AccessTokensUserSetting_AccessToken, error) {
+	claims, err := s.parseAccessToken(accessToken)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse access token")
+	}
+	userAccessToken := &storepb.AccessTokensUserSetting_AccessToken{
+		Description: description,
+		Scopes:      scopes,
+		IssuedTs:    claims.IssuedAt.Unix(),
+	}
+	if claims.ExpiresAt != nil {
+		userAccessToken.ExpiresTs = claims.ExpiresAt.Unix()
+	}
+	if err := s.Store.AddUserAccessToken(ctx, user.ID, accessToken, userAccessToken); err != nil {
+		return nil, errors.Wrap(err, "failed to upsert user setting")
+	}
+	return userAccessToken, nil
+}
+
+func (s *APIV2Service) parseAccessToken(accessToken string) (*auth.ClaimsMessage, error) {
 	claims := &auth.ClaimsMessage{}
-	_, err = jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
+	_, err := jwt.ParseWithClaims(accessToken, claims, func(t *jwt.Token) (any, error) {
 		if t.Method.Alg() != jwt.SigningMethodHS256.Name {
 			return nil, errors.Errorf("unexpected access token signing method=%v, expect %v", t.Header["alg"], jwt.SigningMethodHS256)
 		}
@@ -423,82 +627,169 @@ func (s *APIV2Service) CreateUserAccessToken(ctx context.Context, request *apiv2
 		return nil, errors.Errorf("unexpected access token kid=%v", t.Header["kid"])
 	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to parse access token: %v", err)
-	}
-
-	// Upsert the access token to user setting store.
-	if err := s.UpsertAccessTokenToStore(ctx, user, accessToken, request.Description); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to upsert access token to store: %v", err)
+		return nil, err
 	}
+	return claims, nil
+}
 
-	userAccessToken := &apiv2pb.UserAccessToken{
-		AccessToken: accessToken,
-		Description: request.Description,
-		IssuedAt:    timestamppb.New(claims.IssuedAt.Time),
+func convertUserAccessTokenFromStore(userAccessToken *storepb.AccessTokensUserSetting_AccessToken) *apiv2pb.UserAccessToken {
+	accessToken := &apiv2pb.UserAccessToken{
+		Id:          userAccessToken.Id,
+		Description: userAccessToken.Description,
+		Scopes:      userAccessToken.Scopes,
+		IssuedAt:    timestamppb.New(time.Unix(userAccessToken.IssuedTs, 0)),
+		LastUsedIp:  userAccessToken.LastUsedIp,
 	}
-	if claims.ExpiresAt != nil {
-		userAccessToken.ExpiresAt = timestamppb.New(claims.ExpiresAt.Time)
+	if userAccessToken.ExpiresTs != 0 {
+		accessToken.ExpiresAt = timestamppb.New(time.Unix(userAccessToken.ExpiresTs, 0))
 	}
-	response := &apiv2pb.CreateUserAccessTokenResponse{
-		AccessToken: userAccessToken,
+	if userAccessToken.LastUsedTs != 0 {
+		accessToken.LastUsedAt = timestamppb.New(time.Unix(userAccessToken.LastUsedTs, 0))
 	}
-	return response, nil
+	return accessToken
 }
 
-func (s *APIV2Service) DeleteUserAccessToken(ctx context.Context, request *apiv2pb.DeleteUserAccessTokenRequest) (*apiv2pb.DeleteUserAccessTokenResponse, error) {
-	user, err := getCurrentUser(ctx, s.Store)
+func (s *APIV2Service) ListSessions(ctx context.Context, request *apiv2pb.ListSessionsRequest) (*apiv2pb.ListSessionsResponse, error) {
+	user, err := s.getSessionOwner(ctx, request.Name)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		return nil, err
 	}
 
-	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+	sessions, err := s.Store.GetUserSessions(ctx, user.ID)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to list access tokens: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to list sessions: %v", err)
 	}
-	updatedUserAccessTokens := []*storepb.AccessTokensUserSetting_AccessToken{}
-	for _, userAccessToken := range userAccessTokens {
-		if userAccessToken.AccessToken == request.AccessToken {
+	currentTokenHash := ""
+	if accessToken, ok := ctx.Value(accessTokenContextKey).(string); ok {
+		currentTokenHash = store.HashAccessToken(accessToken)
+	}
+
+	response := &apiv2pb.ListSessionsResponse{
+		Sessions: []*apiv2pb.UserSession{},
+	}
+	for _, session := range sessions {
+		if session.ExpiresTs != 0 && session.ExpiresTs < time.Now().Unix() {
 			continue
 		}
-		updatedUserAccessTokens = append(updatedUserAccessTokens, userAccessToken)
-	}
-	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
-		UserId: user.ID,
-		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
-		Value: &storepb.UserSetting_AccessTokens{
-			AccessTokens: &storepb.AccessTokensUserSetting{
-				AccessTokens: updatedUserAccessTokens,
-			},
-		},
-	}); err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to upsert user setting: %v", err)
+		userSession := convertUserSessionFromStore(session)
+		userSession.Current = session.TokenHash == currentTokenHash
+		response.Sessions = append(response.Sessions, userSession)
 	}
-
-	return &apiv2pb.DeleteUserAccessTokenResponse{}, nil
+	// Sort by last seen time in descending order.
+	slices.SortFunc(response.Sessions, func(i, j *apiv2pb.UserSession) int {
+		return int(j.LastSeenTime.Seconds - i.LastSeenTime.Seconds)
+	})
+	return response, nil
 }
 
-func (s *APIV2Service) UpsertAccessTokenToStore(ctx context.Context, user *store.User, accessToken, description string) error {
-	userAccessTokens, err := s.Store.GetUserAccessTokens(ctx, user.ID)
+func (s *APIV2Service) RevokeSession(ctx context.Context, request *apiv2pb.RevokeSessionRequest) (*apiv2pb.RevokeSessionResponse, error) {
+	user, err := s.getSessionOwner(ctx, request.Name)
 	if err != nil {
-		return errors.Wrap(err, "failed to get user access tokens")
+		return nil, err
 	}
-	userAccessToken := storepb.AccessTokensUserSetting_AccessToken{
-		AccessToken: accessToken,
-		Description: description,
+
+	if err := s.Store.RemoveUserSessions(ctx, user.ID, func(session *storepb.SessionsUserSetting_Session) bool {
+		return session.Id == request.SessionId
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to revoke session: %v", err)
 	}
-	userAccessTokens = append(userAccessTokens, &userAccessToken)
-	if _, err := s.Store.UpsertUserSetting(ctx, &storepb.UserSetting{
-		UserId: user.ID,
-		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
-		Value: &storepb.UserSetting_AccessTokens{
-			AccessTokens: &storepb.AccessTokensUserSetting{
-				AccessTokens: userAccessTokens,
-			},
+	s.createAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionSessionRevoke,
+		Target: request.Name,
+		Payload: &storepb.AuditLogPayload{
+			Message: fmt.Sprintf("revoked session %s", request.SessionId),
 		},
+	})
+	return &apiv2pb.RevokeSessionResponse{}, nil
+}
+
+func (s *APIV2Service) RevokeAllOtherSessions(ctx context.Context, request *apiv2pb.RevokeAllOtherSessionsRequest) (*apiv2pb.RevokeAllOtherSessionsResponse, error) {
+	user, err := s.getSessionOwner(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// When an admin revokes the sessions of another user, there is no current session to keep.
+	currentTokenHash := ""
+	if accessToken, ok := ctx.Value(accessTokenContextKey).(string); ok {
+		currentTokenHash = store.HashAccessToken(accessToken)
+	}
+	if err := s.Store.RemoveUserSessions(ctx, user.ID, func(session *storepb.SessionsUserSetting_Session) bool {
+		return session.TokenHash != currentTokenHash
 	}); err != nil {
-		return errors.Wrap(err, "failed to upsert user setting")
+		return nil, status.Errorf(codes.Internal, "failed to revoke sessions: %v", err)
 	}
-	return nil
+	s.createAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionSessionRevoke,
+		Target: request.Name,
+		Payload: &storepb.AuditLogPayload{
+			Message: "revoked all other sessions",
+		},
+	})
+	return &apiv2pb.RevokeAllOtherSessionsResponse{}, nil
+}
+
+// getSessionOwner returns the user owning the sessions, which must be the current user unless the current user can manage the owner.
+func (s *APIV2Service) getSessionOwner(ctx context.Context, name string) (*store.User, error) {
+	userID, err := ExtractUserIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid user name: %v", err)
+	}
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+
+	user, err := s.Store.GetUser(ctx, &store.FindUser{ID: &userID})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if user == nil {
+		return nil, status.Errorf(codes.NotFound, "user not found")
+	}
+	evaluator, err := auth.GetEvaluator(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get permissions: %v", err)
+	}
+	if !evaluator.CanManageUser(currentUser, user) {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return user, nil
+}
+
+// createUserSession stores a sign-in session for the access token issued to the user.
+func (s *APIV2Service) createUserSession(ctx context.Context, user *store.User, accessToken string, expireTime time.Time) error {
+	session := &storepb.SessionsUserSetting_Session{
+		CreatedTs:  time.Now().Unix(),
+		LastSeenTs: time.Now().Unix(),
+	}
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		session.UserAgent = getUserAgent(md)
+		session.Ip = getClientIPFromContext(ctx)
+		session.LastSeenIp = session.Ip
+	}
+	if !expireTime.IsZero() {
+		session.ExpiresTs = expireTime.Unix()
+	}
+	return s.Store.CreateUserSession(ctx, user.ID, accessToken, session)
+}
+
+func convertUserSessionFromStore(session *storepb.SessionsUserSetting_Session) *apiv2pb.UserSession {
+	userSession := &apiv2pb.UserSession{
+		Id:           session.Id,
+		UserAgent:    session.UserAgent,
+		Ip:           session.Ip,
+		CreateTime:   timestamppb.New(time.Unix(session.CreatedTs, 0)),
+		LastSeenTime: timestamppb.New(time.Unix(session.LastSeenTs, 0)),
+		LastSeenIp:   session.LastSeenIp,
+	}
+	if session.ExpiresTs != 0 {
+		userSession.ExpireTime = timestamppb.New(time.Unix(session.ExpiresTs, 0))
+	}
+	return userSession
 }
 
 func convertUserFromStore(user *store.User) *apiv2pb.User {
@@ -509,6 +800,7 @@ func convertUserFromStore(user *store.User) *apiv2pb.User {
 		CreateTime:  timestamppb.New(time.Unix(user.CreatedTs, 0)),
 		UpdateTime:  timestamppb.New(time.Unix(user.UpdatedTs, 0)),
 		Role:        convertUserRoleFromStore(user.Role),
+		CustomRole:  convertUserCustomRoleFromStore(user.Role),
 		Username:    user.Username,
 		Email:       user.Email,
 		Nickname:    user.Nickname,
@@ -530,72 +822,63 @@ func convertUserRoleFromStore(role store.Role) apiv2pb.User_Role {
 	}
 }
 
-func convertUserRoleToStore(role apiv2pb.User_Role) store.Role {
-	switch role {
-	case apiv2pb.User_HOST:
-		return store.RoleHost
-	case apiv2pb.User_ADMIN:
-		return store.RoleAdmin
-	case apiv2pb.User_USER:
-		return store.RoleUser
-	default:
-		return store.RoleUser
+// convertUserCustomRoleFromStore returns the name of the role if it is not a built-in role.
+func convertUserCustomRoleFromStore(role store.Role) string {
+	if convertUserRoleFromStore(role) != apiv2pb.User_ROLE_UNSPECIFIED {
+		return ""
 	}
+	return string(role)
 }
 
-// SearchUsersFilterCELAttributes are the CEL attributes for SearchUsersFilter.
-var SearchUsersFilterCELAttributes = []cel.EnvOption{
-	cel.Variable("username", cel.StringType),
-	cel.Variable("random", cel.BoolType),
-	cel.Variable("limit", cel.IntType),
+// convertUserRoleToStoreWithCustomRole returns the custom role of the user if set, or else the built-in role.
+func convertUserRoleToStoreWithCustomRole(user *apiv2pb.User) store.Role {
+	if user.CustomRole != "" {
+		return store.Role(user.CustomRole)
+	}
+	return convertUserRoleToStore(user.Role)
 }
 
-type SearchUsersFilter struct {
-	Username *string
-	Random   bool
-	Limit    *int
+func convertPermissionsFromStore(permissions []auth.Permission) []string {
+	list := []string{}
+	for _, permission := range permissions {
+		list = append(list, string(permission))
+	}
+	return list
 }
 
-func parseSearchUsersFilter(expression string) (*SearchUsersFilter, error) {
-	e, err := cel.NewEnv(SearchUsersFilterCELAttributes...)
+// getUserPermissions returns the permissions granted to the user in the workspace.
+func (s *APIV2Service) getUserPermissions(ctx context.Context, user *store.User) ([]string, error) {
+	evaluator, err := auth.GetEvaluator(ctx, s.Store)
 	if err != nil {
 		return nil, err
 	}
-	ast, issues := e.Compile(expression)
-	if issues != nil {
-		return nil, errors.Errorf("found issue %v", issues)
-	}
-	filter := &SearchUsersFilter{}
-	expr, err := cel.AstToParsedExpr(ast)
-	if err != nil {
-		return nil, err
+	if user.RowStatus == store.Archived {
+		return []string{}, nil
 	}
-	callExpr := expr.GetExpr().GetCallExpr()
-	findSearchUsersField(callExpr, filter)
-	return filter, nil
+	return convertPermissionsFromStore(evaluator.RolePermissions(user.Role)), nil
 }
 
-func findSearchUsersField(callExpr *expr.Expr_Call, filter *SearchUsersFilter) {
-	if len(callExpr.Args) == 2 {
-		idExpr := callExpr.Args[0].GetIdentExpr()
-		if idExpr != nil {
-			if idExpr.Name == "username" {
-				username := callExpr.Args[1].GetConstExpr().GetStringValue()
-				filter.Username = &username
-			} else if idExpr.Name == "random" {
-				random := callExpr.Args[1].GetConstExpr().GetBoolValue()
-				filter.Random = random
-			} else if idExpr.Name == "limit" {
-				limit := int(callExpr.Args[1].GetConstExpr().GetInt64Value())
-				filter.Limit = &limit
-			}
-			return
-		}
-	}
-	for _, arg := range callExpr.Args {
-		callExpr := arg.GetCallExpr()
-		if callExpr != nil {
-			findSearchUsersField(callExpr, filter)
-		}
+func convertUserRoleToStore(role apiv2pb.User_Role) store.Role {
+	switch role {
+	case apiv2pb.User_HOST:
+		return store.RoleHost
+	case apiv2pb.User_ADMIN:
+		return store.RoleAdmin
+	case apiv2pb.User_USER:
+		return store.RoleUser
+	default:
+		return store.RoleUser
 	}
 }
+
+// SearchUsersFilterFields are the variables of the user filters.
+// The users can be searched by anyone, so the private fields such as the email are not variables.
+var SearchUsersFilterFields = []*filter.Field{
+	{Name: "username", Type: cel.StringType},
+	{Name: "nickname", Type: cel.StringType},
+	{Name: "role", Type: cel.StringType},
+	{Name: "row_status", Type: cel.StringType},
+	{Name: "create_time", Type: cel.TimestampType, Column: "created_ts", Convert: convertTimestampToUnix},
+	{Name: "random", Type: cel.BoolType, Option: true},
+	{Name: "limit", Type: cel.IntType, Option: true},
+}
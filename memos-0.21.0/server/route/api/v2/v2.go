@@ -5,17 +5,24 @@ import (
 	"fmt"
 	"log/slog"
 	"net"
+	"net/http"
+	"strings"
 
+	"github.com/google/uuid"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/improbable-eng/grpc-web/go/grpcweb"
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/reflection"
 
+	"github.com/usememos/memos/internal/ratelimit"
+	"github.com/usememos/memos/plugin/mail"
 	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
 	"github.com/usememos/memos/server/profile"
+	"github.com/usememos/memos/server/route/api/auth"
 	"github.com/usememos/memos/store"
 )
 
@@ -24,50 +31,75 @@ type APIV2Service struct {
 	apiv2pb.UnimplementedWorkspaceSettingServiceServer
 	apiv2pb.UnimplementedAuthServiceServer
 	apiv2pb.UnimplementedUserServiceServer
+	apiv2pb.UnimplementedGroupServiceServer
 	apiv2pb.UnimplementedMemoServiceServer
 	apiv2pb.UnimplementedResourceServiceServer
 	apiv2pb.UnimplementedTagServiceServer
 	apiv2pb.UnimplementedInboxServiceServer
 	apiv2pb.UnimplementedActivityServiceServer
+	apiv2pb.UnimplementedAuditLogServiceServer
 	apiv2pb.UnimplementedWebhookServiceServer
 	apiv2pb.UnimplementedLinkServiceServer
+	apiv2pb.UnimplementedInvitationServiceServer
+	apiv2pb.UnimplementedViewServiceServer
+	apiv2pb.UnimplementedTaskServiceServer
 
-	Secret  string
-	Profile *profile.Profile
-	Store   *store.Store
+	Secret     string
+	Profile    *profile.Profile
+	Store      *store.Store
+	LoginGuard *ratelimit.LoginGuard
+	// MailSender overrides the SMTP server of the workspace settings to send the emails, if set.
+	MailSender mail.Sender
 
 	grpcServer     *grpc.Server
 	grpcServerPort int
+
+	proxyAuthenticator *auth.ProxyAuthenticator
+	gatewaySecret      string
 }
 
-func NewAPIV2Service(secret string, profile *profile.Profile, store *store.Store, grpcServerPort int) *APIV2Service {
+func NewAPIV2Service(secret string, profile *profile.Profile, store *store.Store, grpcServerPort int, rateLimiter *ratelimit.Limiter, loginGuard *ratelimit.LoginGuard, proxyAuthenticator *auth.ProxyAuthenticator, trustedProxies auth.TrustedProxies) *APIV2Service {
 	grpc.EnableTracing = true
-	authProvider := NewGRPCAuthInterceptor(store, secret)
+	gatewaySecret := uuid.NewString()
+	authProvider := NewGRPCAuthInterceptor(store, secret, proxyAuthenticator, gatewaySecret)
+	rateLimitInterceptor := NewRateLimitInterceptor(rateLimiter)
 	grpcServer := grpc.NewServer(
 		grpc.ChainUnaryInterceptor(
 			NewLoggerInterceptor().LoggerInterceptor,
+			NewClientIPInterceptor(trustedProxies, gatewaySecret).ClientIPInterceptor,
+			rateLimitInterceptor.IPRateLimitInterceptor,
 			authProvider.AuthenticationInterceptor,
+			rateLimitInterceptor.RateLimitInterceptor,
 		),
 	)
 	apiv2Service := &APIV2Service{
 		Secret:         secret,
 		Profile:        profile,
 		Store:          store,
+		LoginGuard:     loginGuard,
 		grpcServer:     grpcServer,
 		grpcServerPort: grpcServerPort,
+
+		proxyAuthenticator: proxyAuthenticator,
+		gatewaySecret:      gatewaySecret,
 	}
 
 	apiv2pb.RegisterWorkspaceServiceServer(grpcServer, apiv2Service)
 	apiv2pb.RegisterWorkspaceSettingServiceServer(grpcServer, apiv2Service)
 	apiv2pb.RegisterAuthServiceServer(grpcServer, apiv2Service)
 	apiv2pb.RegisterUserServiceServer(grpcServer, apiv2Service)
+	apiv2pb.RegisterGroupServiceServer(grpcServer, apiv2Service)
 	apiv2pb.RegisterMemoServiceServer(grpcServer, apiv2Service)
 	apiv2pb.RegisterTagServiceServer(grpcServer, apiv2Service)
 	apiv2pb.RegisterResourceServiceServer(grpcServer, apiv2Service)
 	apiv2pb.RegisterInboxServiceServer(grpcServer, apiv2Service)
 	apiv2pb.RegisterActivityServiceServer(grpcServer, apiv2Service)
+	apiv2pb.RegisterAuditLogServiceServer(grpcServer, apiv2Service)
 	apiv2pb.RegisterWebhookServiceServer(grpcServer, apiv2Service)
 	apiv2pb.RegisterLinkServiceServer(grpcServer, apiv2Service)
+	apiv2pb.RegisterInvitationServiceServer(grpcServer, apiv2Service)
+	apiv2pb.RegisterViewServiceServer(grpcServer, apiv2Service)
+	apiv2pb.RegisterTaskServiceServer(grpcServer, apiv2Service)
 	reflection.Register(grpcServer)
 
 	return apiv2Service
@@ -90,7 +122,18 @@ func (s *APIV2Service) RegisterGateway(ctx context.Context, e *echo.Echo) error
 		return err
 	}
 
-	gwMux := runtime.NewServeMux()
+	gwMux := runtime.NewServeMux(
+		runtime.WithIncomingHeaderMatcher(func(key string) (string, bool) {
+			// Forward the header set by the trusted reverse proxy as is.
+			if header := s.proxyAuthenticator.Header(); header != "" && strings.EqualFold(key, header) {
+				return key, true
+			}
+			return runtime.DefaultHeaderMatcher(key)
+		}),
+		runtime.WithMetadata(func(_ context.Context, request *http.Request) metadata.MD {
+			return metadata.Pairs(gatewaySecretMetadataKey, s.gatewaySecret, remoteAddrMetadataKey, request.RemoteAddr)
+		}),
+	)
 	if err := apiv2pb.RegisterWorkspaceServiceHandler(context.Background(), gwMux, conn); err != nil {
 		return err
 	}
@@ -103,6 +146,9 @@ func (s *APIV2Service) RegisterGateway(ctx context.Context, e *echo.Echo) error
 	if err := apiv2pb.RegisterUserServiceHandler(context.Background(), gwMux, conn); err != nil {
 		return err
 	}
+	if err := apiv2pb.RegisterGroupServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
 	if err := apiv2pb.RegisterMemoServiceHandler(context.Background(), gwMux, conn); err != nil {
 		return err
 	}
@@ -118,12 +164,24 @@ func (s *APIV2Service) RegisterGateway(ctx context.Context, e *echo.Echo) error
 	if err := apiv2pb.RegisterActivityServiceHandler(context.Background(), gwMux, conn); err != nil {
 		return err
 	}
+	if err := apiv2pb.RegisterAuditLogServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
 	if err := apiv2pb.RegisterWebhookServiceHandler(context.Background(), gwMux, conn); err != nil {
 		return err
 	}
 	if err := apiv2pb.RegisterLinkServiceHandler(context.Background(), gwMux, conn); err != nil {
 		return err
 	}
+	if err := apiv2pb.RegisterInvitationServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
+	if err := apiv2pb.RegisterViewServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
+	if err := apiv2pb.RegisterTaskServiceHandler(context.Background(), gwMux, conn); err != nil {
+		return err
+	}
 	e.Any("/api/v2/*", echo.WrapHandler(gwMux))
 
 	// GRPC web proxy.
@@ -0,0 +1,296 @@
+package v2
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	"github.com/usememos/memos/store"
+)
+
+func (s *APIV2Service) ListViews(ctx context.Context, _ *apiv2pb.ListViewsRequest) (*apiv2pb.ListViewsResponse, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	views, err := s.Store.ListMemoViews(ctx, &store.FindMemoView{
+		VisibleToUserID: &currentUser.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to list views: %v", err)
+	}
+
+	response := &apiv2pb.ListViewsResponse{
+		Views: []*apiv2pb.View{},
+	}
+	for _, view := range views {
+		response.Views = append(response.Views, convertViewFromStore(view))
+	}
+	return response, nil
+}
+
+func (s *APIV2Service) GetView(ctx context.Context, request *apiv2pb.GetViewRequest) (*apiv2pb.GetViewResponse, error) {
+	view, err := s.getMemoViewByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &apiv2pb.GetViewResponse{
+		View: convertViewFromStore(view),
+	}, nil
+}
+
+func (s *APIV2Service) CreateView(ctx context.Context, request *apiv2pb.CreateViewRequest) (*apiv2pb.CreateViewResponse, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if request.View == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "view is required")
+	}
+
+	create := &store.MemoView{
+		CreatorID:  currentUser.ID,
+		Name:       request.View.DisplayName,
+		Filter:     request.View.Filter,
+		OrderBy:    request.View.OrderBy,
+		Visibility: convertViewVisibilityToStore(request.View.Visibility),
+	}
+	if err := validateMemoView(create); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid view: %v", err)
+	}
+	view, err := s.Store.CreateMemoView(ctx, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create view: %v", err)
+	}
+	return &apiv2pb.CreateViewResponse{
+		View: convertViewFromStore(view),
+	}, nil
+}
+
+func (s *APIV2Service) UpdateView(ctx context.Context, request *apiv2pb.UpdateViewRequest) (*apiv2pb.UpdateViewResponse, error) {
+	if request.UpdateMask == nil || len(request.UpdateMask.Paths) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "update mask is required")
+	}
+	if request.View == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "view is required")
+	}
+	view, err := s.getOwnMemoViewByName(ctx, request.View.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	currentTs := time.Now().Unix()
+	update := &store.UpdateMemoView{
+		ID:        view.ID,
+		UpdatedTs: &currentTs,
+	}
+	for _, path := range request.UpdateMask.Paths {
+		if path == "display_name" {
+			update.Name = &request.View.DisplayName
+			view.Name = request.View.DisplayName
+		} else if path == "filter" {
+			update.Filter = &request.View.Filter
+			view.Filter = request.View.Filter
+		} else if path == "order_by" {
+			update.OrderBy = &request.View.OrderBy
+			view.OrderBy = request.View.OrderBy
+		} else if path == "visibility" {
+			visibility := convertViewVisibilityToStore(request.View.Visibility)
+			update.Visibility = &visibility
+			view.Visibility = visibility
+		} else {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid update path: %s", path)
+		}
+	}
+	if err := validateMemoView(view); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid view: %v", err)
+	}
+
+	view, err = s.Store.UpdateMemoView(ctx, update)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to update view: %v", err)
+	}
+	return &apiv2pb.UpdateViewResponse{
+		View: convertViewFromStore(view),
+	}, nil
+}
+
+func (s *APIV2Service) DeleteView(ctx context.Context, request *apiv2pb.DeleteViewRequest) (*apiv2pb.DeleteViewResponse, error) {
+	view, err := s.getOwnMemoViewByName(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.Store.DeleteMemoView(ctx, &store.DeleteMemoView{
+		ID: view.ID,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete view: %v", err)
+	}
+	return &apiv2pb.DeleteViewResponse{}, nil
+}
+
+// getMemoViewByName returns the view if it is visible to the current user, as their own view or a view shared with the workspace.
+func (s *APIV2Service) getMemoViewByName(ctx context.Context, name string) (*store.MemoView, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	if currentUser == nil {
+		return nil, status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	id, err := ExtractViewIDFromName(name)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid view name: %v", err)
+	}
+	view, err := s.Store.GetMemoView(ctx, &store.FindMemoView{
+		ID:              &id,
+		VisibleToUserID: &currentUser.ID,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get view: %v", err)
+	}
+	if view == nil {
+		return nil, status.Errorf(codes.NotFound, "view not found")
+	}
+	return view, nil
+}
+
+// getOwnMemoViewByName returns the view if it was created by the current user, who is the only one to change it.
+func (s *APIV2Service) getOwnMemoViewByName(ctx context.Context, name string) (*store.MemoView, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	view, err := s.getMemoViewByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if view.CreatorID != currentUser.ID {
+		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	}
+	return view, nil
+}
+
+// ApplyMemoView adds the filter of the view to the find of the memos, and sorts them in the order of the view.
+// The relative times of the filter are resolved now.
+func ApplyMemoView(find *store.FindMemo, view *store.MemoView, displayWithUpdatedTs bool) error {
+	if view.Filter != "" {
+		if err := applyMemoFilter(find, view.Filter, displayWithUpdatedTs); err != nil {
+			return errors.Wrap(err, "invalid filter")
+		}
+	}
+	if err := applyMemoOrderBy(find, view.OrderBy, displayWithUpdatedTs); err != nil {
+		return errors.Wrap(err, "invalid order by")
+	}
+	return nil
+}
+
+// applyMemoOrderBy sorts the memos of the find in the order, such as "pinned desc, display_time asc"
+// or "property.priority desc". The memos with the same value of a property are sorted by their display time.
+func applyMemoOrderBy(find *store.FindMemo, orderBy string, displayWithUpdatedTs bool) error {
+	if strings.TrimSpace(orderBy) == "" {
+		return nil
+	}
+	find.OrderByPinned = false
+	find.OrderByProperty = ""
+	hasTimeField := false
+	for i, item := range strings.Split(orderBy, ",") {
+		tokens := strings.Fields(item)
+		if len(tokens) == 0 || len(tokens) > 2 {
+			return errors.Errorf("invalid order %q", strings.TrimSpace(item))
+		}
+		desc := false
+		if len(tokens) == 2 {
+			switch strings.ToLower(tokens[1]) {
+			case "asc":
+			case "desc":
+				desc = true
+			default:
+				return errors.Errorf("invalid direction %q", tokens[1])
+			}
+		}
+
+		field := tokens[0]
+		if field == "pinned" {
+			if i != 0 || !desc {
+				return errors.New("pinned can only be sorted desc before the other fields")
+			}
+			find.OrderByPinned = true
+			continue
+		}
+		if key, ok := strings.CutPrefix(field, "property."); ok {
+			if find.OrderByProperty != "" || hasTimeField {
+				return errors.New("a property can only be sorted once, before the time field")
+			}
+			if key == "" {
+				return errors.New("the property key is required")
+			}
+			find.OrderByProperty = key
+			find.OrderByPropertyDesc = desc
+			continue
+		}
+		if hasTimeField {
+			return errors.Errorf("unsupported order by more than one time field")
+		}
+		switch field {
+		case "create_time":
+			find.OrderByUpdatedTs = false
+		case "update_time":
+			find.OrderByUpdatedTs = true
+		case "display_time":
+			find.OrderByUpdatedTs = displayWithUpdatedTs
+		default:
+			return errors.Errorf("unsupported order by field %s", field)
+		}
+		find.OrderByTimeAsc = !desc
+		hasTimeField = true
+	}
+	return nil
+}
+
+// validateMemoView checks the view can be used to list the memos.
+func validateMemoView(view *store.MemoView) error {
+	if view.Name == "" {
+		return errors.New("display name is required")
+	}
+	return ApplyMemoView(&store.FindMemo{}, view, false)
+}
+
+func convertViewFromStore(view *store.MemoView) *apiv2pb.View {
+	return &apiv2pb.View{
+		Name:        fmt.Sprintf("%s%d", ViewNamePrefix, view.ID),
+		Creator:     fmt.Sprintf("%s%d", UserNamePrefix, view.CreatorID),
+		CreateTime:  timestamppb.New(time.Unix(view.CreatedTs, 0)),
+		UpdateTime:  timestamppb.New(time.Unix(view.UpdatedTs, 0)),
+		DisplayName: view.Name,
+		Filter:      view.Filter,
+		OrderBy:     view.OrderBy,
+		Visibility:  convertViewVisibilityFromStore(view.Visibility),
+	}
+}
+
+func convertViewVisibilityFromStore(visibility store.MemoViewVisibility) apiv2pb.View_Visibility {
+	switch visibility {
+	case store.MemoViewPrivate:
+		return apiv2pb.View_PRIVATE
+	case store.MemoViewWorkspace:
+		return apiv2pb.View_WORKSPACE
+	default:
+		return apiv2pb.View_VISIBILITY_UNSPECIFIED
+	}
+}
+
+func convertViewVisibilityToStore(visibility apiv2pb.View_Visibility) store.MemoViewVisibility {
+	switch visibility {
+	case apiv2pb.View_WORKSPACE:
+		return store.MemoViewWorkspace
+	default:
+		return store.MemoViewPrivate
+	}
+}
@@ -19,10 +19,17 @@ func (s *APIV2Service) CreateWebhook(ctx context.Context, request *apiv2pb.Creat
 		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
 	}
 
+	if request.GroupId != 0 {
+		if _, err := s.getAdministratedGroup(ctx, request.GroupId); err != nil {
+			return nil, err
+		}
+	}
+
 	webhook, err := s.Store.CreateWebhook(ctx, &storepb.Webhook{
 		CreatorId: currentUser.ID,
 		Name:      request.Name,
 		Url:       request.Url,
+		GroupId:   request.GroupId,
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to create webhook, error: %+v", err)
@@ -33,9 +40,34 @@ func (s *APIV2Service) CreateWebhook(ctx context.Context, request *apiv2pb.Creat
 }
 
 func (s *APIV2Service) ListWebhooks(ctx context.Context, request *apiv2pb.ListWebhooksRequest) (*apiv2pb.ListWebhooksResponse, error) {
-	webhooks, err := s.Store.ListWebhooks(ctx, &store.FindWebhook{
-		CreatorID: &request.CreatorId,
-	})
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	find := &store.FindWebhook{
+		GroupID: &request.GroupId,
+	}
+	if request.GroupId != 0 {
+		if _, err := s.getAdministratedGroup(ctx, request.GroupId); err != nil {
+			return nil, err
+		}
+	} else {
+		// Personal webhooks are only listed to their creators.
+		if request.CreatorId != 0 && request.CreatorId != currentUser.ID {
+			return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+		}
+		find.CreatorID = &currentUser.ID
+	}
+	limit, cursor, err := s.getPageLimitAndCursor(request.PageSize, request.PageToken)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid page token: %v", err)
+	}
+	find.Cursor = cursor
+	limitPlusOne := limit + 1
+	if limit > 0 {
+		find.Limit = &limitPlusOne
+	}
+	webhooks, err := s.Store.ListWebhooks(ctx, find)
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to list webhooks, error: %+v", err)
 	}
@@ -43,6 +75,13 @@ func (s *APIV2Service) ListWebhooks(ctx context.Context, request *apiv2pb.ListWe
 	response := &apiv2pb.ListWebhooksResponse{
 		Webhooks: []*apiv2pb.Webhook{},
 	}
+	if limit > 0 && len(webhooks) == limitPlusOne {
+		webhooks = webhooks[:limit]
+		response.NextPageToken, err = s.getCursorPageToken(limit, &store.Cursor{ID: webhooks[len(webhooks)-1].Id})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to get next page token, error: %v", err)
+		}
+	}
 	for _, webhook := range webhooks {
 		response.Webhooks = append(response.Webhooks, convertWebhookFromStore(webhook))
 	}
@@ -50,20 +89,9 @@ func (s *APIV2Service) ListWebhooks(ctx context.Context, request *apiv2pb.ListWe
 }
 
 func (s *APIV2Service) GetWebhook(ctx context.Context, request *apiv2pb.GetWebhookRequest) (*apiv2pb.GetWebhookResponse, error) {
-	currentUser, err := getCurrentUser(ctx, s.Store)
-	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
-	}
-
-	webhook, err := s.Store.GetWebhooks(ctx, &store.FindWebhook{
-		ID:        &request.Id,
-		CreatorID: &currentUser.ID,
-	})
+	webhook, err := s.getManagedWebhook(ctx, request.Id)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get webhook, error: %+v", err)
-	}
-	if webhook == nil {
-		return nil, status.Errorf(codes.NotFound, "webhook not found")
+		return nil, err
 	}
 	return &apiv2pb.GetWebhookResponse{
 		Webhook: convertWebhookFromStore(webhook),
@@ -75,7 +103,13 @@ func (s *APIV2Service) UpdateWebhook(ctx context.Context, request *apiv2pb.Updat
 		return nil, status.Errorf(codes.InvalidArgument, "update_mask is required")
 	}
 
-	update := &store.UpdateWebhook{}
+	if _, err := s.getManagedWebhook(ctx, request.Webhook.Id); err != nil {
+		return nil, err
+	}
+
+	update := &store.UpdateWebhook{
+		ID: request.Webhook.Id,
+	}
 	for _, field := range request.UpdateMask.Paths {
 		switch field {
 		case "row_status":
@@ -98,6 +132,10 @@ func (s *APIV2Service) UpdateWebhook(ctx context.Context, request *apiv2pb.Updat
 }
 
 func (s *APIV2Service) DeleteWebhook(ctx context.Context, request *apiv2pb.DeleteWebhookRequest) (*apiv2pb.DeleteWebhookResponse, error) {
+	if _, err := s.getManagedWebhook(ctx, request.Id); err != nil {
+		return nil, err
+	}
+
 	err := s.Store.DeleteWebhook(ctx, &store.DeleteWebhook{
 		ID: request.Id,
 	})
@@ -107,6 +145,46 @@ func (s *APIV2Service) DeleteWebhook(ctx context.Context, request *apiv2pb.Delet
 	return &apiv2pb.DeleteWebhookResponse{}, nil
 }
 
+// getManagedWebhook returns the webhook if the current user is its creator,
+// or administrates the group it belongs to.
+func (s *APIV2Service) getManagedWebhook(ctx context.Context, id int32) (*storepb.Webhook, error) {
+	currentUser, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get user: %v", err)
+	}
+	webhook, err := s.Store.GetWebhooks(ctx, &store.FindWebhook{
+		ID: &id,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webhook, error: %+v", err)
+	}
+	if webhook == nil {
+		return nil, status.Errorf(codes.NotFound, "webhook not found")
+	}
+	if webhook.GroupId != 0 {
+		if _, err := s.getAdministratedGroup(ctx, webhook.GroupId); err != nil {
+			return nil, err
+		}
+	} else if webhook.CreatorId != currentUser.ID {
+		return nil, status.Errorf(codes.NotFound, "webhook not found")
+	}
+	return webhook, nil
+}
+
+func (s *APIV2Service) getAdministratedGroup(ctx context.Context, id int32) (*store.UserGroup, error) {
+	group, err := s.Store.GetUserGroup(ctx, &store.FindUserGroup{ID: &id})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get group: %v", err)
+	}
+	if group == nil {
+		return nil, status.Errorf(codes.NotFound, "group not found")
+	}
+	if err := s.checkGroupAdmin(ctx, group); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
 func convertWebhookFromStore(webhook *storepb.Webhook) *apiv2pb.Webhook {
 	return &apiv2pb.Webhook{
 		Id:          webhook.Id,
@@ -116,5 +194,6 @@ func convertWebhookFromStore(webhook *storepb.Webhook) *apiv2pb.Webhook {
 		CreatorId:   webhook.CreatorId,
 		Name:        webhook.Name,
 		Url:         webhook.Url,
+		GroupId:     webhook.GroupId,
 	}
 }
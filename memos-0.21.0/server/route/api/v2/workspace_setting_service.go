@@ -9,6 +9,7 @@ import (
 
 	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
 	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/server/route/api/auth"
 	"github.com/usememos/memos/store"
 )
 
@@ -18,6 +19,12 @@ func (s *APIV2Service) GetWorkspaceSetting(ctx context.Context, request *apiv2pb
 		return nil, status.Errorf(codes.InvalidArgument, "invalid workspace setting name: %v", err)
 	}
 	settingKey := storepb.WorkspaceSettingKey(storepb.WorkspaceSettingKey_value[settingKeyString])
+	// The SMTP server is only shown to the users managing the workspace, even with its password redacted.
+	if settingKey == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SMTP {
+		if err := s.checkManageWorkspacePermission(ctx); err != nil {
+			return nil, err
+		}
+	}
 	workspaceSetting, err := s.Store.GetWorkspaceSettingV1(ctx, &store.FindWorkspaceSettingV1{
 		Key: settingKey,
 	})
@@ -38,38 +45,104 @@ func (s *APIV2Service) SetWorkspaceSetting(ctx context.Context, request *apiv2pb
 		return nil, status.Errorf(codes.InvalidArgument, "setting workspace setting is not allowed in demo mode")
 	}
 
-	user, err := getCurrentUser(ctx, s.Store)
+	// The caller is granted the permission to manage the workspace by the auth interceptor.
+	if rolesSetting := request.Setting.GetRolesSetting(); rolesSetting != nil {
+		if err := auth.ValidateRoles(convertWorkspaceRolesSettingToStore(rolesSetting).Roles); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid roles setting: %v", err)
+		}
+	}
+
+	workspaceSetting := convertWorkspaceSettingToStore(request.Setting)
+	before, err := s.Store.GetWorkspaceSettingV1(ctx, &store.FindWorkspaceSettingV1{
+		Key: workspaceSetting.Key,
+	})
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, "failed to get current user: %v", err)
+		return nil, status.Errorf(codes.Internal, "failed to get workspace setting: %v", err)
 	}
-	if user.Role != store.RoleHost {
-		return nil, status.Errorf(codes.PermissionDenied, "permission denied")
+	if smtpSetting := workspaceSetting.GetSmtp(); smtpSetting != nil {
+		// Sending the redacted password back keeps the current password.
+		if smtpSetting.Password == auth.RedactedValue {
+			smtpSetting.Password = before.GetSmtp().GetPassword()
+		}
+		if smtpSetting.Host != "" {
+			if err := convertSMTPConfigFromStore(smtpSetting).Validate(); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid smtp setting: %v", err)
+			}
+		}
 	}
-
-	if _, err := s.Store.UpsertWorkspaceSettingV1(ctx, convertWorkspaceSettingToStore(request.Setting)); err != nil {
+	if passwordPolicySetting := workspaceSetting.GetPasswordPolicy(); passwordPolicySetting != nil {
+		if passwordPolicySetting.MinLength < 0 || passwordPolicySetting.ExpiryDays < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid password policy setting: min length and expiry days must not be negative")
+		}
+	}
+	// Snapshot the setting before upserting it, as the store may return the cached message.
+	auditLog := &store.AuditLog{
+		Action:  store.AuditActionWorkspaceSettingUpdate,
+		Target:  fmt.Sprintf("%s%s", WorkspaceSettingNamePrefix, workspaceSetting.Key.String()),
+		Payload: &storepb.AuditLogPayload{},
+	}
+	if before != nil {
+		auditLog.Payload.Before = auth.NewAuditSnapshot(before)
+	}
+	after, err := s.Store.UpsertWorkspaceSettingV1(ctx, workspaceSetting)
+	if err != nil {
 		return nil, status.Errorf(codes.Internal, "failed to upsert workspace setting: %v", err)
 	}
+	auditLog.Payload.After = auth.NewAuditSnapshot(after)
+	s.createAuditLog(ctx, auditLog)
 
 	return &apiv2pb.SetWorkspaceSettingResponse{}, nil
 }
 
 func convertWorkspaceSettingFromStore(setting *storepb.WorkspaceSetting) *apiv2pb.WorkspaceSetting {
-	return &apiv2pb.WorkspaceSetting{
+	workspaceSetting := &apiv2pb.WorkspaceSetting{
 		Name: fmt.Sprintf("%s%s", WorkspaceSettingNamePrefix, setting.Key.String()),
-		Value: &apiv2pb.WorkspaceSetting_GeneralSetting{
-			GeneralSetting: convertWorkspaceGeneralSettingFromStore(setting.GetGeneral()),
-		},
 	}
+	switch value := setting.Value.(type) {
+	case *storepb.WorkspaceSetting_General:
+		workspaceSetting.Value = &apiv2pb.WorkspaceSetting_GeneralSetting{
+			GeneralSetting: convertWorkspaceGeneralSettingFromStore(value.General),
+		}
+	case *storepb.WorkspaceSetting_Roles:
+		workspaceSetting.Value = &apiv2pb.WorkspaceSetting_RolesSetting{
+			RolesSetting: convertWorkspaceRolesSettingFromStore(value.Roles),
+		}
+	case *storepb.WorkspaceSetting_Smtp:
+		workspaceSetting.Value = &apiv2pb.WorkspaceSetting_SmtpSetting{
+			SmtpSetting: convertWorkspaceSmtpSettingFromStore(value.Smtp),
+		}
+	case *storepb.WorkspaceSetting_PasswordPolicy:
+		workspaceSetting.Value = &apiv2pb.WorkspaceSetting_PasswordPolicySetting{
+			PasswordPolicySetting: convertWorkspacePasswordPolicySettingFromStore(value.PasswordPolicy),
+		}
+	}
+	return workspaceSetting
 }
 
 func convertWorkspaceSettingToStore(setting *apiv2pb.WorkspaceSetting) *storepb.WorkspaceSetting {
 	settingKeyString, _ := ExtractWorkspaceSettingKeyFromName(setting.Name)
-	return &storepb.WorkspaceSetting{
+	workspaceSetting := &storepb.WorkspaceSetting{
 		Key: storepb.WorkspaceSettingKey(storepb.WorkspaceSettingKey_value[settingKeyString]),
-		Value: &storepb.WorkspaceSetting_General{
-			General: convertWorkspaceGeneralSettingToStore(setting.GetGeneralSetting()),
-		},
 	}
+	switch value := setting.Value.(type) {
+	case *apiv2pb.WorkspaceSetting_GeneralSetting:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_General{
+			General: convertWorkspaceGeneralSettingToStore(value.GeneralSetting),
+		}
+	case *apiv2pb.WorkspaceSetting_RolesSetting:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_Roles{
+			Roles: convertWorkspaceRolesSettingToStore(value.RolesSetting),
+		}
+	case *apiv2pb.WorkspaceSetting_SmtpSetting:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_Smtp{
+			Smtp: convertWorkspaceSmtpSettingToStore(value.SmtpSetting),
+		}
+	case *apiv2pb.WorkspaceSetting_PasswordPolicySetting:
+		workspaceSetting.Value = &storepb.WorkspaceSetting_PasswordPolicy{
+			PasswordPolicy: convertWorkspacePasswordPolicySettingToStore(value.PasswordPolicySetting),
+		}
+	}
+	return workspaceSetting
 }
 
 func convertWorkspaceGeneralSettingFromStore(setting *storepb.WorkspaceGeneralSetting) *apiv2pb.WorkspaceGeneralSetting {
@@ -77,11 +150,13 @@ func convertWorkspaceGeneralSettingFromStore(setting *storepb.WorkspaceGeneralSe
 		return nil
 	}
 	return &apiv2pb.WorkspaceGeneralSetting{
-		InstanceUrl:           setting.InstanceUrl,
-		DisallowSignup:        setting.DisallowSignup,
-		DisallowPasswordLogin: setting.DisallowPasswordLogin,
-		AdditionalScript:      setting.AdditionalScript,
-		AdditionalStyle:       setting.AdditionalStyle,
+		InstanceUrl:              setting.InstanceUrl,
+		DisallowSignup:           setting.DisallowSignup,
+		DisallowPasswordLogin:    setting.DisallowPasswordLogin,
+		AdditionalScript:         setting.AdditionalScript,
+		AdditionalStyle:          setting.AdditionalStyle,
+		RequireEmailVerification: setting.RequireEmailVerification,
+		AllowUserInvitations:     setting.AllowUserInvitations,
 	}
 }
 
@@ -90,10 +165,124 @@ func convertWorkspaceGeneralSettingToStore(setting *apiv2pb.WorkspaceGeneralSett
 		return nil
 	}
 	return &storepb.WorkspaceGeneralSetting{
-		InstanceUrl:           setting.InstanceUrl,
-		DisallowSignup:        setting.DisallowSignup,
-		DisallowPasswordLogin: setting.DisallowPasswordLogin,
-		AdditionalScript:      setting.AdditionalScript,
-		AdditionalStyle:       setting.AdditionalStyle,
+		InstanceUrl:              setting.InstanceUrl,
+		DisallowSignup:           setting.DisallowSignup,
+		DisallowPasswordLogin:    setting.DisallowPasswordLogin,
+		AdditionalScript:         setting.AdditionalScript,
+		AdditionalStyle:          setting.AdditionalStyle,
+		RequireEmailVerification: setting.RequireEmailVerification,
+		AllowUserInvitations:     setting.AllowUserInvitations,
+	}
+}
+
+func convertWorkspaceRolesSettingFromStore(setting *storepb.WorkspaceRolesSetting) *apiv2pb.WorkspaceRolesSetting {
+	if setting == nil {
+		return nil
+	}
+	rolesSetting := &apiv2pb.WorkspaceRolesSetting{
+		Roles: []*apiv2pb.WorkspaceRole{},
+	}
+	for _, role := range setting.Roles {
+		rolesSetting.Roles = append(rolesSetting.Roles, &apiv2pb.WorkspaceRole{
+			Name:        role.Name,
+			Permissions: role.Permissions,
+		})
+	}
+	return rolesSetting
+}
+
+func convertWorkspaceRolesSettingToStore(setting *apiv2pb.WorkspaceRolesSetting) *storepb.WorkspaceRolesSetting {
+	if setting == nil {
+		return nil
+	}
+	rolesSetting := &storepb.WorkspaceRolesSetting{
+		Roles: []*storepb.WorkspaceRole{},
+	}
+	for _, role := range setting.Roles {
+		rolesSetting.Roles = append(rolesSetting.Roles, &storepb.WorkspaceRole{
+			Name:        role.Name,
+			Permissions: role.Permissions,
+		})
+	}
+	return rolesSetting
+}
+
+func convertWorkspaceSmtpSettingFromStore(setting *storepb.WorkspaceSmtpSetting) *apiv2pb.WorkspaceSmtpSetting {
+	if setting == nil {
+		return nil
+	}
+	smtpSetting := &apiv2pb.WorkspaceSmtpSetting{
+		Host:      setting.Host,
+		Port:      setting.Port,
+		Username:  setting.Username,
+		FromEmail: setting.FromEmail,
+		FromName:  setting.FromName,
+		Security:  apiv2pb.WorkspaceSmtpSetting_Security(setting.Security),
+	}
+	if setting.Password != "" {
+		smtpSetting.Password = auth.RedactedValue
+	}
+	return smtpSetting
+}
+
+func convertWorkspaceSmtpSettingToStore(setting *apiv2pb.WorkspaceSmtpSetting) *storepb.WorkspaceSmtpSetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspaceSmtpSetting{
+		Host:      setting.Host,
+		Port:      setting.Port,
+		Username:  setting.Username,
+		Password:  setting.Password,
+		FromEmail: setting.FromEmail,
+		FromName:  setting.FromName,
+		Security:  storepb.WorkspaceSmtpSetting_Security(setting.Security),
+	}
+}
+
+func convertWorkspacePasswordPolicySettingFromStore(setting *storepb.WorkspacePasswordPolicySetting) *apiv2pb.WorkspacePasswordPolicySetting {
+	if setting == nil {
+		return nil
+	}
+	return &apiv2pb.WorkspacePasswordPolicySetting{
+		MinLength:        setting.MinLength,
+		RequireUppercase: setting.RequireUppercase,
+		RequireLowercase: setting.RequireLowercase,
+		RequireDigit:     setting.RequireDigit,
+		RequireSymbol:    setting.RequireSymbol,
+		ExpiryDays:       setting.ExpiryDays,
+	}
+}
+
+func convertWorkspacePasswordPolicySettingToStore(setting *apiv2pb.WorkspacePasswordPolicySetting) *storepb.WorkspacePasswordPolicySetting {
+	if setting == nil {
+		return nil
+	}
+	return &storepb.WorkspacePasswordPolicySetting{
+		MinLength:        setting.MinLength,
+		RequireUppercase: setting.RequireUppercase,
+		RequireLowercase: setting.RequireLowercase,
+		RequireDigit:     setting.RequireDigit,
+		RequireSymbol:    setting.RequireSymbol,
+		ExpiryDays:       setting.ExpiryDays,
+	}
+}
+
+// checkManageWorkspacePermission returns an error if the current user cannot manage the workspace.
+func (s *APIV2Service) checkManageWorkspacePermission(ctx context.Context) error {
+	user, err := getCurrentUser(ctx, s.Store)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get current user: %v", err)
+	}
+	if user == nil {
+		return status.Errorf(codes.Unauthenticated, "user not found")
+	}
+	evaluator, err := auth.GetEvaluator(ctx, s.Store)
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to get permissions: %v", err)
+	}
+	if !evaluator.HasPermission(user, auth.PermissionManageWorkspace) {
+		return status.Errorf(codes.PermissionDenied, "permission denied")
 	}
+	return nil
 }
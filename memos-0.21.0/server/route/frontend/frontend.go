@@ -118,7 +118,8 @@ Sitemap: %s/sitemap.xml`, instanceURL, instanceURL)
 func generateMemoMetadata(memo *store.Memo, creator *store.User) *Metadata {
 	metadata := getDefaultMetadata()
 	metadata.Title = fmt.Sprintf("%s(@%s) on Memos", creator.Nickname, creator.Username)
-	if memo.Visibility == store.Public {
+	// The content of encrypted memos is an envelope the server cannot read.
+	if memo.Visibility == store.Public && !memo.Encrypted {
 		tokens := tokenizer.Tokenize(memo.Content)
 		nodes, _ := parser.Parse(tokens)
 		description := renderer.NewStringRenderer().Render(nodes)
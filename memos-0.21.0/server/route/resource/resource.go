@@ -18,6 +18,7 @@ import (
 
 	"github.com/usememos/memos/internal/util"
 	"github.com/usememos/memos/server/profile"
+	"github.com/usememos/memos/server/route/api/auth"
 	"github.com/usememos/memos/store"
 )
 
@@ -32,12 +33,14 @@ const (
 type ResourceService struct {
 	Profile *profile.Profile
 	Store   *store.Store
+	Secret  string
 }
 
-func NewResourceService(profile *profile.Profile, store *store.Store) *ResourceService {
+func NewResourceService(profile *profile.Profile, store *store.Store, secret string) *ResourceService {
 	return &ResourceService{
 		Profile: profile,
 		Store:   store,
+		Secret:  secret,
 	}
 }
 
@@ -59,6 +62,8 @@ func (s *ResourceService) streamResource(c echo.Context) error {
 	if resource == nil {
 		return echo.NewHTTPError(http.StatusNotFound, fmt.Sprintf("Resource not found: %s", uid))
 	}
+	// The resources of encrypted memos are encrypted by the client as well, and served as they are.
+	encrypted := false
 	// Check the related memo visibility.
 	if resource.MemoID != nil {
 		memo, err := s.Store.GetMemo(ctx, &store.FindMemo{
@@ -67,9 +72,28 @@ func (s *ResourceService) streamResource(c echo.Context) error {
 		if err != nil {
 			return echo.NewHTTPError(http.StatusInternalServerError, fmt.Sprintf("Failed to find memo by ID: %v", resource.MemoID)).SetInternal(err)
 		}
-		if memo != nil && memo.Visibility != store.Public {
-			userID, ok := c.Get(userIDContextKey).(int32)
-			if !ok || (memo.Visibility == store.Private && userID != resource.CreatorID) {
+		if memo != nil {
+			encrypted = memo.Encrypted
+			var user *store.User
+			if userID, ok := c.Get(userIDContextKey).(int32); ok {
+				user, err = s.Store.GetUser(ctx, &store.FindUser{
+					ID: &userID,
+				})
+				if err != nil {
+					return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find user").SetInternal(err)
+				}
+			}
+			canView, err := auth.CanAccessMemo(ctx, s.Store, user, memo, auth.MemoAccessView)
+			if err != nil {
+				return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check memo access").SetInternal(err)
+			}
+			if !canView {
+				canView, err = s.checkShareLink(c, memo)
+				if err != nil {
+					return echo.NewHTTPError(http.StatusInternalServerError, "Failed to check share link").SetInternal(err)
+				}
+			}
+			if !canView {
 				return echo.NewHTTPError(http.StatusUnauthorized, "Resource visibility not match")
 			}
 		}
@@ -93,7 +117,7 @@ func (s *ResourceService) streamResource(c echo.Context) error {
 		}
 	}
 
-	if c.QueryParam("thumbnail") == "1" && util.HasPrefixes(resource.Type, "image/png", "image/jpeg") {
+	if c.QueryParam("thumbnail") == "1" && !encrypted && util.HasPrefixes(resource.Type, "image/png", "image/jpeg") {
 		ext := filepath.Ext(resource.Filename)
 		thumbnailPath := filepath.Join(s.Profile.Data, thumbnailImagePath, fmt.Sprintf("%d%s", resource.ID, ext))
 		thumbnailBlob, err := getOrGenerateThumbnailImage(blob, thumbnailPath)
@@ -117,6 +141,25 @@ func (s *ResourceService) streamResource(c echo.Context) error {
 	return c.Stream(http.StatusOK, resourceType, bytes.NewReader(blob))
 }
 
+// checkShareLink returns true if the request carries a valid share link of the memo,
+// along with the share key for password protected links and links with max views.
+func (s *ResourceService) checkShareLink(c echo.Context, memo *store.Memo) (bool, error) {
+	token := c.QueryParam("share")
+	if token == "" {
+		return false, nil
+	}
+	link, err := s.Store.GetMemoShareLink(c.Request().Context(), &store.FindMemoShareLink{
+		Token: &token,
+	})
+	if err != nil {
+		return false, err
+	}
+	if link == nil {
+		return false, nil
+	}
+	return auth.CanViewMemoResourcesByShareLink(link, memo, c.QueryParam("share_key"), []byte(s.Secret), time.Now()), nil
+}
+
 var availableGeneratorAmount int32 = 32
 
 func getOrGenerateThumbnailImage(srcBlob []byte, dstPath string) ([]byte, error) {
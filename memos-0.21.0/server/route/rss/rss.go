@@ -23,15 +23,21 @@ const (
 	maxRSSItemTitleLength = 128
 )
 
+// ApplyMemoViewFunc adds the filter and the order of the view to the find of the memos.
+// The filters are defined by the API, which provides the function.
+type ApplyMemoViewFunc func(find *store.FindMemo, view *store.MemoView, displayWithUpdatedTs bool) error
+
 type RSSService struct {
-	Profile *profile.Profile
-	Store   *store.Store
+	Profile       *profile.Profile
+	Store         *store.Store
+	ApplyMemoView ApplyMemoViewFunc
 }
 
-func NewRSSService(profile *profile.Profile, store *store.Store) *RSSService {
+func NewRSSService(profile *profile.Profile, store *store.Store, applyMemoView ApplyMemoViewFunc) *RSSService {
 	return &RSSService{
-		Profile: profile,
-		Store:   store,
+		Profile:       profile,
+		Store:         store,
+		ApplyMemoView: applyMemoView,
 	}
 }
 
@@ -43,10 +49,14 @@ func (s *RSSService) RegisterRoutes(g *echo.Group) {
 func (s *RSSService) GetExploreRSS(c echo.Context) error {
 	ctx := c.Request().Context()
 	normalStatus := store.Normal
+	// Only public memos are listed, never the ones shared with specific users or groups.
 	memoFind := store.FindMemo{
 		RowStatus:      &normalStatus,
 		VisibilityList: []store.Visibility{store.Public},
 	}
+	if err := s.applyView(c, &memoFind); err != nil {
+		return err
+	}
 	memoList, err := s.Store.ListMemos(ctx, &memoFind)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").SetInternal(err)
@@ -80,6 +90,9 @@ func (s *RSSService) GetUserRSS(c echo.Context) error {
 		RowStatus:      &normalStatus,
 		VisibilityList: []store.Visibility{store.Public},
 	}
+	if err := s.applyView(c, &memoFind); err != nil {
+		return err
+	}
 	memoList, err := s.Store.ListMemos(ctx, &memoFind)
 	if err != nil {
 		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find memo list").SetInternal(err)
@@ -94,6 +107,33 @@ func (s *RSSService) GetUserRSS(c echo.Context) error {
 	return c.String(http.StatusOK, rss)
 }
 
+// applyView lists the memos of the view of the view query parameter, such as ?view=views/1, if it is set.
+// The feeds are public, so only the views shared with the workspace can be used, and they still only list public memos.
+func (s *RSSService) applyView(c echo.Context, memoFind *store.FindMemo) error {
+	name := c.QueryParam("view")
+	if name == "" {
+		return nil
+	}
+	id, err := util.ConvertStringToInt32(strings.TrimPrefix(name, "views/"))
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid view name").SetInternal(err)
+	}
+	view, err := s.Store.GetMemoView(c.Request().Context(), &store.FindMemoView{
+		ID: &id,
+	})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusInternalServerError, "Failed to find view").SetInternal(err)
+	}
+	if view == nil || view.Visibility != store.MemoViewWorkspace {
+		return echo.NewHTTPError(http.StatusNotFound, "View not found")
+	}
+	// The feeds show the creation times of the memos, which are the display times of the view.
+	if err := s.ApplyMemoView(memoFind, view, false); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, "Invalid view").SetInternal(err)
+	}
+	return nil
+}
+
 func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*store.Memo, baseURL string) (string, error) {
 	feed := &feeds.Feed{
 		Title:       "Memos",
@@ -102,10 +142,17 @@ func (s *RSSService) generateRSSFromMemoList(ctx context.Context, memoList []*st
 		Created:     time.Now(),
 	}
 
-	var itemCountLimit = util.Min(len(memoList), maxRSSItemCount)
+	readableMemoList := []*store.Memo{}
+	for _, memo := range memoList {
+		// The server cannot read the content of encrypted memos, so they are left out of the feed.
+		if !memo.Encrypted {
+			readableMemoList = append(readableMemoList, memo)
+		}
+	}
+	var itemCountLimit = util.Min(len(readableMemoList), maxRSSItemCount)
 	feed.Items = make([]*feeds.Item, itemCountLimit)
 	for i := 0; i < itemCountLimit; i++ {
-		memo := memoList[i]
+		memo := readableMemoList[i]
 		description, err := getRSSItemDescription(memo.Content)
 		if err != nil {
 			return "", err
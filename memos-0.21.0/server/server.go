@@ -11,12 +11,15 @@ import (
 	"github.com/labstack/echo/v4"
 	"github.com/pkg/errors"
 
+	"github.com/usememos/memos/internal/ratelimit"
 	"github.com/usememos/memos/plugin/telegram"
 	"github.com/usememos/memos/server/integration"
 	"github.com/usememos/memos/server/profile"
+	"github.com/usememos/memos/server/route/api/auth"
 	apiv1 "github.com/usememos/memos/server/route/api/v1"
 	apiv2 "github.com/usememos/memos/server/route/api/v2"
 	"github.com/usememos/memos/server/route/frontend"
+	"github.com/usememos/memos/server/route/rss"
 	versionchecker "github.com/usememos/memos/server/service/version_checker"
 	"github.com/usememos/memos/store"
 )
@@ -77,17 +80,37 @@ func NewServer(ctx context.Context, profile *profile.Profile, store *store.Store
 		frontendService.Serve(ctx, e)
 	}
 
+	// The client IPs keying the rate limits and the sign-in lockouts are only taken from the headers of the trusted proxies.
+	trustedProxies, err := auth.ParseTrustedProxies(profile.TrustedProxies)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to configure trusted proxies")
+	}
+	e.IPExtractor = func(request *http.Request) string {
+		return trustedProxies.ClientIP(request.RemoteAddr, request.Header.Values(echo.HeaderXForwardedFor))
+	}
+
+	// The rate limiter and login guard are shared by both API versions.
+	rateLimiter := ratelimit.NewLimiter(profile.RateLimit, profile.RateLimitBurst)
+	loginGuard := ratelimit.NewLoginGuard(profile.LoginMaxAttempts, profile.LoginLockoutDuration)
+	proxyAuthenticator, err := auth.NewProxyAuthenticator(profile, store)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to configure auth proxy")
+	}
+
 	// Register API v1 endpoints.
 	rootGroup := e.Group("")
-	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store, s.telegramBot)
+	apiV1Service := apiv1.NewAPIV1Service(s.Secret, profile, store, s.telegramBot, rateLimiter, loginGuard, proxyAuthenticator)
 	apiV1Service.Register(rootGroup)
 
-	apiV2Service := apiv2.NewAPIV2Service(s.Secret, profile, store, s.Profile.Port+1)
+	apiV2Service := apiv2.NewAPIV2Service(s.Secret, profile, store, s.Profile.Port+1, rateLimiter, loginGuard, proxyAuthenticator, trustedProxies)
 	// Register gRPC gateway as api v2.
 	if err := apiV2Service.RegisterGateway(ctx, e); err != nil {
 		return nil, errors.Wrap(err, "failed to register gRPC gateway")
 	}
 
+	// Register rss public routes, with the memo views of api v2.
+	rss.NewRSSService(profile, store, apiv2.ApplyMemoView).RegisterRoutes(rootGroup)
+
 	return s, nil
 }
 
@@ -11,6 +11,8 @@ type ActivityType string
 const (
 	ActivityTypeMemoComment   ActivityType = "MEMO_COMMENT"
 	ActivityTypeVersionUpdate ActivityType = "VERSION_UPDATE"
+	ActivityTypeLoginLockout  ActivityType = "LOGIN_LOCKOUT"
+	ActivityTypeTaskOverdue   ActivityType = "TASK_OVERDUE"
 )
 
 func (t ActivityType) String() string {
@@ -21,6 +23,7 @@ type ActivityLevel string
 
 const (
 	ActivityLevelInfo ActivityLevel = "INFO"
+	ActivityLevelWarn ActivityLevel = "WARN"
 )
 
 func (l ActivityLevel) String() string {
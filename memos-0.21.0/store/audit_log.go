@@ -0,0 +1,141 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+type AuditAction string
+
+const (
+	AuditActionSignIn                 AuditAction = "SIGN_IN"
+	AuditActionSignInFailed           AuditAction = "SIGN_IN_FAILED"
+	AuditActionSignOut                AuditAction = "SIGN_OUT"
+	AuditActionUserCreate             AuditAction = "USER_CREATE"
+	AuditActionUserUpdate             AuditAction = "USER_UPDATE"
+	AuditActionUserRoleUpdate         AuditAction = "USER_ROLE_UPDATE"
+	AuditActionUserDelete             AuditAction = "USER_DELETE"
+	AuditActionUserSuspend            AuditAction = "USER_SUSPEND"
+	AuditActionUserUnsuspend          AuditAction = "USER_UNSUSPEND"
+	AuditActionUserImport             AuditAction = "USER_IMPORT"
+	AuditActionUserExport             AuditAction = "USER_EXPORT"
+	AuditActionUserDataExport         AuditAction = "USER_DATA_EXPORT"
+	AuditActionAccessTokenCreate      AuditAction = "ACCESS_TOKEN_CREATE"
+	AuditActionAccessTokenDelete      AuditAction = "ACCESS_TOKEN_DELETE"
+	AuditActionSessionRevoke          AuditAction = "SESSION_REVOKE"
+	AuditActionPasswordReset          AuditAction = "PASSWORD_RESET"
+	AuditActionPasswordChange         AuditAction = "PASSWORD_CHANGE"
+	AuditActionPasswordChangeRequire  AuditAction = "PASSWORD_CHANGE_REQUIRE"
+	AuditActionEmailVerify            AuditAction = "EMAIL_VERIFY"
+	AuditActionEmailChange            AuditAction = "EMAIL_CHANGE"
+	AuditActionLoginLockoutClear      AuditAction = "LOGIN_LOCKOUT_CLEAR"
+	AuditActionWorkspaceSettingUpdate AuditAction = "WORKSPACE_SETTING_UPDATE"
+	AuditActionIdentityProviderCreate AuditAction = "IDENTITY_PROVIDER_CREATE"
+	AuditActionIdentityProviderUpdate AuditAction = "IDENTITY_PROVIDER_UPDATE"
+	AuditActionIdentityProviderDelete AuditAction = "IDENTITY_PROVIDER_DELETE"
+	AuditActionStorageCreate          AuditAction = "STORAGE_CREATE"
+	AuditActionStorageUpdate          AuditAction = "STORAGE_UPDATE"
+	AuditActionStorageDelete          AuditAction = "STORAGE_DELETE"
+	AuditActionInvitationCreate       AuditAction = "INVITATION_CREATE"
+	AuditActionInvitationRevoke       AuditAction = "INVITATION_REVOKE"
+	AuditActionInvitationUse          AuditAction = "INVITATION_USE"
+)
+
+func (a AuditAction) String() string {
+	return string(a)
+}
+
+type AuditLog struct {
+	ID        int32
+	CreatedTs int64
+
+	// ActorID is the user performing the action, zero for anonymous requests.
+	ActorID int32
+	Action  AuditAction
+	// Target is the name of the resource the action applies to, e.g. "users/3".
+	Target  string
+	IP      string
+	Payload *storepb.AuditLogPayload
+}
+
+type FindAuditLog struct {
+	ID              *int32
+	ActorID         *int32
+	Action          *AuditAction
+	Target          *string
+	CreatedTsAfter  *int64
+	CreatedTsBefore *int64
+
+	// Pagination
+	Limit  *int
+	Offset *int
+}
+
+// CreateAuditLog saves the audit log, and appends it to the audit log file if configured.
+func (s *Store) CreateAuditLog(ctx context.Context, create *AuditLog) (*AuditLog, error) {
+	auditLog, err := s.driver.CreateAuditLog(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	if s.Profile != nil && s.Profile.AuditLogFile != "" {
+		if err := s.appendAuditLogFile(auditLog); err != nil {
+			return nil, errors.Wrap(err, "failed to append audit log file")
+		}
+	}
+	return auditLog, nil
+}
+
+func (s *Store) ListAuditLogs(ctx context.Context, find *FindAuditLog) ([]*AuditLog, error) {
+	return s.driver.ListAuditLogs(ctx, find)
+}
+
+// auditLogLine is the format of the lines of the audit log file.
+type auditLogLine struct {
+	ID        int32  `json:"id"`
+	CreatedTs int64  `json:"createdTs"`
+	ActorID   int32  `json:"actorId"`
+	Action    string `json:"action"`
+	Target    string `json:"target"`
+	IP        string `json:"ip"`
+	Before    string `json:"before,omitempty"`
+	After     string `json:"after,omitempty"`
+	Message   string `json:"message,omitempty"`
+}
+
+func (s *Store) appendAuditLogFile(auditLog *AuditLog) error {
+	line := auditLogLine{
+		ID:        auditLog.ID,
+		CreatedTs: auditLog.CreatedTs,
+		ActorID:   auditLog.ActorID,
+		Action:    auditLog.Action.String(),
+		Target:    auditLog.Target,
+		IP:        auditLog.IP,
+	}
+	if auditLog.Payload != nil {
+		line.Before = auditLog.Payload.Before
+		line.After = auditLog.Payload.After
+		line.Message = auditLog.Payload.Message
+	}
+	bytes, err := json.Marshal(line)
+	if err != nil {
+		return err
+	}
+
+	s.auditLogFileMutex.Lock()
+	defer s.auditLogFileMutex.Unlock()
+	// Open the file for every line so that it can be rotated by external tools.
+	file, err := os.OpenFile(s.Profile.AuditLogFile, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := file.Write(append(bytes, '\n')); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
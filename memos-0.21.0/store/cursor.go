@@ -0,0 +1,121 @@
+package store
+
+import (
+	"github.com/usememos/memos/plugin/filter"
+)
+
+// Cursor is the position of the last row of a page in the order of a listing, the next page lists the rows after it.
+// The listings are sorted by a time then by id in the same direction, and the memos can be sorted by pinned first.
+// Unlike offsets, the cursors keep their position when rows are added or removed before them.
+type Cursor struct {
+	// Pinned is only used for the memos sorted by pinned first.
+	Pinned bool
+	// Ts is the time the rows are sorted by, unused for the webhooks which are only sorted by id.
+	Ts int64
+	ID int32
+}
+
+// CursorKey is a field a listing is sorted by, with its value at the cursor.
+type CursorKey struct {
+	Field string
+	Value any
+	Desc  bool
+}
+
+// GetCursorFilter returns the condition that the rows are after the cursor in the order of the keys,
+// such as "created_ts < ? OR (created_ts = ? AND id < ?)".
+func GetCursorFilter(keys ...CursorKey) filter.Expr {
+	exprs := []filter.Expr{}
+	for i, key := range keys {
+		conditions := []filter.Expr{}
+		for _, previous := range keys[:i] {
+			conditions = append(conditions, &filter.CompareExpr{Field: previous.Field, Operator: filter.OperatorEqual, Value: previous.Value})
+		}
+		operator := filter.OperatorGreater
+		if key.Desc {
+			operator = filter.OperatorLess
+		}
+		conditions = append(conditions, &filter.CompareExpr{Field: key.Field, Operator: operator, Value: key.Value})
+		exprs = append(exprs, filter.And(conditions...))
+	}
+	return &filter.OrExpr{Exprs: exprs}
+}
+
+// The cursor filters below follow the orders of the listings in the drivers, and are nil without a cursor.
+
+// GetMemoCursorFilter returns the condition on the memos after the cursor of the find,
+// which is ignored in random order and in the order of a property.
+func GetMemoCursorFilter(find *FindMemo) filter.Expr {
+	if find.Cursor == nil || find.Random || find.OrderByProperty != "" {
+		return nil
+	}
+	keys := []CursorKey{}
+	if find.OrderByPinned {
+		keys = append(keys, CursorKey{Field: "pinned", Value: find.Cursor.Pinned, Desc: true})
+	}
+	tsField := "created_ts"
+	if find.OrderByUpdatedTs {
+		tsField = "updated_ts"
+	}
+	keys = append(keys,
+		CursorKey{Field: tsField, Value: find.Cursor.Ts, Desc: !find.OrderByTimeAsc},
+		CursorKey{Field: "id", Value: find.Cursor.ID, Desc: !find.OrderByTimeAsc},
+	)
+	return GetCursorFilter(keys...)
+}
+
+// GetMemoCursor returns the cursor of the memo in the order of the find.
+func GetMemoCursor(find *FindMemo, memo *Memo) *Cursor {
+	cursor := &Cursor{
+		Pinned: memo.Pinned,
+		Ts:     memo.CreatedTs,
+		ID:     memo.ID,
+	}
+	if find.OrderByUpdatedTs {
+		cursor.Ts = memo.UpdatedTs
+	}
+	return cursor
+}
+
+// GetResourceCursorFilter returns the condition on the resources after the cursor of the find.
+func GetResourceCursorFilter(find *FindResource) filter.Expr {
+	if find.Cursor == nil {
+		return nil
+	}
+	return GetCursorFilter(
+		CursorKey{Field: "updated_ts", Value: find.Cursor.Ts, Desc: true},
+		CursorKey{Field: "id", Value: find.Cursor.ID, Desc: true},
+	)
+}
+
+// GetUserCursorFilter returns the condition on the users after the cursor of the find, which is ignored in random order.
+func GetUserCursorFilter(find *FindUser) filter.Expr {
+	if find.Cursor == nil || find.Random {
+		return nil
+	}
+	return GetCursorFilter(
+		CursorKey{Field: "created_ts", Value: find.Cursor.Ts, Desc: true},
+		CursorKey{Field: "id", Value: find.Cursor.ID, Desc: true},
+	)
+}
+
+// GetInboxCursorFilter returns the condition on the inboxes after the cursor of the find.
+func GetInboxCursorFilter(find *FindInbox) filter.Expr {
+	if find.Cursor == nil {
+		return nil
+	}
+	return GetCursorFilter(
+		CursorKey{Field: "created_ts", Value: find.Cursor.Ts, Desc: true},
+		CursorKey{Field: "id", Value: find.Cursor.ID, Desc: true},
+	)
+}
+
+// GetWebhookCursorFilter returns the condition on the webhooks after the cursor of the find.
+func GetWebhookCursorFilter(find *FindWebhook) filter.Expr {
+	if find.Cursor == nil {
+		return nil
+	}
+	return GetCursorFilter(
+		CursorKey{Field: "id", Value: find.Cursor.ID, Desc: true},
+	)
+}
@@ -8,13 +8,14 @@ import (
 
 	"github.com/pkg/errors"
 
+	"github.com/usememos/memos/plugin/filter"
 	"github.com/usememos/memos/store"
 )
 
 func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, error) {
-	fields := []string{"`uid`", "`creator_id`", "`content`", "`visibility`"}
-	placeholder := []string{"?", "?", "?", "?"}
-	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility}
+	fields := []string{"`uid`", "`creator_id`", "`content`", "`visibility`", "`group_id`", "`encrypted`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?"}
+	args := []any{create.UID, create.CreatorID, create.Content, create.Visibility, create.GroupID, create.Encrypted}
 
 	stmt := "INSERT INTO `memo` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
 	result, err := d.db.ExecContext(ctx, stmt, args...)
@@ -37,6 +38,27 @@ func (d *DB) CreateMemo(ctx context.Context, create *store.Memo) (*store.Memo, e
 	return memo, nil
 }
 
+// memoFilterColumns are the columns of the fields of the memo filters.
+var memoFilterColumns = map[string]string{
+	"id":                "`memo`.`id`",
+	"uid":               "`memo`.`uid`",
+	"creator_id":        "`memo`.`creator_id`",
+	"created_ts":        "UNIX_TIMESTAMP(`memo`.`created_ts`)",
+	"updated_ts":        "UNIX_TIMESTAMP(`memo`.`updated_ts`)",
+	"row_status":        "`memo`.`row_status`",
+	"content":           "`memo`.`content`",
+	"visibility":        "`memo`.`visibility`",
+	"group_id":          "`memo`.`group_id`",
+	"encrypted":         "`memo`.`encrypted`",
+	"pinned":            "IFNULL(`memo_organizer`.`pinned`, 0)",
+	"tag":               "EXISTS (SELECT 1 FROM `memo_tag` WHERE `memo_tag`.`memo_id` = `memo`.`id` AND `memo_tag`.`tag` %s)",
+	"has_resource":      "(CASE WHEN EXISTS (SELECT 1 FROM `resource` WHERE `resource`.`memo_id` = `memo`.`id`) THEN 1 ELSE 0 END)",
+	"has_task":          "(CASE WHEN EXISTS (SELECT 1 FROM `memo_task` WHERE `memo_task`.`memo_id` = `memo`.`id`) THEN 1 ELSE 0 END)",
+	"has_reference":     "(CASE WHEN EXISTS (SELECT 1 FROM `memo_relation` WHERE `memo_relation`.`memo_id` = `memo`.`id` AND `memo_relation`.`type` = 'REFERENCE') THEN 1 ELSE 0 END)",
+	"property.*":        "EXISTS (SELECT 1 FROM `memo_property` WHERE `memo_property`.`memo_id` = `memo`.`id` AND `memo_property`.`key` = %s AND `memo_property`.`value` %s)",
+	"property_number.*": "EXISTS (SELECT 1 FROM `memo_property` WHERE `memo_property`.`memo_id` = `memo`.`id` AND `memo_property`.`key` = %s AND `memo_property`.`number_value` %s)",
+}
+
 func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
 	where, having, args := []string{"1 = 1"}, []string{"1 = 1"}, []any{}
 
@@ -68,6 +90,7 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		for _, s := range v {
 			where, args = append(where, "`memo`.`content` LIKE ?"), append(args, "%"+s+"%")
 		}
+		where = append(where, "`memo`.`encrypted` = 0")
 	}
 	if v := find.VisibilityList; len(v) != 0 {
 		placeholder := []string{}
@@ -80,17 +103,51 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 	if find.ExcludeComments {
 		having = append(having, "`parent_id` IS NULL")
 	}
+	if v := find.GroupID; v != nil {
+		where, args = append(where, "`memo`.`group_id` = ?"), append(args, *v)
+	}
+	if v := find.VisibleToUserID; v != nil {
+		where = append(where, "(`memo`.`creator_id` = ? OR `memo`.`visibility` IN ('PUBLIC', 'PROTECTED') OR `memo`.`id` IN ("+
+			"SELECT `memo_id` FROM `memo_grant` WHERE (`grantee_type` = 'USER' AND `grantee_id` = ?) "+
+			"OR (`grantee_type` = 'GROUP' AND `grantee_id` IN (SELECT `group_id` FROM `user_group_member` WHERE `user_id` = ?))) "+
+			"OR `memo`.`group_id` IN (SELECT `group_id` FROM `user_group_member` WHERE `user_id` = ?))")
+		args = append(args, *v, *v, *v, *v)
+	}
+	if v := filter.And(find.Filter, store.GetMemoCursorFilter(find)); v != nil {
+		condition, filterArgs, err := filter.MySQL.ConvertToSQL(v, memoFilterColumns, len(args))
+		if err != nil {
+			return nil, err
+		}
+		where, args = append(where, condition), append(args, filterArgs...)
+	}
 
+	timeOrder := "DESC"
+	if find.OrderByTimeAsc {
+		timeOrder = "ASC"
+	}
 	orders := []string{}
 	if find.OrderByPinned {
 		orders = append(orders, "`pinned` DESC")
 	}
+	if v := find.OrderByProperty; v != "" {
+		direction := "ASC"
+		if find.OrderByPropertyDesc {
+			direction = "DESC"
+		}
+		propertyColumn := "(SELECT `memo_property`.`%s` FROM `memo_property` WHERE `memo_property`.`memo_id` = `memo`.`id` AND `memo_property`.`key` = ?)"
+		orders = append(orders,
+			fmt.Sprintf(propertyColumn, "value")+" IS NULL",
+			fmt.Sprintf(propertyColumn, "number_value")+" "+direction,
+			fmt.Sprintf(propertyColumn, "value")+" "+direction,
+		)
+		args = append(args, v, v, v)
+	}
 	if find.OrderByUpdatedTs {
-		orders = append(orders, "`updated_ts` DESC")
+		orders = append(orders, "`updated_ts` "+timeOrder)
 	} else {
-		orders = append(orders, "`created_ts` DESC")
+		orders = append(orders, "`created_ts` "+timeOrder)
 	}
-	orders = append(orders, "`id` DESC")
+	orders = append(orders, "`id` "+timeOrder)
 	if find.Random {
 		orders = append(orders, "RAND()")
 	}
@@ -103,6 +160,8 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 		"UNIX_TIMESTAMP(`memo`.`updated_ts`) AS `updated_ts`",
 		"`memo`.`row_status` AS `row_status`",
 		"`memo`.`visibility` AS `visibility`",
+		"`memo`.`group_id` AS `group_id`",
+		"`memo`.`encrypted` AS `encrypted`",
 		"IFNULL(`memo_organizer`.`pinned`, 0) AS `pinned`",
 		"`memo_relation`.`related_memo_id` AS `parent_id`",
 	}
@@ -135,6 +194,8 @@ func (d *DB) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo
 			&memo.UpdatedTs,
 			&memo.RowStatus,
 			&memo.Visibility,
+			&memo.GroupID,
+			&memo.Encrypted,
 			&memo.Pinned,
 			&memo.ParentID,
 		}
@@ -187,6 +248,9 @@ func (d *DB) UpdateMemo(ctx context.Context, update *store.UpdateMemo) error {
 	if v := update.Visibility; v != nil {
 		set, args = append(set, "`visibility` = ?"), append(args, *v)
 	}
+	if v := update.Encrypted; v != nil {
+		set, args = append(set, "`encrypted` = ?"), append(args, *v)
+	}
 	args = append(args, update.ID)
 
 	stmt := "UPDATE `memo` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
@@ -0,0 +1,110 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) UpsertMemoGrant(ctx context.Context, upsert *store.MemoGrant) (*store.MemoGrant, error) {
+	stmt := "INSERT INTO `memo_grant` (`memo_id`, `grantee_type`, `grantee_id`, `role`) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE `role` = ?"
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.MemoID, upsert.GranteeType, upsert.GranteeID, upsert.Role, upsert.Role); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListMemoGrants(ctx, &store.FindMemoGrant{
+		MemoID:      &upsert.MemoID,
+		GranteeType: &upsert.GranteeType,
+		GranteeID:   &upsert.GranteeID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("unexpected memo grant count: %d", len(list))
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListMemoGrants(ctx context.Context, find *store.FindMemoGrant) ([]*store.MemoGrant, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	if v := find.GranteeType; v != nil {
+		where, args = append(where, "`grantee_type` = ?"), append(args, *v)
+	}
+	if v := find.GranteeID; v != nil {
+		where, args = append(where, "`grantee_id` = ?"), append(args, *v)
+	}
+
+	query := "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), `memo_id`, `grantee_type`, `grantee_id`, `role` FROM `memo_grant` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoGrant{}
+	for rows.Next() {
+		grant := &store.MemoGrant{}
+		if err := rows.Scan(
+			&grant.ID,
+			&grant.CreatedTs,
+			&grant.MemoID,
+			&grant.GranteeType,
+			&grant.GranteeID,
+			&grant.Role,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, grant)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteMemoGrant(ctx context.Context, delete *store.DeleteMemoGrant) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := delete.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	if v := delete.GranteeType; v != nil {
+		where, args = append(where, "`grantee_type` = ?"), append(args, *v)
+	}
+	if v := delete.GranteeID; v != nil {
+		where, args = append(where, "`grantee_id` = ?"), append(args, *v)
+	}
+	result, err := d.db.ExecContext(ctx, "DELETE FROM `memo_grant` WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func vacuumMemoGrant(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `memo_grant` WHERE `memo_id` NOT IN (SELECT `id` FROM `memo`) " +
+		"OR (`grantee_type` = 'USER' AND `grantee_id` NOT IN (SELECT `id` FROM `user`)) " +
+		"OR (`grantee_type` = 'GROUP' AND `grantee_id` NOT IN (SELECT `id` FROM `user_group`))"
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+	return nil
+}
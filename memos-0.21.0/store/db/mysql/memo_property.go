@@ -0,0 +1,76 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) SetMemoProperties(ctx context.Context, set *store.SetMemoProperties) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `memo_property` WHERE `memo_id` = ?", set.MemoID); err != nil {
+		return err
+	}
+	for _, property := range set.Properties {
+		stmt := "INSERT INTO `memo_property` (`memo_id`, `key`, `type`, `value`, `number_value`) VALUES (?, ?, ?, ?, ?)"
+		if _, err := tx.ExecContext(ctx, stmt, set.MemoID, property.Key, property.Type, property.Value, property.NumberValue()); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
+func (d *DB) ListMemoProperties(ctx context.Context, find *store.FindMemoProperty) ([]*store.MemoProperty, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	if v := find.MemoIDList; len(v) != 0 {
+		placeholder := []string{}
+		for _, memoID := range v {
+			placeholder, args = append(placeholder, "?"), append(args, memoID)
+		}
+		where = append(where, "`memo_id` IN ("+strings.Join(placeholder, ", ")+")")
+	}
+
+	query := "SELECT `memo_id`, `key`, `type`, `value` FROM `memo_property` WHERE " + strings.Join(where, " AND ") + " ORDER BY `memo_id` DESC, `key` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoProperty{}
+	for rows.Next() {
+		property := &store.MemoProperty{}
+		if err := rows.Scan(
+			&property.MemoID,
+			&property.Key,
+			&property.Type,
+			&property.Value,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, property)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func vacuumMemoProperty(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `memo_property` WHERE `memo_id` NOT IN (SELECT `id` FROM `memo`)"
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -9,13 +9,15 @@ import (
 )
 
 func (d *DB) UpsertMemoRelation(ctx context.Context, create *store.MemoRelation) (*store.MemoRelation, error) {
-	stmt := "INSERT INTO `memo_relation` (`memo_id`, `related_memo_id`, `type`) VALUES (?, ?, ?)"
+	stmt := "INSERT INTO `memo_relation` (`memo_id`, `related_memo_id`, `type`, `source`) VALUES (?, ?, ?, ?) ON DUPLICATE KEY UPDATE `source` = ?"
 	_, err := d.db.ExecContext(
 		ctx,
 		stmt,
 		create.MemoID,
 		create.RelatedMemoID,
 		create.Type,
+		create.Source,
+		create.Source,
 	)
 	if err != nil {
 		return nil, err
@@ -25,6 +27,7 @@ func (d *DB) UpsertMemoRelation(ctx context.Context, create *store.MemoRelation)
 		MemoID:        create.MemoID,
 		RelatedMemoID: create.RelatedMemoID,
 		Type:          create.Type,
+		Source:        create.Source,
 	}
 
 	return &memoRelation, nil
@@ -38,11 +41,28 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 	if find.RelatedMemoID != nil {
 		where, args = append(where, "`related_memo_id` = ?"), append(args, find.RelatedMemoID)
 	}
+	if v := find.MemoIDList; len(v) != 0 {
+		placeholder := []string{}
+		for _, memoID := range v {
+			placeholder, args = append(placeholder, "?"), append(args, memoID)
+		}
+		where = append(where, "`memo_id` IN ("+strings.Join(placeholder, ", ")+")")
+	}
+	if v := find.RelatedMemoIDList; len(v) != 0 {
+		placeholder := []string{}
+		for _, memoID := range v {
+			placeholder, args = append(placeholder, "?"), append(args, memoID)
+		}
+		where = append(where, "`related_memo_id` IN ("+strings.Join(placeholder, ", ")+")")
+	}
 	if find.Type != nil {
 		where, args = append(where, "`type` = ?"), append(args, find.Type)
 	}
+	if find.Source != nil {
+		where, args = append(where, "`source` = ?"), append(args, find.Source)
+	}
 
-	rows, err := d.db.QueryContext(ctx, "SELECT `memo_id`, `related_memo_id`, `type` FROM `memo_relation` WHERE "+strings.Join(where, " AND "), args...)
+	rows, err := d.db.QueryContext(ctx, "SELECT `memo_id`, `related_memo_id`, `type`, `source` FROM `memo_relation` WHERE "+strings.Join(where, " AND "), args...)
 	if err != nil {
 		return nil, err
 	}
@@ -55,6 +75,7 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 			&memoRelation.MemoID,
 			&memoRelation.RelatedMemoID,
 			&memoRelation.Type,
+			&memoRelation.Source,
 		); err != nil {
 			return nil, err
 		}
@@ -79,6 +100,9 @@ func (d *DB) DeleteMemoRelation(ctx context.Context, delete *store.DeleteMemoRel
 	if delete.Type != nil {
 		where, args = append(where, "`type` = ?"), append(args, delete.Type)
 	}
+	if delete.Source != nil {
+		where, args = append(where, "`source` = ?"), append(args, delete.Source)
+	}
 	stmt := "DELETE FROM `memo_relation` WHERE " + strings.Join(where, " AND ")
 	result, err := d.db.ExecContext(ctx, stmt, args...)
 	if err != nil {
@@ -0,0 +1,121 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoShareLink(ctx context.Context, create *store.MemoShareLink) (*store.MemoShareLink, error) {
+	fields := []string{"`creator_id`", "`memo_id`", "`token`", "`expires_ts`", "`max_views`", "`password_hash`"}
+	placeholder := []string{"?", "?", "?", "?", "?", "?"}
+	args := []any{create.CreatorID, create.MemoID, create.Token, create.ExpiresTs, create.MaxViews, create.PasswordHash}
+
+	stmt := "INSERT INTO `memo_share_link` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	rawID, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+	id := int32(rawID)
+	list, err := d.ListMemoShareLinks(ctx, &store.FindMemoShareLink{ID: &id})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("unexpected memo share link count: %d", len(list))
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListMemoShareLinks(ctx context.Context, find *store.FindMemoShareLink) ([]*store.MemoShareLink, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	if v := find.Token; v != nil {
+		where, args = append(where, "`token` = ?"), append(args, *v)
+	}
+
+	query := "SELECT `id`, `creator_id`, UNIX_TIMESTAMP(`created_ts`), `memo_id`, `token`, `expires_ts`, `max_views`, `view_count`, `password_hash` FROM `memo_share_link` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` DESC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoShareLink{}
+	for rows.Next() {
+		link := &store.MemoShareLink{}
+		if err := rows.Scan(
+			&link.ID,
+			&link.CreatorID,
+			&link.CreatedTs,
+			&link.MemoID,
+			&link.Token,
+			&link.ExpiresTs,
+			&link.MaxViews,
+			&link.ViewCount,
+			&link.PasswordHash,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, link)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) IncreaseMemoShareLinkViewCount(ctx context.Context, id int32) (bool, error) {
+	stmt := "UPDATE `memo_share_link` SET `view_count` = `view_count` + 1 WHERE `id` = ? AND (`max_views` = 0 OR `view_count` < `max_views`)"
+	result, err := d.db.ExecContext(ctx, stmt, id)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows == 1, nil
+}
+
+func (d *DB) DeleteMemoShareLink(ctx context.Context, delete *store.DeleteMemoShareLink) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := delete.MemoID; v != nil {
+		where, args = append(where, "`memo_id` = ?"), append(args, *v)
+	}
+	result, err := d.db.ExecContext(ctx, "DELETE FROM `memo_share_link` WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func vacuumMemoShareLink(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `memo_share_link` WHERE `memo_id` NOT IN (SELECT `id` FROM `memo`)"
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) SetMemoTags(ctx context.Context, set *store.SetMemoTags) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := setMemoTags(ctx, tx, set); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func setMemoTags(ctx context.Context, tx *sql.Tx, set *store.SetMemoTags) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `memo_tag` WHERE `memo_id` = ?", set.MemoID); err != nil {
+		return err
+	}
+	for _, tag := range set.Tags {
+		stmt := "INSERT IGNORE INTO `memo_tag` (`memo_id`, `tag`) VALUES (?, ?)"
+		if _, err := tx.ExecContext(ctx, stmt, set.MemoID, tag); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *DB) ListMemoTags(ctx context.Context, find *store.FindMemoTag) ([]*store.MemoTag, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "`memo_tag`.`memo_id` = ?"), append(args, *v)
+	}
+	if v := find.MemoIDList; len(v) != 0 {
+		placeholder := []string{}
+		for _, memoID := range v {
+			placeholder, args = append(placeholder, "?"), append(args, memoID)
+		}
+		where = append(where, "`memo_tag`.`memo_id` IN ("+strings.Join(placeholder, ", ")+")")
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.TagList; len(v) != 0 {
+		placeholder := []string{}
+		for _, tag := range v {
+			placeholder, args = append(placeholder, "?"), append(args, tag)
+		}
+		where = append(where, "`memo_tag`.`tag` IN ("+strings.Join(placeholder, ", ")+")")
+	}
+
+	query := "SELECT `memo_tag`.`memo_id`, `memo_tag`.`tag` FROM `memo_tag` " +
+		"INNER JOIN `memo` ON `memo`.`id` = `memo_tag`.`memo_id` " +
+		"WHERE " + strings.Join(where, " AND ") + " ORDER BY `memo_tag`.`memo_id` DESC, `memo_tag`.`tag` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTag{}
+	for rows.Next() {
+		memoTag := &store.MemoTag{}
+		if err := rows.Scan(&memoTag.MemoID, &memoTag.Tag); err != nil {
+			return nil, err
+		}
+		list = append(list, memoTag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) ListMemoTagCounts(ctx context.Context, find *store.FindMemoTagCount) ([]*store.MemoTagCount, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`memo`.`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "`memo`.`row_status` = ?"), append(args, *v)
+	}
+
+	query := "SELECT `memo_tag`.`tag`, COUNT(*) FROM `memo_tag` " +
+		"INNER JOIN `memo` ON `memo`.`id` = `memo_tag`.`memo_id` " +
+		"WHERE " + strings.Join(where, " AND ") + " GROUP BY `memo_tag`.`tag` ORDER BY `memo_tag`.`tag` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTagCount{}
+	for rows.Next() {
+		memoTagCount := &store.MemoTagCount{}
+		if err := rows.Scan(&memoTagCount.Tag, &memoTagCount.Count); err != nil {
+			return nil, err
+		}
+		list = append(list, memoTagCount)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func vacuumMemoTag(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `memo_tag` WHERE `memo_id` NOT IN (SELECT `id` FROM `memo`)"
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) SetMemoTasks(ctx context.Context, set *store.SetMemoTasks) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := setMemoTasks(ctx, tx, set); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func setMemoTasks(ctx context.Context, tx *sql.Tx, set *store.SetMemoTasks) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM `memo_task` WHERE `memo_id` = ?", set.MemoID); err != nil {
+		return err
+	}
+	for _, task := range set.Tasks {
+		stmt := "INSERT INTO `memo_task` (`memo_id`, `position`, `content`, `completed`, `due_ts`, `assignee_id`, `overdue_notified`) VALUES (?, ?, ?, ?, ?, ?, ?)"
+		if _, err := tx.ExecContext(ctx, stmt, set.MemoID, task.Position, task.Content, task.Completed, task.DueTs, task.AssigneeID, task.OverdueNotified); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *DB) ListMemoTasks(ctx context.Context, find *store.FindMemoTask) ([]*store.MemoTask, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "`memo_task`.`memo_id` = ?"), append(args, *v)
+	}
+	if v := find.Position; v != nil {
+		where, args = append(where, "`memo_task`.`position` = ?"), append(args, *v)
+	}
+	if v := find.CreatorOrAssigneeID; v != nil {
+		where, args = append(where, "(`memo`.`creator_id` = ? OR `memo_task`.`assignee_id` = ?)"), append(args, *v, *v)
+	}
+	if v := find.VisibleToUserID; v != nil {
+		where = append(where, "(`memo`.`creator_id` = ? OR `memo`.`visibility` IN ('PUBLIC', 'PROTECTED') OR `memo`.`id` IN ("+
+			"SELECT `memo_id` FROM `memo_grant` WHERE (`grantee_type` = 'USER' AND `grantee_id` = ?) "+
+			"OR (`grantee_type` = 'GROUP' AND `grantee_id` IN (SELECT `group_id` FROM `user_group_member` WHERE `user_id` = ?))) "+
+			"OR `memo`.`group_id` IN (SELECT `group_id` FROM `user_group_member` WHERE `user_id` = ?))")
+		args = append(args, *v, *v, *v, *v)
+	}
+	if v := find.Completed; v != nil {
+		where, args = append(where, "`memo_task`.`completed` = ?"), append(args, *v)
+	}
+	if v := find.DueTsBefore; v != nil {
+		where, args = append(where, "`memo_task`.`due_ts` > 0 AND `memo_task`.`due_ts` < ?"), append(args, *v)
+	}
+	if v := find.OverdueNotified; v != nil {
+		where, args = append(where, "`memo_task`.`overdue_notified` = ?"), append(args, *v)
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "`memo`.`row_status` = ?"), append(args, *v)
+	}
+
+	query := "SELECT `memo_task`.`memo_id`, `memo_task`.`position`, `memo_task`.`content`, `memo_task`.`completed`, `memo_task`.`due_ts`, `memo_task`.`assignee_id`, `memo_task`.`overdue_notified` FROM `memo_task` " +
+		"INNER JOIN `memo` ON `memo`.`id` = `memo_task`.`memo_id` " +
+		"WHERE " + strings.Join(where, " AND ") + " ORDER BY CASE WHEN `memo_task`.`due_ts` = 0 THEN 1 ELSE 0 END, `memo_task`.`due_ts` ASC, `memo_task`.`memo_id` DESC, `memo_task`.`position` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTask{}
+	for rows.Next() {
+		task := &store.MemoTask{}
+		if err := rows.Scan(
+			&task.MemoID,
+			&task.Position,
+			&task.Content,
+			&task.Completed,
+			&task.DueTs,
+			&task.AssigneeID,
+			&task.OverdueNotified,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) UpdateMemoTask(ctx context.Context, update *store.UpdateMemoTask) error {
+	set, args := []string{}, []any{}
+	if v := update.OverdueNotified; v != nil {
+		set, args = append(set, "`overdue_notified` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	args = append(args, update.MemoID, update.Position)
+	stmt := "UPDATE `memo_task` SET " + strings.Join(set, ", ") + " WHERE `memo_id` = ? AND `position` = ?"
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
+
+func vacuumMemoTask(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `memo_task` WHERE `memo_id` NOT IN (SELECT `id` FROM `memo`)"
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoView(ctx context.Context, create *store.MemoView) (*store.MemoView, error) {
+	fields := []string{"`creator_id`", "`name`", "`filter`", "`order_by`", "`visibility`"}
+	placeholder := []string{"?", "?", "?", "?", "?"}
+	args := []any{create.CreatorID, create.Name, create.Filter, create.OrderBy, create.Visibility}
+
+	stmt := "INSERT INTO `memo_view` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
+	result, err := d.db.ExecContext(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := result.LastInsertId()
+	if err != nil {
+		return nil, err
+	}
+
+	id32 := int32(id)
+	list, err := d.ListMemoViews(ctx, &store.FindMemoView{ID: &id32})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("unexpected memo view count: %d", len(list))
+	}
+	return list[0], nil
+}
+
+func (d *DB) ListMemoViews(ctx context.Context, find *store.FindMemoView) ([]*store.MemoView, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.VisibleToUserID; v != nil {
+		where, args = append(where, "(`creator_id` = ? OR `visibility` = ?)"), append(args, *v, store.MemoViewWorkspace)
+	}
+
+	query := "SELECT `id`, `creator_id`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`), `name`, `filter`, `order_by`, `visibility` FROM `memo_view` WHERE " + strings.Join(where, " AND ") + " ORDER BY `name` ASC, `id` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoView{}
+	for rows.Next() {
+		view := &store.MemoView{}
+		if err := rows.Scan(
+			&view.ID,
+			&view.CreatorID,
+			&view.CreatedTs,
+			&view.UpdatedTs,
+			&view.Name,
+			&view.Filter,
+			&view.OrderBy,
+			&view.Visibility,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, view)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateMemoView(ctx context.Context, update *store.UpdateMemoView) (*store.MemoView, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = FROM_UNIXTIME(?)"), append(args, *v)
+	}
+	if v := update.Name; v != nil {
+		set, args = append(set, "`name` = ?"), append(args, *v)
+	}
+	if v := update.Filter; v != nil {
+		set, args = append(set, "`filter` = ?"), append(args, *v)
+	}
+	if v := update.OrderBy; v != nil {
+		set, args = append(set, "`order_by` = ?"), append(args, *v)
+	}
+	if v := update.Visibility; v != nil {
+		set, args = append(set, "`visibility` = ?"), append(args, *v)
+	}
+	args = append(args, update.ID)
+
+	query := "UPDATE `memo_view` SET " + strings.Join(set, ", ") + " WHERE `id` = ?"
+	if _, err := d.db.ExecContext(ctx, query, args...); err != nil {
+		return nil, err
+	}
+
+	list, err := d.ListMemoViews(ctx, &store.FindMemoView{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("unexpected memo view count: %d", len(list))
+	}
+	return list[0], nil
+}
+
+func (d *DB) DeleteMemoView(ctx context.Context, delete *store.DeleteMemoView) error {
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM `memo_view` WHERE `id` = ?", delete.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func vacuumMemoView(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `memo_view` WHERE `creator_id` NOT IN (SELECT `id` FROM `user`)"
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+	return nil
+}
@@ -34,6 +34,32 @@ CREATE TABLE `user_setting` (
   UNIQUE(`user_id`,`key`)
 );
 
+-- user_group
+CREATE TABLE `user_group` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `name` VARCHAR(256) NOT NULL,
+  `description` TEXT NOT NULL
+);
+
+-- user_group_member
+CREATE TABLE `user_group_member` (
+  `group_id` INT NOT NULL,
+  `user_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `role` VARCHAR(256) NOT NULL DEFAULT 'MEMBER',
+  UNIQUE(`group_id`,`user_id`)
+);
+
+-- user_group_tag
+CREATE TABLE `user_group_tag` (
+  `group_id` INT NOT NULL,
+  `name` VARCHAR(256) NOT NULL,
+  UNIQUE(`group_id`,`name`)
+);
+
 -- memo
 CREATE TABLE `memo` (
   `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
@@ -43,7 +69,9 @@ CREATE TABLE `memo` (
   `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
   `row_status` VARCHAR(256) NOT NULL DEFAULT 'NORMAL',
   `content` TEXT NOT NULL,
-  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE'
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE',
+  `group_id` INT NOT NULL DEFAULT 0,
+  `encrypted` INT NOT NULL DEFAULT '0'
 );
 
 -- memo_organizer
@@ -54,14 +82,86 @@ CREATE TABLE `memo_organizer` (
   UNIQUE(`memo_id`,`user_id`)
 );
 
+-- memo_tag
+CREATE TABLE `memo_tag` (
+  `memo_id` INT NOT NULL,
+  `tag` VARCHAR(256) NOT NULL,
+  UNIQUE(`memo_id`,`tag`)
+);
+
+CREATE INDEX `idx_memo_tag_tag` ON `memo_tag` (`tag`);
+
+-- memo_task
+CREATE TABLE `memo_task` (
+  `memo_id` INT NOT NULL,
+  `position` INT NOT NULL,
+  `content` TEXT NOT NULL,
+  `completed` INT NOT NULL DEFAULT '0',
+  `due_ts` BIGINT NOT NULL DEFAULT '0',
+  `assignee_id` INT NOT NULL DEFAULT '0',
+  `overdue_notified` INT NOT NULL DEFAULT '0',
+  UNIQUE(`memo_id`,`position`)
+);
+
+CREATE INDEX `idx_memo_task_assignee_id` ON `memo_task` (`assignee_id`);
+
+-- memo_property
+CREATE TABLE `memo_property` (
+  `memo_id` INT NOT NULL,
+  `key` VARCHAR(256) NOT NULL,
+  `type` VARCHAR(256) NOT NULL,
+  `value` TEXT NOT NULL,
+  `number_value` DOUBLE,
+  UNIQUE(`memo_id`,`key`)
+);
+
+-- memo_view
+CREATE TABLE `memo_view` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `name` VARCHAR(256) NOT NULL,
+  `filter` TEXT NOT NULL,
+  `order_by` VARCHAR(256) NOT NULL DEFAULT '',
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE'
+);
+
+CREATE INDEX `idx_memo_view_creator_id` ON `memo_view` (`creator_id`);
+
 -- memo_relation
 CREATE TABLE `memo_relation` (
   `memo_id` INT NOT NULL,
   `related_memo_id` INT NOT NULL,
   `type` VARCHAR(256) NOT NULL,
+  `source` VARCHAR(256) NOT NULL DEFAULT 'MANUAL',
   UNIQUE(`memo_id`,`related_memo_id`,`type`)
 );
 
+-- memo_grant
+CREATE TABLE `memo_grant` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `memo_id` INT NOT NULL,
+  `grantee_type` VARCHAR(256) NOT NULL,
+  `grantee_id` INT NOT NULL,
+  `role` VARCHAR(256) NOT NULL DEFAULT 'VIEWER',
+  UNIQUE(`memo_id`,`grantee_type`,`grantee_id`)
+);
+
+-- memo_share_link
+CREATE TABLE `memo_share_link` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `memo_id` INT NOT NULL,
+  `token` VARCHAR(256) NOT NULL UNIQUE,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0,
+  `max_views` INT NOT NULL DEFAULT 0,
+  `view_count` INT NOT NULL DEFAULT 0,
+  `password_hash` VARCHAR(256) NOT NULL DEFAULT ''
+);
+
 -- resource
 CREATE TABLE `resource` (
   `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
@@ -82,6 +182,10 @@ CREATE TABLE `resource` (
 CREATE TABLE `tag` (
   `name` VARCHAR(256) NOT NULL,
   `creator_id` INT NOT NULL,
+  `color` VARCHAR(256) NOT NULL DEFAULT '',
+  `emoji` VARCHAR(256) NOT NULL DEFAULT '',
+  `description` VARCHAR(1024) NOT NULL DEFAULT '',
+  `pinned` INT NOT NULL DEFAULT '0',
   UNIQUE(`name`,`creator_id`)
 );
 
@@ -95,6 +199,35 @@ CREATE TABLE `activity` (
   `payload` TEXT NOT NULL
 );
 
+-- audit_log
+CREATE TABLE `audit_log` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `actor_id` INT NOT NULL DEFAULT 0,
+  `action` VARCHAR(256) NOT NULL,
+  `target` VARCHAR(256) NOT NULL DEFAULT '',
+  `ip` VARCHAR(256) NOT NULL DEFAULT '',
+  `payload` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_audit_log_created_ts` ON `audit_log` (`created_ts`);
+
+-- invitation
+CREATE TABLE `invitation` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `code` VARCHAR(256) NOT NULL UNIQUE,
+  `role` VARCHAR(256) NOT NULL DEFAULT 'USER',
+  `group_id` INT NOT NULL DEFAULT 0,
+  `max_uses` INT NOT NULL DEFAULT 0,
+  `use_count` INT NOT NULL DEFAULT 0,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0,
+  `revoked_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_invitation_creator_id` ON `invitation` (`creator_id`);
+
 -- storage
 CREATE TABLE `storage` (
   `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
@@ -130,7 +263,8 @@ CREATE TABLE `webhook` (
   `row_status` VARCHAR(256) NOT NULL DEFAULT 'NORMAL',
   `creator_id` INT NOT NULL,
   `name` TEXT NOT NULL,
-  `url` TEXT NOT NULL
+  `url` TEXT NOT NULL,
+  `group_id` INT NOT NULL DEFAULT 0
 );
 
 -- reaction
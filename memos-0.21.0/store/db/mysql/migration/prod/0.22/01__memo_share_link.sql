@@ -0,0 +1,11 @@
+CREATE TABLE `memo_share_link` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `memo_id` INT NOT NULL,
+  `token` VARCHAR(256) NOT NULL UNIQUE,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0,
+  `max_views` INT NOT NULL DEFAULT 0,
+  `view_count` INT NOT NULL DEFAULT 0,
+  `password_hash` VARCHAR(256) NOT NULL DEFAULT ''
+);
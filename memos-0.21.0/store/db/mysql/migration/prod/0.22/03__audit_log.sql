@@ -0,0 +1,11 @@
+CREATE TABLE `audit_log` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `actor_id` INT NOT NULL DEFAULT 0,
+  `action` VARCHAR(256) NOT NULL,
+  `target` VARCHAR(256) NOT NULL DEFAULT '',
+  `ip` VARCHAR(256) NOT NULL DEFAULT '',
+  `payload` TEXT NOT NULL
+);
+
+CREATE INDEX `idx_audit_log_created_ts` ON `audit_log` (`created_ts`);
@@ -0,0 +1 @@
+ALTER TABLE `memo` ADD COLUMN `encrypted` INT NOT NULL DEFAULT '0';
@@ -0,0 +1,14 @@
+CREATE TABLE `invitation` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `code` VARCHAR(256) NOT NULL UNIQUE,
+  `role` VARCHAR(256) NOT NULL DEFAULT 'USER',
+  `group_id` INT NOT NULL DEFAULT 0,
+  `max_uses` INT NOT NULL DEFAULT 0,
+  `use_count` INT NOT NULL DEFAULT 0,
+  `expires_ts` BIGINT NOT NULL DEFAULT 0,
+  `revoked_ts` BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX `idx_invitation_creator_id` ON `invitation` (`creator_id`);
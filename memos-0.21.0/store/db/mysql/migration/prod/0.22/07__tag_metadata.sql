@@ -0,0 +1,4 @@
+ALTER TABLE `tag` ADD COLUMN `color` VARCHAR(256) NOT NULL DEFAULT '';
+ALTER TABLE `tag` ADD COLUMN `emoji` VARCHAR(256) NOT NULL DEFAULT '';
+ALTER TABLE `tag` ADD COLUMN `description` VARCHAR(1024) NOT NULL DEFAULT '';
+ALTER TABLE `tag` ADD COLUMN `pinned` INT NOT NULL DEFAULT '0';
@@ -0,0 +1,12 @@
+CREATE TABLE `memo_view` (
+  `id` INT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  `creator_id` INT NOT NULL,
+  `created_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updated_ts` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `name` VARCHAR(256) NOT NULL,
+  `filter` TEXT NOT NULL,
+  `order_by` VARCHAR(256) NOT NULL DEFAULT '',
+  `visibility` VARCHAR(256) NOT NULL DEFAULT 'PRIVATE'
+);
+
+CREATE INDEX `idx_memo_view_creator_id` ON `memo_view` (`creator_id`);
@@ -0,0 +1,12 @@
+CREATE TABLE `memo_task` (
+  `memo_id` INT NOT NULL,
+  `position` INT NOT NULL,
+  `content` TEXT NOT NULL,
+  `completed` INT NOT NULL DEFAULT '0',
+  `due_ts` BIGINT NOT NULL DEFAULT '0',
+  `assignee_id` INT NOT NULL DEFAULT '0',
+  `overdue_notified` INT NOT NULL DEFAULT '0',
+  UNIQUE(`memo_id`,`position`)
+);
+
+CREATE INDEX `idx_memo_task_assignee_id` ON `memo_task` (`assignee_id`);
@@ -0,0 +1 @@
+ALTER TABLE `memo_relation` ADD COLUMN `source` VARCHAR(256) NOT NULL DEFAULT 'MANUAL';
@@ -0,0 +1,8 @@
+CREATE TABLE `memo_property` (
+  `memo_id` INT NOT NULL,
+  `key` VARCHAR(256) NOT NULL,
+  `type` VARCHAR(256) NOT NULL,
+  `value` TEXT NOT NULL,
+  `number_value` DOUBLE,
+  UNIQUE(`memo_id`,`key`)
+);
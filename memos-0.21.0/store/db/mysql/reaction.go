@@ -2,6 +2,7 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -46,6 +47,13 @@ func (d *DB) ListReactions(ctx context.Context, find *store.FindReaction) ([]*st
 	if find.ContentID != nil {
 		where, args = append(where, "`content_id` = ?"), append(args, *find.ContentID)
 	}
+	if v := find.ContentIDList; len(v) != 0 {
+		placeholder := []string{}
+		for _, contentID := range v {
+			placeholder, args = append(placeholder, "?"), append(args, contentID)
+		}
+		where = append(where, "`content_id` IN ("+strings.Join(placeholder, ", ")+")")
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -105,3 +113,12 @@ func (d *DB) DeleteReaction(ctx context.Context, delete *store.DeleteReaction) e
 	_, err := d.db.ExecContext(ctx, "DELETE FROM `reaction` WHERE `id` = ?", delete.ID)
 	return err
 }
+
+// vacuumReaction deletes the reactions of the deleted users and to the deleted memos.
+func vacuumReaction(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `reaction` WHERE `creator_id` NOT IN (SELECT `id` FROM `user`) OR (`content_id` LIKE 'memos/%' AND `content_id` NOT IN (SELECT CONCAT('memos/', `id`) FROM `memo`))"
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+	return nil
+}
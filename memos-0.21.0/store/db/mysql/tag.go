@@ -23,8 +23,11 @@ func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, e
 	if find.CreatorID != 0 {
 		where, args = append(where, "`creator_id` = ?"), append(args, find.CreatorID)
 	}
+	if v := find.Name; v != nil {
+		where, args = append(where, "`name` = ?"), append(args, *v)
+	}
 
-	query := "SELECT `name`, `creator_id` FROM `tag` WHERE " + strings.Join(where, " AND ") + " ORDER BY name ASC"
+	query := "SELECT `name`, `creator_id`, `color`, `emoji`, `description`, `pinned` FROM `tag` WHERE " + strings.Join(where, " AND ") + " ORDER BY name ASC"
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -37,6 +40,10 @@ func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, e
 		if err := rows.Scan(
 			&tag.Name,
 			&tag.CreatorID,
+			&tag.Color,
+			&tag.Emoji,
+			&tag.Description,
+			&tag.Pinned,
 		); err != nil {
 			return nil, err
 		}
@@ -51,6 +58,32 @@ func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, e
 	return list, nil
 }
 
+func (d *DB) UpdateTag(ctx context.Context, update *store.UpdateTag) error {
+	set, args := []string{}, []any{}
+	if v := update.Color; v != nil {
+		set, args = append(set, "`color` = ?"), append(args, *v)
+	}
+	if v := update.Emoji; v != nil {
+		set, args = append(set, "`emoji` = ?"), append(args, *v)
+	}
+	if v := update.Description; v != nil {
+		set, args = append(set, "`description` = ?"), append(args, *v)
+	}
+	if v := update.Pinned; v != nil {
+		set, args = append(set, "`pinned` = ?"), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+	args = append(args, update.Name, update.CreatorID)
+
+	stmt := "UPDATE `tag` SET " + strings.Join(set, ", ") + " WHERE `name` = ? AND `creator_id` = ?"
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (d *DB) DeleteTag(ctx context.Context, delete *store.DeleteTag) error {
 	where, args := []string{"`name` = ?", "`creator_id` = ?"}, []any{delete.Name, delete.CreatorID}
 	stmt := "DELETE FROM `tag` WHERE " + strings.Join(where, " AND ")
@@ -64,6 +97,42 @@ func (d *DB) DeleteTag(ctx context.Context, delete *store.DeleteTag) error {
 	return nil
 }
 
+func (d *DB) MoveTags(ctx context.Context, move *store.MoveTags) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, memo := range move.Memos {
+		if _, err := tx.ExecContext(ctx, "UPDATE `memo` SET `content` = ? WHERE `id` = ?", memo.Content, memo.ID); err != nil {
+			return err
+		}
+	}
+	for _, set := range move.MemoTags {
+		if err := setMemoTags(ctx, tx, set); err != nil {
+			return err
+		}
+	}
+	for _, set := range move.MemoTasks {
+		if err := setMemoTasks(ctx, tx, set); err != nil {
+			return err
+		}
+	}
+	// The moved tags are deleted first, as the new name of a tag can be the old name of another one.
+	for _, name := range move.DeleteNames {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM `tag` WHERE `name` = ? AND `creator_id` = ?", name, move.CreatorID); err != nil {
+			return err
+		}
+	}
+	for _, tag := range move.Tags {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO `tag` (`name`, `creator_id`, `color`, `emoji`, `description`, `pinned`) VALUES (?, ?, ?, ?, ?, ?)", tag.Name, move.CreatorID, tag.Color, tag.Emoji, tag.Description, tag.Pinned); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
 func vacuumTag(ctx context.Context, tx *sql.Tx) error {
 	stmt := "DELETE FROM `tag` WHERE `creator_id` NOT IN (SELECT `id` FROM `user`)"
 	_, err := tx.ExecContext(ctx, stmt)
@@ -2,16 +2,19 @@ package mysql
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"strings"
 
+	"github.com/usememos/memos/plugin/filter"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
 func (d *DB) CreateWebhook(ctx context.Context, create *storepb.Webhook) (*storepb.Webhook, error) {
-	fields := []string{"`name`", "`url`", "`creator_id`"}
-	placeholder := []string{"?", "?", "?"}
-	args := []any{create.Name, create.Url, create.CreatorId}
+	fields := []string{"`name`", "`url`", "`creator_id`", "`group_id`"}
+	placeholder := []string{"?", "?", "?", "?"}
+	args := []any{create.Name, create.Url, create.CreatorId, create.GroupId}
 
 	stmt := "INSERT INTO `webhook` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ")"
 	result, err := d.db.ExecContext(ctx, stmt, args...)
@@ -28,6 +31,11 @@ func (d *DB) CreateWebhook(ctx context.Context, create *storepb.Webhook) (*store
 	return d.GetWebhook(ctx, &store.FindWebhook{ID: &create.Id})
 }
 
+// webhookFilterColumns are the columns of the fields of the webhook cursors.
+var webhookFilterColumns = map[string]string{
+	"id": "`id`",
+}
+
 func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*storepb.Webhook, error) {
 	where, args := []string{"1 = 1"}, []any{}
 	if find.ID != nil {
@@ -36,10 +44,23 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 	if find.CreatorID != nil {
 		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
 	}
+	if find.GroupID != nil {
+		where, args = append(where, "`group_id` = ?"), append(args, *find.GroupID)
+	}
 
-	rows, err := d.db.QueryContext(ctx, "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`), `row_status`, `creator_id`, `name`, `url` FROM `webhook` WHERE "+strings.Join(where, " AND ")+" ORDER BY `id` DESC",
-		args...,
-	)
+	if v := store.GetWebhookCursorFilter(find); v != nil {
+		condition, cursorArgs, err := filter.MySQL.ConvertToSQL(v, webhookFilterColumns, len(args))
+		if err != nil {
+			return nil, err
+		}
+		where, args = append(where, condition), append(args, cursorArgs...)
+	}
+
+	query := "SELECT `id`, UNIX_TIMESTAMP(`created_ts`), UNIX_TIMESTAMP(`updated_ts`), `row_status`, `creator_id`, `name`, `url`, `group_id` FROM `webhook` WHERE " + strings.Join(where, " AND ") + " ORDER BY `id` DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -57,6 +78,7 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 			&webhook.CreatorId,
 			&webhook.Name,
 			&webhook.Url,
+			&webhook.GroupId,
 		); err != nil {
 			return nil, err
 		}
@@ -113,3 +135,11 @@ func (d *DB) DeleteWebhook(ctx context.Context, delete *store.DeleteWebhook) err
 	_, err := d.db.ExecContext(ctx, "DELETE FROM `webhook` WHERE `id` = ?", delete.ID)
 	return err
 }
+
+func vacuumWebhook(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `webhook` WHERE `creator_id` NOT IN (SELECT `id` FROM `user`)"
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+	return nil
+}
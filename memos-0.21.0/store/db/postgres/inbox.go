@@ -3,11 +3,13 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"strings"
 
 	"github.com/pkg/errors"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/usememos/memos/plugin/filter"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
@@ -35,6 +37,12 @@ func (d *DB) CreateInbox(ctx context.Context, create *store.Inbox) (*store.Inbox
 	return create, nil
 }
 
+// inboxFilterColumns are the columns of the fields of the inbox cursors.
+var inboxFilterColumns = map[string]string{
+	"id":         "id",
+	"created_ts": "created_ts",
+}
+
 func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.Inbox, error) {
 	where, args := []string{"1 = 1"}, []any{}
 
@@ -51,7 +59,18 @@ func (d *DB) ListInboxes(ctx context.Context, find *store.FindInbox) ([]*store.I
 		where, args = append(where, "status = "+placeholder(len(args)+1)), append(args, *find.Status)
 	}
 
-	query := "SELECT id, created_ts, sender_id, receiver_id, status, message FROM inbox WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC"
+	if v := store.GetInboxCursorFilter(find); v != nil {
+		condition, cursorArgs, err := filter.Postgres.ConvertToSQL(v, inboxFilterColumns, len(args))
+		if err != nil {
+			return nil, err
+		}
+		where, args = append(where, condition), append(args, cursorArgs...)
+	}
+
+	query := "SELECT id, created_ts, sender_id, receiver_id, status, message FROM inbox WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts DESC, id DESC"
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+	}
 	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -134,9 +153,10 @@ func (d *DB) DeleteInbox(ctx context.Context, delete *store.DeleteInbox) error {
 	return nil
 }
 
+// vacuumInbox deletes the inboxes sent to or by the deleted users, keeping those sent by the system bot.
 func vacuumInbox(ctx context.Context, tx *sql.Tx) error {
-	stmt := `DELETE FROM inbox WHERE sender_id NOT IN (SELECT id FROM "user")`
-	_, err := tx.ExecContext(ctx, stmt)
+	stmt := `DELETE FROM inbox WHERE (sender_id != $1 AND sender_id NOT IN (SELECT id FROM "user")) OR receiver_id NOT IN (SELECT id FROM "user")`
+	_, err := tx.ExecContext(ctx, stmt, store.SystemBotID)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,119 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateInvitation(ctx context.Context, create *store.Invitation) (*store.Invitation, error) {
+	fields := []string{"creator_id", "code", "role", "group_id", "max_uses", "expires_ts"}
+	args := []any{create.CreatorID, create.Code, create.Role, create.GroupID, create.MaxUses, create.ExpiresTs}
+
+	stmt := "INSERT INTO invitation (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, use_count, revoked_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UseCount,
+		&create.RevokedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListInvitations(ctx context.Context, find *store.FindInvitation) ([]*store.Invitation, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Code; v != nil {
+		where, args = append(where, "code = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := "SELECT id, creator_id, created_ts, code, role, group_id, max_uses, use_count, expires_ts, revoked_ts FROM invitation WHERE " + strings.Join(where, " AND ") + " ORDER BY id DESC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.Invitation{}
+	for rows.Next() {
+		invitation := &store.Invitation{}
+		if err := rows.Scan(
+			&invitation.ID,
+			&invitation.CreatorID,
+			&invitation.CreatedTs,
+			&invitation.Code,
+			&invitation.Role,
+			&invitation.GroupID,
+			&invitation.MaxUses,
+			&invitation.UseCount,
+			&invitation.ExpiresTs,
+			&invitation.RevokedTs,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, invitation)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateInvitation(ctx context.Context, update *store.UpdateInvitation) (*store.Invitation, error) {
+	set, args := []string{}, []any{}
+	if v := update.RevokedTs; v != nil {
+		set, args = append(set, "revoked_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if len(set) == 0 {
+		return nil, errors.New("no fields to update")
+	}
+	args = append(args, update.ID)
+
+	stmt := "UPDATE invitation SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args))
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return nil, err
+	}
+	list, err := d.ListInvitations(ctx, &store.FindInvitation{ID: &update.ID})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) != 1 {
+		return nil, errors.Errorf("unexpected invitation count: %d", len(list))
+	}
+	return list[0], nil
+}
+
+func (d *DB) IncreaseInvitationUseCount(ctx context.Context, id int32, ts int64) (bool, error) {
+	stmt := "UPDATE invitation SET use_count = use_count + 1 WHERE id = $1 AND revoked_ts = 0 AND (expires_ts = 0 OR expires_ts > $2) AND (max_uses = 0 OR use_count < max_uses)"
+	result, err := d.db.ExecContext(ctx, stmt, id, ts)
+	if err != nil {
+		return false, err
+	}
+	rows, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return rows == 1, nil
+}
+
+func vacuumInvitation(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM invitation WHERE creator_id NOT IN (SELECT id FROM "user")`
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) SetMemoTasks(ctx context.Context, set *store.SetMemoTasks) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := setMemoTasks(ctx, tx, set); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func setMemoTasks(ctx context.Context, tx *sql.Tx, set *store.SetMemoTasks) error {
+	if _, err := tx.ExecContext(ctx, "DELETE FROM memo_task WHERE memo_id = "+placeholder(1), set.MemoID); err != nil {
+		return err
+	}
+	for _, task := range set.Tasks {
+		stmt := "INSERT INTO memo_task (memo_id, position, content, completed, due_ts, assignee_id, overdue_notified) VALUES (" + placeholders(7) + ")"
+		if _, err := tx.ExecContext(ctx, stmt, set.MemoID, task.Position, task.Content, boolToInt(task.Completed), task.DueTs, task.AssigneeID, boolToInt(task.OverdueNotified)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *DB) ListMemoTasks(ctx context.Context, find *store.FindMemoTask) ([]*store.MemoTask, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.MemoID; v != nil {
+		where, args = append(where, "memo_task.memo_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.Position; v != nil {
+		where, args = append(where, "memo_task.position = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.CreatorOrAssigneeID; v != nil {
+		where, args = append(where, "(memo.creator_id = "+placeholder(len(args)+1)+" OR memo_task.assignee_id = "+placeholder(len(args)+2)+")"), append(args, *v, *v)
+	}
+	if v := find.VisibleToUserID; v != nil {
+		userPlaceholder := placeholder(len(args) + 1)
+		where = append(where, "(memo.creator_id = "+userPlaceholder+" OR memo.visibility IN ('PUBLIC', 'PROTECTED') OR memo.id IN ("+
+			"SELECT memo_id FROM memo_grant WHERE (grantee_type = 'USER' AND grantee_id = "+userPlaceholder+") "+
+			"OR (grantee_type = 'GROUP' AND grantee_id IN (SELECT group_id FROM user_group_member WHERE user_id = "+userPlaceholder+"))) "+
+			"OR memo.group_id IN (SELECT group_id FROM user_group_member WHERE user_id = "+userPlaceholder+"))")
+		args = append(args, *v)
+	}
+	if v := find.Completed; v != nil {
+		where, args = append(where, "memo_task.completed = "+placeholder(len(args)+1)), append(args, boolToInt(*v))
+	}
+	if v := find.DueTsBefore; v != nil {
+		where, args = append(where, "memo_task.due_ts > 0 AND memo_task.due_ts < "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.OverdueNotified; v != nil {
+		where, args = append(where, "memo_task.overdue_notified = "+placeholder(len(args)+1)), append(args, boolToInt(*v))
+	}
+	if v := find.RowStatus; v != nil {
+		where, args = append(where, "memo.row_status = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := `
+		SELECT memo_task.memo_id, memo_task.position, memo_task.content, memo_task.completed, memo_task.due_ts, memo_task.assignee_id, memo_task.overdue_notified
+		FROM memo_task
+		INNER JOIN memo ON memo.id = memo_task.memo_id
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY CASE WHEN memo_task.due_ts = 0 THEN 1 ELSE 0 END, memo_task.due_ts ASC, memo_task.memo_id DESC, memo_task.position ASC`
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoTask{}
+	for rows.Next() {
+		task := &store.MemoTask{}
+		if err := rows.Scan(
+			&task.MemoID,
+			&task.Position,
+			&task.Content,
+			&task.Completed,
+			&task.DueTs,
+			&task.AssigneeID,
+			&task.OverdueNotified,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, task)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+func (d *DB) UpdateMemoTask(ctx context.Context, update *store.UpdateMemoTask) error {
+	set, args := []string{}, []any{}
+	if v := update.OverdueNotified; v != nil {
+		overdueNotified := boolToInt(*v)
+		set, args = append(set, "overdue_notified = "+placeholder(len(args)+1)), append(args, overdueNotified)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	args = append(args, update.MemoID, update.Position)
+	stmt := "UPDATE memo_task SET " + strings.Join(set, ", ") + " WHERE memo_id = " + placeholder(len(args)-1) + " AND position = " + placeholder(len(args))
+	_, err := d.db.ExecContext(ctx, stmt, args...)
+	return err
+}
+
+func vacuumMemoTask(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM memo_task WHERE memo_id NOT IN (SELECT id FROM memo)`
+	_, err := tx.ExecContext(ctx, stmt)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// boolToInt converts the booleans to the integers they are stored as.
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
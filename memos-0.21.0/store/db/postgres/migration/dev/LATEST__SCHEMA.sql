@@ -34,6 +34,32 @@ CREATE TABLE user_setting (
   UNIQUE(user_id, key)
 );
 
+-- user_group
+CREATE TABLE user_group (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+-- user_group_member
+CREATE TABLE user_group_member (
+  group_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  role TEXT NOT NULL DEFAULT 'MEMBER',
+  UNIQUE(group_id, user_id)
+);
+
+-- user_group_tag
+CREATE TABLE user_group_tag (
+  group_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  UNIQUE(group_id, name)
+);
+
 -- memo
 CREATE TABLE memo (
   id SERIAL PRIMARY KEY,
@@ -43,7 +69,9 @@ CREATE TABLE memo (
   updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
   row_status TEXT NOT NULL DEFAULT 'NORMAL',
   content TEXT NOT NULL,
-  visibility TEXT NOT NULL DEFAULT 'PRIVATE'
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE',
+  group_id INTEGER NOT NULL DEFAULT 0,
+  encrypted INTEGER NOT NULL DEFAULT 0
 );
 
 -- memo_organizer
@@ -54,14 +82,86 @@ CREATE TABLE memo_organizer (
   UNIQUE(memo_id, user_id)
 );
 
+-- memo_tag
+CREATE TABLE memo_tag (
+  memo_id INTEGER NOT NULL,
+  tag TEXT NOT NULL,
+  UNIQUE(memo_id, tag)
+);
+
+CREATE INDEX idx_memo_tag_tag ON memo_tag (tag);
+
+-- memo_task
+CREATE TABLE memo_task (
+  memo_id INTEGER NOT NULL,
+  position INTEGER NOT NULL,
+  content TEXT NOT NULL,
+  completed INTEGER NOT NULL DEFAULT 0,
+  due_ts BIGINT NOT NULL DEFAULT 0,
+  assignee_id INTEGER NOT NULL DEFAULT 0,
+  overdue_notified INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(memo_id, position)
+);
+
+CREATE INDEX idx_memo_task_assignee_id ON memo_task (assignee_id);
+
+-- memo_property
+CREATE TABLE memo_property (
+  memo_id INTEGER NOT NULL,
+  key TEXT NOT NULL,
+  type TEXT NOT NULL,
+  value TEXT NOT NULL,
+  number_value DOUBLE PRECISION,
+  UNIQUE(memo_id, key)
+);
+
+-- memo_view
+CREATE TABLE memo_view (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  updated_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  name TEXT NOT NULL,
+  filter TEXT NOT NULL DEFAULT '',
+  order_by TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL DEFAULT 'PRIVATE'
+);
+
+CREATE INDEX idx_memo_view_creator_id ON memo_view (creator_id);
+
 -- memo_relation
 CREATE TABLE memo_relation (
   memo_id INTEGER NOT NULL,
   related_memo_id INTEGER NOT NULL,
   type TEXT NOT NULL,
+  source TEXT NOT NULL DEFAULT 'MANUAL',
   UNIQUE(memo_id, related_memo_id, type)
 );
 
+-- memo_grant
+CREATE TABLE memo_grant (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  memo_id INTEGER NOT NULL,
+  grantee_type TEXT NOT NULL,
+  grantee_id INTEGER NOT NULL,
+  role TEXT NOT NULL DEFAULT 'VIEWER',
+  UNIQUE(memo_id, grantee_type, grantee_id)
+);
+
+-- memo_share_link
+CREATE TABLE memo_share_link (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  memo_id INTEGER NOT NULL,
+  token TEXT NOT NULL UNIQUE,
+  expires_ts BIGINT NOT NULL DEFAULT 0,
+  max_views INTEGER NOT NULL DEFAULT 0,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  password_hash TEXT NOT NULL DEFAULT ''
+);
+
 -- resource
 CREATE TABLE resource (
   id SERIAL PRIMARY KEY,
@@ -82,6 +182,10 @@ CREATE TABLE resource (
 CREATE TABLE tag (
   name TEXT NOT NULL,
   creator_id INTEGER NOT NULL,
+  color TEXT NOT NULL DEFAULT '',
+  emoji TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  pinned INTEGER NOT NULL DEFAULT 0,
   UNIQUE(name, creator_id)
 );
 
@@ -95,6 +199,35 @@ CREATE TABLE activity (
   payload JSONB NOT NULL DEFAULT '{}'
 );
 
+-- audit_log
+CREATE TABLE audit_log (
+  id SERIAL PRIMARY KEY,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  actor_id INTEGER NOT NULL DEFAULT 0,
+  action TEXT NOT NULL,
+  target TEXT NOT NULL DEFAULT '',
+  ip TEXT NOT NULL DEFAULT '',
+  payload JSONB NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_audit_log_created_ts ON audit_log (created_ts);
+
+-- invitation
+CREATE TABLE invitation (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  code TEXT NOT NULL UNIQUE,
+  role TEXT NOT NULL DEFAULT 'USER',
+  group_id INTEGER NOT NULL DEFAULT 0,
+  max_uses INTEGER NOT NULL DEFAULT 0,
+  use_count INTEGER NOT NULL DEFAULT 0,
+  expires_ts BIGINT NOT NULL DEFAULT 0,
+  revoked_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_invitation_creator_id ON invitation (creator_id);
+
 -- storage
 CREATE TABLE storage (
   id SERIAL PRIMARY KEY,
@@ -130,7 +263,8 @@ CREATE TABLE webhook (
   row_status TEXT NOT NULL DEFAULT 'NORMAL',
   creator_id INTEGER NOT NULL,
   name TEXT NOT NULL,
-  url TEXT NOT NULL
+  url TEXT NOT NULL,
+  group_id INTEGER NOT NULL DEFAULT 0
 );
 
 -- reaction
@@ -0,0 +1,11 @@
+CREATE TABLE memo_share_link (
+  id SERIAL PRIMARY KEY,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT EXTRACT(EPOCH FROM NOW()),
+  memo_id INTEGER NOT NULL,
+  token TEXT NOT NULL UNIQUE,
+  expires_ts BIGINT NOT NULL DEFAULT 0,
+  max_views INTEGER NOT NULL DEFAULT 0,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  password_hash TEXT NOT NULL DEFAULT ''
+);
@@ -0,0 +1,11 @@
+ALTER TABLE user_group_member ADD COLUMN role TEXT NOT NULL DEFAULT 'MEMBER';
+
+CREATE TABLE user_group_tag (
+  group_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  UNIQUE(group_id, name)
+);
+
+ALTER TABLE memo ADD COLUMN group_id INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE webhook ADD COLUMN group_id INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1 @@
+ALTER TABLE memo ADD COLUMN encrypted INTEGER NOT NULL DEFAULT 0;
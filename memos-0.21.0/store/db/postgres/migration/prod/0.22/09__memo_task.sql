@@ -0,0 +1,12 @@
+CREATE TABLE memo_task (
+  memo_id INTEGER NOT NULL,
+  position INTEGER NOT NULL,
+  content TEXT NOT NULL,
+  completed INTEGER NOT NULL DEFAULT 0,
+  due_ts BIGINT NOT NULL DEFAULT 0,
+  assignee_id INTEGER NOT NULL DEFAULT 0,
+  overdue_notified INTEGER NOT NULL DEFAULT 0,
+  UNIQUE(memo_id, position)
+);
+
+CREATE INDEX idx_memo_task_assignee_id ON memo_task (assignee_id);
@@ -0,0 +1 @@
+ALTER TABLE memo_relation ADD COLUMN source TEXT NOT NULL DEFAULT 'MANUAL';
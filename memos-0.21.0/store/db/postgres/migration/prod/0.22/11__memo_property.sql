@@ -0,0 +1,8 @@
+CREATE TABLE memo_property (
+  memo_id INTEGER NOT NULL,
+  key TEXT NOT NULL,
+  type TEXT NOT NULL,
+  value TEXT NOT NULL,
+  number_value DOUBLE PRECISION,
+  UNIQUE(memo_id, key)
+);
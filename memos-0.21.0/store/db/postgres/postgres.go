@@ -51,6 +51,14 @@ func (d *DB) Vacuum(ctx context.Context) error {
 	}
 	defer tx.Rollback()
 
+	if err := vacuumImpl(ctx, tx); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func vacuumImpl(ctx context.Context, tx *sql.Tx) error {
 	if err := vacuumMemo(ctx, tx); err != nil {
 		return err
 	}
@@ -63,18 +71,51 @@ func (d *DB) Vacuum(ctx context.Context) error {
 	if err := vacuumMemoOrganizer(ctx, tx); err != nil {
 		return err
 	}
+	if err := vacuumMemoTag(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumMemoTask(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumMemoProperty(ctx, tx); err != nil {
+		return err
+	}
 	if err := vacuumMemoRelations(ctx, tx); err != nil {
 		return err
 	}
+	if err := vacuumMemoGrant(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumMemoShareLink(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumUserGroupMember(ctx, tx); err != nil {
+		return err
+	}
 	if err := vacuumInbox(ctx, tx); err != nil {
 		return err
 	}
+	if err := vacuumReaction(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumWebhook(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumActivity(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumInvitation(ctx, tx); err != nil {
+		return err
+	}
+	if err := vacuumMemoView(ctx, tx); err != nil {
+		return err
+	}
 	if err := vacuumTag(ctx, tx); err != nil {
 		// Prevent revive warning.
 		return err
 	}
 
-	return tx.Commit()
+	return nil
 }
 
 func (*DB) GetCurrentDBSize(context.Context) (int64, error) {
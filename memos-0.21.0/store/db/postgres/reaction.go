@@ -2,6 +2,7 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
@@ -34,6 +35,13 @@ func (d *DB) ListReactions(ctx context.Context, find *store.FindReaction) ([]*st
 	if find.ContentID != nil {
 		where, args = append(where, "content_id = "+placeholder(len(args)+1)), append(args, *find.ContentID)
 	}
+	if v := find.ContentIDList; len(v) != 0 {
+		holders := []string{}
+		for _, contentID := range v {
+			holders, args = append(holders, placeholder(len(args)+1)), append(args, contentID)
+		}
+		where = append(where, "content_id IN ("+strings.Join(holders, ", ")+")")
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
@@ -80,3 +88,12 @@ func (d *DB) DeleteReaction(ctx context.Context, delete *store.DeleteReaction) e
 	_, err := d.db.ExecContext(ctx, "DELETE FROM reaction WHERE id = $1", delete.ID)
 	return err
 }
+
+// vacuumReaction deletes the reactions of the deleted users and to the deleted memos.
+func vacuumReaction(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM reaction WHERE creator_id NOT IN (SELECT id FROM "user") OR (content_id LIKE 'memos/%' AND content_id NOT IN (SELECT 'memos/' || id FROM memo))`
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/usememos/memos/plugin/filter"
 	"github.com/usememos/memos/store"
 )
 
@@ -20,6 +21,19 @@ func (d *DB) CreateResource(ctx context.Context, create *store.Resource) (*store
 	return create, nil
 }
 
+// resourceFilterColumns are the columns of the fields of the resource filters.
+var resourceFilterColumns = map[string]string{
+	"id":         `id`,
+	"uid":        `uid`,
+	"creator_id": `creator_id`,
+	"created_ts": `created_ts`,
+	"updated_ts": `updated_ts`,
+	"filename":   `filename`,
+	"type":       `type`,
+	"size":       `size`,
+	"memo_id":    `memo_id`,
+}
+
 func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*store.Resource, error) {
 	where, args := []string{"1 = 1"}, []any{}
 
@@ -38,9 +52,23 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 	if v := find.MemoID; v != nil {
 		where, args = append(where, "memo_id = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.MemoIDList; len(v) != 0 {
+		holders := []string{}
+		for _, memoID := range v {
+			holders, args = append(holders, placeholder(len(args)+1)), append(args, memoID)
+		}
+		where = append(where, "memo_id IN ("+strings.Join(holders, ", ")+")")
+	}
 	if find.HasRelatedMemo {
 		where = append(where, "memo_id IS NOT NULL")
 	}
+	if v := filter.And(find.Filter, store.GetResourceCursorFilter(find)); v != nil {
+		condition, filterArgs, err := filter.Postgres.ConvertToSQL(v, resourceFilterColumns, len(args))
+		if err != nil {
+			return nil, err
+		}
+		where, args = append(where, condition), append(args, filterArgs...)
+	}
 
 	fields := []string{"id", "uid", "filename", "external_link", "type", "size", "creator_id", "created_ts", "updated_ts", "internal_path", "memo_id"}
 	if find.GetBlob {
@@ -52,7 +80,7 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 			%s
 		FROM resource
 		WHERE %s
-		ORDER BY updated_ts DESC, created_ts DESC
+		ORDER BY updated_ts DESC, id DESC
 	`, strings.Join(fields, ", "), strings.Join(where, " AND "))
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
@@ -22,11 +22,18 @@ func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, e
 	if find.CreatorID != 0 {
 		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, find.CreatorID)
 	}
+	if v := find.Name; v != nil {
+		where, args = append(where, "name = "+placeholder(len(args)+1)), append(args, *v)
+	}
 
 	query := `
 		SELECT
 			name,
-			creator_id
+			creator_id,
+			color,
+			emoji,
+			description,
+			pinned
 		FROM tag
 		WHERE ` + strings.Join(where, " AND ") + `
 		ORDER BY name ASC
@@ -43,6 +50,10 @@ func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, e
 		if err := rows.Scan(
 			&tag.Name,
 			&tag.CreatorID,
+			&tag.Color,
+			&tag.Emoji,
+			&tag.Description,
+			&tag.Pinned,
 		); err != nil {
 			return nil, err
 		}
@@ -57,6 +68,36 @@ func (d *DB) ListTags(ctx context.Context, find *store.FindTag) ([]*store.Tag, e
 	return list, nil
 }
 
+func (d *DB) UpdateTag(ctx context.Context, update *store.UpdateTag) error {
+	set, args := []string{}, []any{}
+	if v := update.Color; v != nil {
+		set, args = append(set, "color = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Emoji; v != nil {
+		set, args = append(set, "emoji = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Description; v != nil {
+		set, args = append(set, "description = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Pinned; v != nil {
+		pinned := 0
+		if *v {
+			pinned = 1
+		}
+		set, args = append(set, "pinned = "+placeholder(len(args)+1)), append(args, pinned)
+	}
+	if len(set) == 0 {
+		return nil
+	}
+
+	stmt := `UPDATE tag SET ` + strings.Join(set, ", ") + ` WHERE name = ` + placeholder(len(args)+1) + ` AND creator_id = ` + placeholder(len(args)+2)
+	args = append(args, update.Name, update.CreatorID)
+	if _, err := d.db.ExecContext(ctx, stmt, args...); err != nil {
+		return err
+	}
+	return nil
+}
+
 func (d *DB) DeleteTag(ctx context.Context, delete *store.DeleteTag) error {
 	where, args := []string{"name = $1", "creator_id = $2"}, []any{delete.Name, delete.CreatorID}
 	stmt := `DELETE FROM tag WHERE ` + strings.Join(where, " AND ")
@@ -70,6 +111,42 @@ func (d *DB) DeleteTag(ctx context.Context, delete *store.DeleteTag) error {
 	return nil
 }
 
+func (d *DB) MoveTags(ctx context.Context, move *store.MoveTags) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, memo := range move.Memos {
+		if _, err := tx.ExecContext(ctx, "UPDATE memo SET content = "+placeholder(1)+" WHERE id = "+placeholder(2), memo.Content, memo.ID); err != nil {
+			return err
+		}
+	}
+	for _, set := range move.MemoTags {
+		if err := setMemoTags(ctx, tx, set); err != nil {
+			return err
+		}
+	}
+	for _, set := range move.MemoTasks {
+		if err := setMemoTasks(ctx, tx, set); err != nil {
+			return err
+		}
+	}
+	// The moved tags are deleted first, as the new name of a tag can be the old name of another one.
+	for _, name := range move.DeleteNames {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM tag WHERE name = "+placeholder(1)+" AND creator_id = "+placeholder(2), name, move.CreatorID); err != nil {
+			return err
+		}
+	}
+	for _, tag := range move.Tags {
+		if _, err := tx.ExecContext(ctx, "INSERT INTO tag (name, creator_id, color, emoji, description, pinned) VALUES ("+placeholders(6)+")", tag.Name, move.CreatorID, tag.Color, tag.Emoji, tag.Description, boolToInt(tag.Pinned)); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
+
 func vacuumTag(ctx context.Context, tx *sql.Tx) error {
 	stmt := `DELETE FROM tag WHERE creator_id NOT IN (SELECT id FROM "user")`
 	_, err := tx.ExecContext(ctx, stmt)
@@ -2,10 +2,12 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"slices"
 	"strings"
 
+	"github.com/usememos/memos/plugin/filter"
 	"github.com/usememos/memos/store"
 )
 
@@ -81,12 +83,29 @@ func (d *DB) UpdateUser(ctx context.Context, update *store.UpdateUser) (*store.U
 	return user, nil
 }
 
+// userFilterColumns are the columns of the fields of the user filters.
+var userFilterColumns = map[string]string{
+	"id":         `id`,
+	"username":   `username`,
+	"nickname":   `nickname`,
+	"role":       `role`,
+	"row_status": `row_status`,
+	"created_ts": `created_ts`,
+}
+
 func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User, error) {
 	where, args := []string{"1 = 1"}, []any{}
 
 	if v := find.ID; v != nil {
 		where, args = append(where, "id = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := find.IDList; len(v) != 0 {
+		holders := []string{}
+		for _, id := range v {
+			holders, args = append(holders, placeholder(len(args)+1)), append(args, id)
+		}
+		where = append(where, "id IN ("+strings.Join(holders, ", ")+")")
+	}
 	if v := find.Username; v != nil {
 		where, args = append(where, "username = "+placeholder(len(args)+1)), append(args, *v)
 	}
@@ -99,8 +118,15 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 	if v := find.Nickname; v != nil {
 		where, args = append(where, "nickname = "+placeholder(len(args)+1)), append(args, *v)
 	}
+	if v := filter.And(find.Filter, store.GetUserCursorFilter(find)); v != nil {
+		condition, filterArgs, err := filter.Postgres.ConvertToSQL(v, userFilterColumns, len(args))
+		if err != nil {
+			return nil, err
+		}
+		where, args = append(where, condition), append(args, filterArgs...)
+	}
 
-	orderBy := []string{"created_ts DESC", "row_status DESC"}
+	orderBy := []string{"created_ts DESC", "id DESC"}
 	if find.Random {
 		orderBy = slices.Concat([]string{"RANDOM()"}, orderBy)
 	}
@@ -158,18 +184,50 @@ func (d *DB) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User
 }
 
 func (d *DB) DeleteUser(ctx context.Context, delete *store.DeleteUser) error {
-	result, err := d.db.ExecContext(ctx, `DELETE FROM "user" WHERE id = $1`, delete.ID)
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if delete.TransferToID != nil {
+		if err := transferUserContent(ctx, tx, delete.ID, *delete.TransferToID); err != nil {
+			return err
+		}
+	}
+	result, err := tx.ExecContext(ctx, `DELETE FROM "user" WHERE id = $1`, delete.ID)
 	if err != nil {
 		return err
 	}
 	if _, err := result.RowsAffected(); err != nil {
 		return err
 	}
-
-	if err := d.Vacuum(ctx); err != nil {
-		// Prevent linter warning.
+	// The rows left behind by the user are deleted in the same transaction.
+	if err := vacuumImpl(ctx, tx); err != nil {
+		return err
+	}
+	if err := tx.Commit(); err != nil {
 		return err
 	}
+	return nil
+}
 
+// transferUserContent transfers the content of a user to another user before the user is deleted.
+func transferUserContent(ctx context.Context, tx *sql.Tx, fromID, toID int32) error {
+	stmts := []string{
+		`UPDATE memo SET creator_id = $1 WHERE creator_id = $2`,
+		`UPDATE resource SET creator_id = $1 WHERE creator_id = $2`,
+		`UPDATE memo_share_link SET creator_id = $1 WHERE creator_id = $2`,
+		`UPDATE webhook SET creator_id = $1 WHERE creator_id = $2`,
+		`UPDATE user_group SET creator_id = $1 WHERE creator_id = $2`,
+		`UPDATE memo_view SET creator_id = $1 WHERE creator_id = $2`,
+		// The tags are copied as the user to transfer to may have the same ones, the tags of the user are vacuumed.
+		`INSERT INTO tag (name, creator_id, color, emoji, description, pinned) SELECT name, $1, color, emoji, description, pinned FROM tag WHERE creator_id = $2 ON CONFLICT DO NOTHING`,
+	}
+	for _, stmt := range stmts {
+		if _, err := tx.ExecContext(ctx, stmt, toID, fromID); err != nil {
+			return err
+		}
+	}
 	return nil
 }
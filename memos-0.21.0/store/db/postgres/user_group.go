@@ -0,0 +1,251 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateUserGroup(ctx context.Context, create *store.UserGroup) (*store.UserGroup, error) {
+	fields := []string{"creator_id", "name", "description"}
+	args := []any{create.CreatorID, create.Name, create.Description}
+	stmt := "INSERT INTO user_group (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListUserGroups(ctx context.Context, find *store.FindUserGroup) ([]*store.UserGroup, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "user_group.id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.MemberID; v != nil {
+		where, args = append(where, "user_group.id IN (SELECT group_id FROM user_group_member WHERE user_id = "+placeholder(len(args)+1)+")"), append(args, *v)
+	}
+
+	query := "SELECT id, creator_id, created_ts, updated_ts, name, description FROM user_group WHERE " + strings.Join(where, " AND ") + " ORDER BY name ASC, id ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserGroup{}
+	for rows.Next() {
+		group := &store.UserGroup{}
+		if err := rows.Scan(
+			&group.ID,
+			&group.CreatorID,
+			&group.CreatedTs,
+			&group.UpdatedTs,
+			&group.Name,
+			&group.Description,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, group)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateUserGroup(ctx context.Context, update *store.UpdateUserGroup) (*store.UserGroup, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "updated_ts = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Name; v != nil {
+		set, args = append(set, "name = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := update.Description; v != nil {
+		set, args = append(set, "description = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := "UPDATE user_group SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args)+1) + " RETURNING id, creator_id, created_ts, updated_ts, name, description"
+	args = append(args, update.ID)
+	group := &store.UserGroup{}
+	if err := d.db.QueryRowContext(ctx, query, args...).Scan(
+		&group.ID,
+		&group.CreatorID,
+		&group.CreatedTs,
+		&group.UpdatedTs,
+		&group.Name,
+		&group.Description,
+	); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (d *DB) DeleteUserGroup(ctx context.Context, delete *store.DeleteUserGroup) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_group WHERE id = $1", delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_group_member WHERE group_id = $1", delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM memo_grant WHERE grantee_type = $1 AND grantee_id = $2", store.MemoGranteeGroup, delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM user_group_tag WHERE group_id = $1", delete.ID); err != nil {
+		return err
+	}
+	if _, err := tx.ExecContext(ctx, "DELETE FROM webhook WHERE group_id = $1", delete.ID); err != nil {
+		return err
+	}
+	// The memos of the group space go back to their creators.
+	if _, err := tx.ExecContext(ctx, "UPDATE memo SET group_id = 0 WHERE group_id = $1", delete.ID); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (d *DB) UpsertUserGroupMember(ctx context.Context, upsert *store.UserGroupMember) (*store.UserGroupMember, error) {
+	stmt := `
+		INSERT INTO user_group_member (
+			group_id,
+			user_id,
+			role
+		)
+		VALUES (` + placeholders(3) + `)
+		ON CONFLICT(group_id, user_id) DO UPDATE
+		SET role = EXCLUDED.role
+		RETURNING group_id, user_id, created_ts, role`
+	member := &store.UserGroupMember{}
+	if err := d.db.QueryRowContext(ctx, stmt, upsert.GroupID, upsert.UserID, upsert.Role).Scan(
+		&member.GroupID,
+		&member.UserID,
+		&member.CreatedTs,
+		&member.Role,
+	); err != nil {
+		return nil, err
+	}
+	return member, nil
+}
+
+func (d *DB) ListUserGroupMembers(ctx context.Context, find *store.FindUserGroupMember) ([]*store.UserGroupMember, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.GroupID; v != nil {
+		where, args = append(where, "group_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := find.UserID; v != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+
+	query := "SELECT group_id, user_id, created_ts, role FROM user_group_member WHERE " + strings.Join(where, " AND ") + " ORDER BY created_ts ASC, user_id ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserGroupMember{}
+	for rows.Next() {
+		member := &store.UserGroupMember{}
+		if err := rows.Scan(
+			&member.GroupID,
+			&member.UserID,
+			&member.CreatedTs,
+			&member.Role,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, member)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteUserGroupMember(ctx context.Context, delete *store.DeleteUserGroupMember) error {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := delete.GroupID; v != nil {
+		where, args = append(where, "group_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	if v := delete.UserID; v != nil {
+		where, args = append(where, "user_id = "+placeholder(len(args)+1)), append(args, *v)
+	}
+	result, err := d.db.ExecContext(ctx, "DELETE FROM user_group_member WHERE "+strings.Join(where, " AND "), args...)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (d *DB) UpsertUserGroupTag(ctx context.Context, upsert *store.UserGroupTag) (*store.UserGroupTag, error) {
+	stmt := "INSERT INTO user_group_tag (group_id, name) VALUES ($1, $2) ON CONFLICT(group_id, name) DO NOTHING"
+	if _, err := d.db.ExecContext(ctx, stmt, upsert.GroupID, upsert.Name); err != nil {
+		return nil, err
+	}
+	return upsert, nil
+}
+
+func (d *DB) ListUserGroupTags(ctx context.Context, find *store.FindUserGroupTag) ([]*store.UserGroupTag, error) {
+	rows, err := d.db.QueryContext(ctx, "SELECT group_id, name FROM user_group_tag WHERE group_id = $1 ORDER BY name ASC", find.GroupID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.UserGroupTag{}
+	for rows.Next() {
+		tag := &store.UserGroupTag{}
+		if err := rows.Scan(
+			&tag.GroupID,
+			&tag.Name,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, tag)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) DeleteUserGroupTag(ctx context.Context, delete *store.DeleteUserGroupTag) error {
+	result, err := d.db.ExecContext(ctx, "DELETE FROM user_group_tag WHERE group_id = $1 AND name = $2", delete.GroupID, delete.Name)
+	if err != nil {
+		return err
+	}
+	if _, err := result.RowsAffected(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func vacuumUserGroupMember(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM user_group_member WHERE user_id NOT IN (SELECT id FROM "user") OR group_id NOT IN (SELECT id FROM user_group)`
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+	return nil
+}
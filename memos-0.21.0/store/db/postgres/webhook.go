@@ -2,15 +2,18 @@ package postgres
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"strings"
 
+	"github.com/usememos/memos/plugin/filter"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
 func (d *DB) CreateWebhook(ctx context.Context, create *storepb.Webhook) (*storepb.Webhook, error) {
-	fields := []string{"name", "url", "creator_id"}
-	args := []any{create.Name, create.Url, create.CreatorId}
+	fields := []string{"name", "url", "creator_id", "group_id"}
+	args := []any{create.Name, create.Url, create.CreatorId, create.GroupId}
 	stmt := "INSERT INTO webhook (" + strings.Join(fields, ", ") + ") VALUES (" + placeholders(len(args)) + ") RETURNING id, created_ts, updated_ts, row_status"
 	var rowStatus string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -27,6 +30,11 @@ func (d *DB) CreateWebhook(ctx context.Context, create *storepb.Webhook) (*store
 	return webhook, nil
 }
 
+// webhookFilterColumns are the columns of the fields of the webhook cursors.
+var webhookFilterColumns = map[string]string{
+	"id": "id",
+}
+
 func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*storepb.Webhook, error) {
 	where, args := []string{"1 = 1"}, []any{}
 	if find.ID != nil {
@@ -35,8 +43,19 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 	if find.CreatorID != nil {
 		where, args = append(where, "creator_id = "+placeholder(len(args)+1)), append(args, *find.CreatorID)
 	}
+	if find.GroupID != nil {
+		where, args = append(where, "group_id = "+placeholder(len(args)+1)), append(args, *find.GroupID)
+	}
 
-	rows, err := d.db.QueryContext(ctx, `
+	if v := store.GetWebhookCursorFilter(find); v != nil {
+		condition, cursorArgs, err := filter.Postgres.ConvertToSQL(v, webhookFilterColumns, len(args))
+		if err != nil {
+			return nil, err
+		}
+		where, args = append(where, condition), append(args, cursorArgs...)
+	}
+
+	query := `
 		SELECT
 			id,
 			created_ts,
@@ -44,12 +63,15 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 			row_status,
 			creator_id,
 			name,
-			url
+			url,
+			group_id
 		FROM webhook
-		WHERE `+strings.Join(where, " AND ")+`
-		ORDER BY id DESC`,
-		args...,
-	)
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY id DESC`
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -67,6 +89,7 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 			&webhook.CreatorId,
 			&webhook.Name,
 			&webhook.Url,
+			&webhook.GroupId,
 		); err != nil {
 			return nil, err
 		}
@@ -93,7 +116,7 @@ func (d *DB) UpdateWebhook(ctx context.Context, update *store.UpdateWebhook) (*s
 		set, args = append(set, "url = "+placeholder(len(args)+1)), append(args, *update.URL)
 	}
 
-	stmt := "UPDATE webhook SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args)+1) + " RETURNING id, created_ts, updated_ts, row_status, creator_id, name, url"
+	stmt := "UPDATE webhook SET " + strings.Join(set, ", ") + " WHERE id = " + placeholder(len(args)+1) + " RETURNING id, created_ts, updated_ts, row_status, creator_id, name, url, group_id"
 	args = append(args, update.ID)
 	webhook := &storepb.Webhook{}
 	var rowStatus string
@@ -105,6 +128,7 @@ func (d *DB) UpdateWebhook(ctx context.Context, update *store.UpdateWebhook) (*s
 		&webhook.CreatorId,
 		&webhook.Name,
 		&webhook.Url,
+		&webhook.GroupId,
 	); err != nil {
 		return nil, err
 	}
@@ -116,3 +140,11 @@ func (d *DB) DeleteWebhook(ctx context.Context, delete *store.DeleteWebhook) err
 	_, err := d.db.ExecContext(ctx, "DELETE FROM webhook WHERE id = $1", delete.ID)
 	return err
 }
+
+func vacuumWebhook(ctx context.Context, tx *sql.Tx) error {
+	stmt := `DELETE FROM webhook WHERE creator_id NOT IN (SELECT id FROM "user")`
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+	return nil
+}
@@ -2,6 +2,7 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -81,3 +82,12 @@ func (d *DB) ListActivities(ctx context.Context, find *store.FindActivity) ([]*s
 
 	return list, nil
 }
+
+// vacuumActivity deletes the activities of the deleted users, keeping those of the system bot.
+func vacuumActivity(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `activity` WHERE `creator_id` != ? AND `creator_id` NOT IN (SELECT `id` FROM `user`)"
+	if _, err := tx.ExecContext(ctx, stmt, store.SystemBotID); err != nil {
+		return err
+	}
+	return nil
+}
@@ -13,10 +13,12 @@ func (d *DB) UpsertMemoRelation(ctx context.Context, create *store.MemoRelation)
 		INSERT INTO memo_relation (
 			memo_id,
 			related_memo_id,
-			type
+			type,
+			source
 		)
-		VALUES (?, ?, ?)
-		RETURNING memo_id, related_memo_id, type
+		VALUES (?, ?, ?, ?)
+		ON CONFLICT(memo_id, related_memo_id, type) DO UPDATE SET source = EXCLUDED.source
+		RETURNING memo_id, related_memo_id, type, source
 	`
 	memoRelation := &store.MemoRelation{}
 	if err := d.db.QueryRowContext(
@@ -25,10 +27,12 @@ func (d *DB) UpsertMemoRelation(ctx context.Context, create *store.MemoRelation)
 		create.MemoID,
 		create.RelatedMemoID,
 		create.Type,
+		create.Source,
 	).Scan(
 		&memoRelation.MemoID,
 		&memoRelation.RelatedMemoID,
 		&memoRelation.Type,
+		&memoRelation.Source,
 	); err != nil {
 		return nil, err
 	}
@@ -44,15 +48,33 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 	if find.RelatedMemoID != nil {
 		where, args = append(where, "related_memo_id = ?"), append(args, find.RelatedMemoID)
 	}
+	if v := find.MemoIDList; len(v) != 0 {
+		placeholder := []string{}
+		for _, memoID := range v {
+			placeholder, args = append(placeholder, "?"), append(args, memoID)
+		}
+		where = append(where, "memo_id IN ("+strings.Join(placeholder, ", ")+")")
+	}
+	if v := find.RelatedMemoIDList; len(v) != 0 {
+		placeholder := []string{}
+		for _, memoID := range v {
+			placeholder, args = append(placeholder, "?"), append(args, memoID)
+		}
+		where = append(where, "related_memo_id IN ("+strings.Join(placeholder, ", ")+")")
+	}
 	if find.Type != nil {
 		where, args = append(where, "type = ?"), append(args, find.Type)
 	}
+	if find.Source != nil {
+		where, args = append(where, "source = ?"), append(args, find.Source)
+	}
 
 	rows, err := d.db.QueryContext(ctx, `
 		SELECT
 			memo_id,
 			related_memo_id,
-			type
+			type,
+			source
 		FROM memo_relation
 		WHERE `+strings.Join(where, " AND "), args...)
 	if err != nil {
@@ -67,6 +89,7 @@ func (d *DB) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation
 			&memoRelation.MemoID,
 			&memoRelation.RelatedMemoID,
 			&memoRelation.Type,
+			&memoRelation.Source,
 		); err != nil {
 			return nil, err
 		}
@@ -91,6 +114,9 @@ func (d *DB) DeleteMemoRelation(ctx context.Context, delete *store.DeleteMemoRel
 	if delete.Type != nil {
 		where, args = append(where, "type = ?"), append(args, delete.Type)
 	}
+	if delete.Source != nil {
+		where, args = append(where, "source = ?"), append(args, delete.Source)
+	}
 	stmt := `
 		DELETE FROM memo_relation
 		WHERE ` + strings.Join(where, " AND ")
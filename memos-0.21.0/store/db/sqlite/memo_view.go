@@ -0,0 +1,121 @@
+package sqlite
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+
+	"github.com/usememos/memos/store"
+)
+
+func (d *DB) CreateMemoView(ctx context.Context, create *store.MemoView) (*store.MemoView, error) {
+	fields := []string{"`creator_id`", "`name`", "`filter`", "`order_by`", "`visibility`"}
+	placeholder := []string{"?", "?", "?", "?", "?"}
+	args := []any{create.CreatorID, create.Name, create.Filter, create.OrderBy, create.Visibility}
+
+	stmt := "INSERT INTO `memo_view` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`"
+	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
+		&create.ID,
+		&create.CreatedTs,
+		&create.UpdatedTs,
+	); err != nil {
+		return nil, err
+	}
+
+	return create, nil
+}
+
+func (d *DB) ListMemoViews(ctx context.Context, find *store.FindMemoView) ([]*store.MemoView, error) {
+	where, args := []string{"1 = 1"}, []any{}
+	if v := find.ID; v != nil {
+		where, args = append(where, "`id` = ?"), append(args, *v)
+	}
+	if v := find.CreatorID; v != nil {
+		where, args = append(where, "`creator_id` = ?"), append(args, *v)
+	}
+	if v := find.VisibleToUserID; v != nil {
+		where, args = append(where, "(`creator_id` = ? OR `visibility` = ?)"), append(args, *v, store.MemoViewWorkspace)
+	}
+
+	query := "SELECT `id`, `creator_id`, `created_ts`, `updated_ts`, `name`, `filter`, `order_by`, `visibility` FROM `memo_view` WHERE " + strings.Join(where, " AND ") + " ORDER BY `name` ASC, `id` ASC"
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	list := []*store.MemoView{}
+	for rows.Next() {
+		view := &store.MemoView{}
+		if err := rows.Scan(
+			&view.ID,
+			&view.CreatorID,
+			&view.CreatedTs,
+			&view.UpdatedTs,
+			&view.Name,
+			&view.Filter,
+			&view.OrderBy,
+			&view.Visibility,
+		); err != nil {
+			return nil, err
+		}
+		list = append(list, view)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (d *DB) UpdateMemoView(ctx context.Context, update *store.UpdateMemoView) (*store.MemoView, error) {
+	set, args := []string{}, []any{}
+	if v := update.UpdatedTs; v != nil {
+		set, args = append(set, "`updated_ts` = ?"), append(args, *v)
+	}
+	if v := update.Name; v != nil {
+		set, args = append(set, "`name` = ?"), append(args, *v)
+	}
+	if v := update.Filter; v != nil {
+		set, args = append(set, "`filter` = ?"), append(args, *v)
+	}
+	if v := update.OrderBy; v != nil {
+		set, args = append(set, "`order_by` = ?"), append(args, *v)
+	}
+	if v := update.Visibility; v != nil {
+		set, args = append(set, "`visibility` = ?"), append(args, *v)
+	}
+	args = append(args, update.ID)
+
+	query := "UPDATE `memo_view` SET " + strings.Join(set, ", ") + " WHERE `id` = ? RETURNING `id`, `creator_id`, `created_ts`, `updated_ts`, `name`, `filter`, `order_by`, `visibility`"
+	view := &store.MemoView{}
+	if err := d.db.QueryRowContext(ctx, query, args...).Scan(
+		&view.ID,
+		&view.CreatorID,
+		&view.CreatedTs,
+		&view.UpdatedTs,
+		&view.Name,
+		&view.Filter,
+		&view.OrderBy,
+		&view.Visibility,
+	); err != nil {
+		return nil, err
+	}
+	return view, nil
+}
+
+func (d *DB) DeleteMemoView(ctx context.Context, delete *store.DeleteMemoView) error {
+	if _, err := d.db.ExecContext(ctx, "DELETE FROM `memo_view` WHERE `id` = ?", delete.ID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func vacuumMemoView(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `memo_view` WHERE `creator_id` NOT IN (SELECT `id` FROM `user`)"
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+	return nil
+}
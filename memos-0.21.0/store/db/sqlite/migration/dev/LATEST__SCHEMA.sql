@@ -19,7 +19,7 @@ CREATE TABLE user (
   updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
   row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
   username TEXT NOT NULL UNIQUE,
-  role TEXT NOT NULL CHECK (role IN ('HOST', 'ADMIN', 'USER')) DEFAULT 'USER',
+  role TEXT NOT NULL DEFAULT 'USER',
   email TEXT NOT NULL DEFAULT '',
   nickname TEXT NOT NULL DEFAULT '',
   password_hash TEXT NOT NULL,
@@ -37,6 +37,34 @@ CREATE TABLE user_setting (
   UNIQUE(user_id, key)
 );
 
+-- user_group
+CREATE TABLE user_group (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL,
+  description TEXT NOT NULL DEFAULT ''
+);
+
+-- user_group_member
+CREATE TABLE user_group_member (
+  group_id INTEGER NOT NULL,
+  user_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  role TEXT NOT NULL CHECK (role IN ('ADMIN', 'MEMBER')) DEFAULT 'MEMBER',
+  UNIQUE(group_id, user_id)
+);
+
+CREATE INDEX idx_user_group_member_user_id ON user_group_member (user_id);
+
+-- user_group_tag
+CREATE TABLE user_group_tag (
+  group_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  UNIQUE(group_id, name)
+);
+
 -- memo
 CREATE TABLE memo (
   id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -46,10 +74,13 @@ CREATE TABLE memo (
   updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
   row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
   content TEXT NOT NULL DEFAULT '',
-  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE'
+  visibility TEXT NOT NULL CHECK (visibility IN ('PUBLIC', 'PROTECTED', 'PRIVATE')) DEFAULT 'PRIVATE',
+  group_id INTEGER NOT NULL DEFAULT 0,
+  encrypted INTEGER NOT NULL CHECK (encrypted IN (0, 1)) DEFAULT 0
 );
 
 CREATE INDEX idx_memo_creator_id ON memo (creator_id);
+CREATE INDEX idx_memo_group_id ON memo (group_id);
 CREATE INDEX idx_memo_content ON memo (content);
 CREATE INDEX idx_memo_visibility ON memo (visibility);
 
@@ -61,14 +92,90 @@ CREATE TABLE memo_organizer (
   UNIQUE(memo_id, user_id)
 );
 
+-- memo_tag
+CREATE TABLE memo_tag (
+  memo_id INTEGER NOT NULL,
+  tag TEXT NOT NULL,
+  UNIQUE(memo_id, tag)
+);
+
+CREATE INDEX idx_memo_tag_tag ON memo_tag (tag);
+
+-- memo_task
+CREATE TABLE memo_task (
+  memo_id INTEGER NOT NULL,
+  position INTEGER NOT NULL,
+  content TEXT NOT NULL,
+  completed INTEGER NOT NULL CHECK (completed IN (0, 1)) DEFAULT 0,
+  due_ts BIGINT NOT NULL DEFAULT 0,
+  assignee_id INTEGER NOT NULL DEFAULT 0,
+  overdue_notified INTEGER NOT NULL CHECK (overdue_notified IN (0, 1)) DEFAULT 0,
+  UNIQUE(memo_id, position)
+);
+
+CREATE INDEX idx_memo_task_assignee_id ON memo_task (assignee_id);
+
+-- memo_property
+CREATE TABLE memo_property (
+  memo_id INTEGER NOT NULL,
+  key TEXT NOT NULL,
+  type TEXT NOT NULL CHECK (type IN ('STRING', 'NUMBER', 'BOOLEAN', 'DATE', 'URL')),
+  value TEXT NOT NULL,
+  number_value REAL,
+  UNIQUE(memo_id, key)
+);
+
+-- memo_view
+CREATE TABLE memo_view (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL,
+  filter TEXT NOT NULL DEFAULT '',
+  order_by TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE')) DEFAULT 'PRIVATE'
+);
+
+CREATE INDEX idx_memo_view_creator_id ON memo_view (creator_id);
+
 -- memo_relation
 CREATE TABLE memo_relation (
   memo_id INTEGER NOT NULL,
   related_memo_id INTEGER NOT NULL,
   type TEXT NOT NULL,
+  source TEXT NOT NULL CHECK (source IN ('MANUAL', 'CONTENT')) DEFAULT 'MANUAL',
   UNIQUE(memo_id, related_memo_id, type)
 );
 
+-- memo_grant
+CREATE TABLE memo_grant (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  memo_id INTEGER NOT NULL,
+  grantee_type TEXT NOT NULL CHECK (grantee_type IN ('USER', 'GROUP')),
+  grantee_id INTEGER NOT NULL,
+  role TEXT NOT NULL CHECK (role IN ('VIEWER', 'COMMENTER', 'EDITOR')) DEFAULT 'VIEWER',
+  UNIQUE(memo_id, grantee_type, grantee_id)
+);
+
+CREATE INDEX idx_memo_grant_grantee ON memo_grant (grantee_type, grantee_id);
+
+-- memo_share_link
+CREATE TABLE memo_share_link (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  memo_id INTEGER NOT NULL,
+  token TEXT NOT NULL UNIQUE,
+  expires_ts BIGINT NOT NULL DEFAULT 0,
+  max_views INTEGER NOT NULL DEFAULT 0,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  password_hash TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_memo_share_link_memo_id ON memo_share_link (memo_id);
+
 -- resource
 CREATE TABLE resource (
   id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -93,6 +200,10 @@ CREATE INDEX idx_resource_memo_id ON resource (memo_id);
 CREATE TABLE tag (
   name TEXT NOT NULL,
   creator_id INTEGER NOT NULL,
+  color TEXT NOT NULL DEFAULT '',
+  emoji TEXT NOT NULL DEFAULT '',
+  description TEXT NOT NULL DEFAULT '',
+  pinned INTEGER NOT NULL CHECK (pinned IN (0, 1)) DEFAULT 0,
   UNIQUE(name, creator_id)
 );
 
@@ -106,6 +217,35 @@ CREATE TABLE activity (
   payload TEXT NOT NULL DEFAULT '{}'
 );
 
+-- audit_log
+CREATE TABLE audit_log (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  actor_id INTEGER NOT NULL DEFAULT 0,
+  action TEXT NOT NULL,
+  target TEXT NOT NULL DEFAULT '',
+  ip TEXT NOT NULL DEFAULT '',
+  payload TEXT NOT NULL DEFAULT '{}'
+);
+
+CREATE INDEX idx_audit_log_created_ts ON audit_log (created_ts);
+
+-- invitation
+CREATE TABLE invitation (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  code TEXT NOT NULL UNIQUE,
+  role TEXT NOT NULL DEFAULT 'USER',
+  group_id INTEGER NOT NULL DEFAULT 0,
+  max_uses INTEGER NOT NULL DEFAULT 0,
+  use_count INTEGER NOT NULL DEFAULT 0,
+  expires_ts BIGINT NOT NULL DEFAULT 0,
+  revoked_ts BIGINT NOT NULL DEFAULT 0
+);
+
+CREATE INDEX idx_invitation_creator_id ON invitation (creator_id);
+
 -- storage
 CREATE TABLE storage (
   id INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -141,7 +281,8 @@ CREATE TABLE webhook (
   row_status TEXT NOT NULL CHECK (row_status IN ('NORMAL', 'ARCHIVED')) DEFAULT 'NORMAL',
   creator_id INTEGER NOT NULL,
   name TEXT NOT NULL,
-  url TEXT NOT NULL
+  url TEXT NOT NULL,
+  group_id INTEGER NOT NULL DEFAULT 0
 );
 
 CREATE INDEX idx_webhook_creator_id ON webhook (creator_id);
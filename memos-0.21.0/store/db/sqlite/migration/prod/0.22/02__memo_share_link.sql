@@ -0,0 +1,13 @@
+CREATE TABLE memo_share_link (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  memo_id INTEGER NOT NULL,
+  token TEXT NOT NULL UNIQUE,
+  expires_ts BIGINT NOT NULL DEFAULT 0,
+  max_views INTEGER NOT NULL DEFAULT 0,
+  view_count INTEGER NOT NULL DEFAULT 0,
+  password_hash TEXT NOT NULL DEFAULT ''
+);
+
+CREATE INDEX idx_memo_share_link_memo_id ON memo_share_link (memo_id);
@@ -0,0 +1,13 @@
+ALTER TABLE user_group_member ADD COLUMN role TEXT NOT NULL CHECK (role IN ('ADMIN', 'MEMBER')) DEFAULT 'MEMBER';
+
+CREATE TABLE user_group_tag (
+  group_id INTEGER NOT NULL,
+  name TEXT NOT NULL,
+  UNIQUE(group_id, name)
+);
+
+ALTER TABLE memo ADD COLUMN group_id INTEGER NOT NULL DEFAULT 0;
+
+CREATE INDEX idx_memo_group_id ON memo (group_id);
+
+ALTER TABLE webhook ADD COLUMN group_id INTEGER NOT NULL DEFAULT 0;
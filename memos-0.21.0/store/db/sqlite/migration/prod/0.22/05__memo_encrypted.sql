@@ -0,0 +1 @@
+ALTER TABLE memo ADD COLUMN encrypted INTEGER NOT NULL CHECK (encrypted IN (0, 1)) DEFAULT 0;
@@ -0,0 +1,4 @@
+ALTER TABLE tag ADD COLUMN color TEXT NOT NULL DEFAULT '';
+ALTER TABLE tag ADD COLUMN emoji TEXT NOT NULL DEFAULT '';
+ALTER TABLE tag ADD COLUMN description TEXT NOT NULL DEFAULT '';
+ALTER TABLE tag ADD COLUMN pinned INTEGER NOT NULL CHECK (pinned IN (0, 1)) DEFAULT 0;
@@ -0,0 +1,12 @@
+CREATE TABLE memo_view (
+  id INTEGER PRIMARY KEY AUTOINCREMENT,
+  creator_id INTEGER NOT NULL,
+  created_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  updated_ts BIGINT NOT NULL DEFAULT (strftime('%s', 'now')),
+  name TEXT NOT NULL,
+  filter TEXT NOT NULL DEFAULT '',
+  order_by TEXT NOT NULL DEFAULT '',
+  visibility TEXT NOT NULL CHECK (visibility IN ('PRIVATE', 'WORKSPACE')) DEFAULT 'PRIVATE'
+);
+
+CREATE INDEX idx_memo_view_creator_id ON memo_view (creator_id);
@@ -0,0 +1 @@
+ALTER TABLE memo_relation ADD COLUMN source TEXT NOT NULL CHECK (source IN ('MANUAL', 'CONTENT')) DEFAULT 'MANUAL';
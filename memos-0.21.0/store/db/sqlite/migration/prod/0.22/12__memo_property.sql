@@ -0,0 +1,8 @@
+CREATE TABLE memo_property (
+  memo_id INTEGER NOT NULL,
+  key TEXT NOT NULL,
+  type TEXT NOT NULL CHECK (type IN ('STRING', 'NUMBER', 'BOOLEAN', 'DATE', 'URL')),
+  value TEXT NOT NULL,
+  number_value REAL,
+  UNIQUE(memo_id, key)
+);
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/usememos/memos/plugin/filter"
 	"github.com/usememos/memos/store"
 )
 
@@ -22,6 +23,19 @@ func (d *DB) CreateResource(ctx context.Context, create *store.Resource) (*store
 	return create, nil
 }
 
+// resourceFilterColumns are the columns of the fields of the resource filters.
+var resourceFilterColumns = map[string]string{
+	"id":         "`id`",
+	"uid":        "`uid`",
+	"creator_id": "`creator_id`",
+	"created_ts": "`created_ts`",
+	"updated_ts": "`updated_ts`",
+	"filename":   "`filename`",
+	"type":       "`type`",
+	"size":       "`size`",
+	"memo_id":    "`memo_id`",
+}
+
 func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*store.Resource, error) {
 	where, args := []string{"1 = 1"}, []any{}
 
@@ -40,16 +54,30 @@ func (d *DB) ListResources(ctx context.Context, find *store.FindResource) ([]*st
 	if v := find.MemoID; v != nil {
 		where, args = append(where, "`memo_id` = ?"), append(args, *v)
 	}
+	if v := find.MemoIDList; len(v) != 0 {
+		placeholder := []string{}
+		for _, memoID := range v {
+			placeholder, args = append(placeholder, "?"), append(args, memoID)
+		}
+		where = append(where, "`memo_id` IN ("+strings.Join(placeholder, ", ")+")")
+	}
 	if find.HasRelatedMemo {
 		where = append(where, "`memo_id` IS NOT NULL")
 	}
+	if v := filter.And(find.Filter, store.GetResourceCursorFilter(find)); v != nil {
+		condition, filterArgs, err := filter.SQLite.ConvertToSQL(v, resourceFilterColumns, len(args))
+		if err != nil {
+			return nil, err
+		}
+		where, args = append(where, condition), append(args, filterArgs...)
+	}
 
 	fields := []string{"`id`", "`uid`", "`filename`", "`external_link`", "`type`", "`size`", "`creator_id`", "`created_ts`", "`updated_ts`", "`internal_path`", "`memo_id`"}
 	if find.GetBlob {
 		fields = append(fields, "`blob`")
 	}
 
-	query := fmt.Sprintf("SELECT %s FROM `resource` WHERE %s ORDER BY `updated_ts` DESC, `created_ts` DESC", strings.Join(fields, ", "), strings.Join(where, " AND "))
+	query := fmt.Sprintf("SELECT %s FROM `resource` WHERE %s ORDER BY `updated_ts` DESC, `id` DESC", strings.Join(fields, ", "), strings.Join(where, " AND "))
 	if find.Limit != nil {
 		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
 		if find.Offset != nil {
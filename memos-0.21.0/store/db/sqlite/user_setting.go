@@ -28,6 +28,30 @@ func (d *DB) UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting)
 			return nil, err
 		}
 		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_SESSIONS {
+		valueBytes, err := protojson.Marshal(upsert.GetSessions())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_EMAIL_VERIFICATION {
+		valueBytes, err := protojson.Marshal(upsert.GetEmailVerification())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_PASSWORD {
+		valueBytes, err := protojson.Marshal(upsert.GetPassword())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_SUSPENSION {
+		valueBytes, err := protojson.Marshal(upsert.GetSuspension())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
 	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_LOCALE {
 		valueString = upsert.GetLocale()
 	} else if upsert.Key == storepb.UserSettingKey_USER_SETTING_APPEARANCE {
@@ -90,6 +114,38 @@ func (d *DB) ListUserSettings(ctx context.Context, find *store.FindUserSetting)
 			userSetting.Value = &storepb.UserSetting_AccessTokens{
 				AccessTokens: accessTokensUserSetting,
 			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_SESSIONS {
+			sessionsUserSetting := &storepb.SessionsUserSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), sessionsUserSetting); err != nil {
+				return nil, err
+			}
+			userSetting.Value = &storepb.UserSetting_Sessions{
+				Sessions: sessionsUserSetting,
+			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_EMAIL_VERIFICATION {
+			emailVerificationUserSetting := &storepb.EmailVerificationUserSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), emailVerificationUserSetting); err != nil {
+				return nil, err
+			}
+			userSetting.Value = &storepb.UserSetting_EmailVerification{
+				EmailVerification: emailVerificationUserSetting,
+			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_PASSWORD {
+			passwordUserSetting := &storepb.PasswordUserSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), passwordUserSetting); err != nil {
+				return nil, err
+			}
+			userSetting.Value = &storepb.UserSetting_Password{
+				Password: passwordUserSetting,
+			}
+		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_SUSPENSION {
+			suspensionUserSetting := &storepb.SuspensionUserSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), suspensionUserSetting); err != nil {
+				return nil, err
+			}
+			userSetting.Value = &storepb.UserSetting_Suspension{
+				Suspension: suspensionUserSetting,
+			}
 		} else if userSetting.Key == storepb.UserSettingKey_USER_SETTING_LOCALE {
 			userSetting.Value = &storepb.UserSetting_Locale{
 				Locale: valueString,
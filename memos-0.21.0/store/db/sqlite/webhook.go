@@ -2,16 +2,19 @@ package sqlite
 
 import (
 	"context"
+	"database/sql"
+	"fmt"
 	"strings"
 
+	"github.com/usememos/memos/plugin/filter"
 	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
 func (d *DB) CreateWebhook(ctx context.Context, create *storepb.Webhook) (*storepb.Webhook, error) {
-	fields := []string{"`name`", "`url`", "`creator_id`"}
-	placeholder := []string{"?", "?", "?"}
-	args := []any{create.Name, create.Url, create.CreatorId}
+	fields := []string{"`name`", "`url`", "`creator_id`", "`group_id`"}
+	placeholder := []string{"?", "?", "?", "?"}
+	args := []any{create.Name, create.Url, create.CreatorId, create.GroupId}
 	stmt := "INSERT INTO `webhook` (" + strings.Join(fields, ", ") + ") VALUES (" + strings.Join(placeholder, ", ") + ") RETURNING `id`, `created_ts`, `updated_ts`, `row_status`"
 	var rowStatus string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -28,6 +31,11 @@ func (d *DB) CreateWebhook(ctx context.Context, create *storepb.Webhook) (*store
 	return webhook, nil
 }
 
+// webhookFilterColumns are the columns of the fields of the webhook cursors.
+var webhookFilterColumns = map[string]string{
+	"id": "`id`",
+}
+
 func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*storepb.Webhook, error) {
 	where, args := []string{"1 = 1"}, []any{}
 	if find.ID != nil {
@@ -36,8 +44,19 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 	if find.CreatorID != nil {
 		where, args = append(where, "`creator_id` = ?"), append(args, *find.CreatorID)
 	}
+	if find.GroupID != nil {
+		where, args = append(where, "`group_id` = ?"), append(args, *find.GroupID)
+	}
 
-	rows, err := d.db.QueryContext(ctx, `
+	if v := store.GetWebhookCursorFilter(find); v != nil {
+		condition, cursorArgs, err := filter.SQLite.ConvertToSQL(v, webhookFilterColumns, len(args))
+		if err != nil {
+			return nil, err
+		}
+		where, args = append(where, condition), append(args, cursorArgs...)
+	}
+
+	query := `
 		SELECT
 			id,
 			created_ts,
@@ -45,12 +64,15 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 			row_status,
 			creator_id,
 			name,
-			url
+			url,
+			group_id
 		FROM webhook
-		WHERE `+strings.Join(where, " AND ")+`
-		ORDER BY id DESC`,
-		args...,
-	)
+		WHERE ` + strings.Join(where, " AND ") + `
+		ORDER BY id DESC`
+	if find.Limit != nil {
+		query = fmt.Sprintf("%s LIMIT %d", query, *find.Limit)
+	}
+	rows, err := d.db.QueryContext(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +90,7 @@ func (d *DB) ListWebhooks(ctx context.Context, find *store.FindWebhook) ([]*stor
 			&webhook.CreatorId,
 			&webhook.Name,
 			&webhook.Url,
+			&webhook.GroupId,
 		); err != nil {
 			return nil, err
 		}
@@ -95,7 +118,7 @@ func (d *DB) UpdateWebhook(ctx context.Context, update *store.UpdateWebhook) (*s
 	}
 	args = append(args, update.ID)
 
-	stmt := "UPDATE `webhook` SET " + strings.Join(set, ", ") + " WHERE `id` = ? RETURNING `id`, `created_ts`, `updated_ts`, `row_status`, `creator_id`, `name`, `url`"
+	stmt := "UPDATE `webhook` SET " + strings.Join(set, ", ") + " WHERE `id` = ? RETURNING `id`, `created_ts`, `updated_ts`, `row_status`, `creator_id`, `name`, `url`, `group_id`"
 	webhook := &storepb.Webhook{}
 	var rowStatus string
 	if err := d.db.QueryRowContext(ctx, stmt, args...).Scan(
@@ -106,6 +129,7 @@ func (d *DB) UpdateWebhook(ctx context.Context, update *store.UpdateWebhook) (*s
 		&webhook.CreatorId,
 		&webhook.Name,
 		&webhook.Url,
+		&webhook.GroupId,
 	); err != nil {
 		return nil, err
 	}
@@ -117,3 +141,11 @@ func (d *DB) DeleteWebhook(ctx context.Context, delete *store.DeleteWebhook) err
 	_, err := d.db.ExecContext(ctx, "DELETE FROM `webhook` WHERE `id` = ?", delete.ID)
 	return err
 }
+
+func vacuumWebhook(ctx context.Context, tx *sql.Tx) error {
+	stmt := "DELETE FROM `webhook` WHERE `creator_id` NOT IN (SELECT `id` FROM `user`)"
+	if _, err := tx.ExecContext(ctx, stmt); err != nil {
+		return err
+	}
+	return nil
+}
@@ -89,6 +89,24 @@ func (d *DB) UpsertWorkspaceSettingV1(ctx context.Context, upsert *storepb.Works
 			return nil, err
 		}
 		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ROLES {
+		valueBytes, err := protojson.Marshal(upsert.GetRoles())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SMTP {
+		valueBytes, err := protojson.Marshal(upsert.GetSmtp())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
+	} else if upsert.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_PASSWORD_POLICY {
+		valueBytes, err := protojson.Marshal(upsert.GetPasswordPolicy())
+		if err != nil {
+			return nil, err
+		}
+		valueString = string(valueBytes)
 	}
 	if _, err := d.db.ExecContext(ctx, stmt, upsert.Key.String(), valueString); err != nil {
 		return nil, err
@@ -126,6 +144,24 @@ func (d *DB) ListWorkspaceSettingsV1(ctx context.Context, find *store.FindWorksp
 				return nil, err
 			}
 			workspaceSetting.Value = &storepb.WorkspaceSetting_General{General: generalSetting}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ROLES {
+			rolesSetting := &storepb.WorkspaceRolesSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), rolesSetting); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_Roles{Roles: rolesSetting}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SMTP {
+			smtpSetting := &storepb.WorkspaceSmtpSetting{}
+			if err := protojson.Unmarshal([]byte(valueString), smtpSetting); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_Smtp{Smtp: smtpSetting}
+		} else if workspaceSetting.Key == storepb.WorkspaceSettingKey_WORKSPACE_SETTING_PASSWORD_POLICY {
+			passwordPolicySetting := &storepb.WorkspacePasswordPolicySetting{}
+			if err := protojson.Unmarshal([]byte(valueString), passwordPolicySetting); err != nil {
+				return nil, err
+			}
+			workspaceSetting.Value = &storepb.WorkspaceSetting_PasswordPolicy{PasswordPolicy: passwordPolicySetting}
 		} else {
 			// Skip unknown workspace setting key.
 			continue
@@ -27,6 +27,10 @@ type Driver interface {
 	CreateActivity(ctx context.Context, create *Activity) (*Activity, error)
 	ListActivities(ctx context.Context, find *FindActivity) ([]*Activity, error)
 
+	// AuditLog model related methods.
+	CreateAuditLog(ctx context.Context, create *AuditLog) (*AuditLog, error)
+	ListAuditLogs(ctx context.Context, find *FindAuditLog) ([]*AuditLog, error)
+
 	// Resource model related methods.
 	CreateResource(ctx context.Context, create *Resource) (*Resource, error)
 	ListResources(ctx context.Context, find *FindResource) ([]*Resource, error)
@@ -39,11 +43,42 @@ type Driver interface {
 	UpdateMemo(ctx context.Context, update *UpdateMemo) error
 	DeleteMemo(ctx context.Context, delete *DeleteMemo) error
 
+	// MemoTag model related methods.
+	SetMemoTags(ctx context.Context, set *SetMemoTags) error
+	ListMemoTags(ctx context.Context, find *FindMemoTag) ([]*MemoTag, error)
+	ListMemoTagCounts(ctx context.Context, find *FindMemoTagCount) ([]*MemoTagCount, error)
+
+	// MemoTask model related methods.
+	SetMemoTasks(ctx context.Context, set *SetMemoTasks) error
+	ListMemoTasks(ctx context.Context, find *FindMemoTask) ([]*MemoTask, error)
+	UpdateMemoTask(ctx context.Context, update *UpdateMemoTask) error
+
+	// MemoProperty model related methods.
+	SetMemoProperties(ctx context.Context, set *SetMemoProperties) error
+	ListMemoProperties(ctx context.Context, find *FindMemoProperty) ([]*MemoProperty, error)
+
 	// MemoRelation model related methods.
 	UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error)
 	ListMemoRelations(ctx context.Context, find *FindMemoRelation) ([]*MemoRelation, error)
 	DeleteMemoRelation(ctx context.Context, delete *DeleteMemoRelation) error
 
+	// MemoGrant model related methods.
+	UpsertMemoGrant(ctx context.Context, upsert *MemoGrant) (*MemoGrant, error)
+	ListMemoGrants(ctx context.Context, find *FindMemoGrant) ([]*MemoGrant, error)
+	DeleteMemoGrant(ctx context.Context, delete *DeleteMemoGrant) error
+
+	// Invitation model related methods.
+	CreateInvitation(ctx context.Context, create *Invitation) (*Invitation, error)
+	ListInvitations(ctx context.Context, find *FindInvitation) ([]*Invitation, error)
+	UpdateInvitation(ctx context.Context, update *UpdateInvitation) (*Invitation, error)
+	IncreaseInvitationUseCount(ctx context.Context, id int32, ts int64) (bool, error)
+
+	// MemoShareLink model related methods.
+	CreateMemoShareLink(ctx context.Context, create *MemoShareLink) (*MemoShareLink, error)
+	ListMemoShareLinks(ctx context.Context, find *FindMemoShareLink) ([]*MemoShareLink, error)
+	IncreaseMemoShareLinkViewCount(ctx context.Context, id int32) (bool, error)
+	DeleteMemoShareLink(ctx context.Context, delete *DeleteMemoShareLink) error
+
 	// MemoOrganizer model related methods.
 	UpsertMemoOrganizer(ctx context.Context, upsert *MemoOrganizer) (*MemoOrganizer, error)
 	ListMemoOrganizer(ctx context.Context, find *FindMemoOrganizer) ([]*MemoOrganizer, error)
@@ -62,6 +97,18 @@ type Driver interface {
 	ListUsers(ctx context.Context, find *FindUser) ([]*User, error)
 	DeleteUser(ctx context.Context, delete *DeleteUser) error
 
+	// UserGroup model related methods.
+	CreateUserGroup(ctx context.Context, create *UserGroup) (*UserGroup, error)
+	ListUserGroups(ctx context.Context, find *FindUserGroup) ([]*UserGroup, error)
+	UpdateUserGroup(ctx context.Context, update *UpdateUserGroup) (*UserGroup, error)
+	DeleteUserGroup(ctx context.Context, delete *DeleteUserGroup) error
+	UpsertUserGroupMember(ctx context.Context, upsert *UserGroupMember) (*UserGroupMember, error)
+	ListUserGroupMembers(ctx context.Context, find *FindUserGroupMember) ([]*UserGroupMember, error)
+	DeleteUserGroupMember(ctx context.Context, delete *DeleteUserGroupMember) error
+	UpsertUserGroupTag(ctx context.Context, upsert *UserGroupTag) (*UserGroupTag, error)
+	ListUserGroupTags(ctx context.Context, find *FindUserGroupTag) ([]*UserGroupTag, error)
+	DeleteUserGroupTag(ctx context.Context, delete *DeleteUserGroupTag) error
+
 	// UserSetting model related methods.
 	UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetting) (*storepb.UserSetting, error)
 	ListUserSettings(ctx context.Context, find *FindUserSetting) ([]*storepb.UserSetting, error)
@@ -72,10 +119,18 @@ type Driver interface {
 	UpdateIdentityProvider(ctx context.Context, update *UpdateIdentityProvider) (*IdentityProvider, error)
 	DeleteIdentityProvider(ctx context.Context, delete *DeleteIdentityProvider) error
 
+	// MemoView model related methods.
+	CreateMemoView(ctx context.Context, create *MemoView) (*MemoView, error)
+	ListMemoViews(ctx context.Context, find *FindMemoView) ([]*MemoView, error)
+	UpdateMemoView(ctx context.Context, update *UpdateMemoView) (*MemoView, error)
+	DeleteMemoView(ctx context.Context, delete *DeleteMemoView) error
+
 	// Tag model related methods.
 	UpsertTag(ctx context.Context, upsert *Tag) (*Tag, error)
 	ListTags(ctx context.Context, find *FindTag) ([]*Tag, error)
+	UpdateTag(ctx context.Context, update *UpdateTag) error
 	DeleteTag(ctx context.Context, delete *DeleteTag) error
+	MoveTags(ctx context.Context, move *MoveTags) error
 
 	// Storage model related methods.
 	CreateStorage(ctx context.Context, create *Storage) (*Storage, error)
@@ -59,10 +59,19 @@ type DeleteIdentityProvider struct {
 }
 
 func (s *Store) CreateIdentityProvider(ctx context.Context, create *IdentityProvider) (*IdentityProvider, error) {
-	identityProvider, err := s.driver.CreateIdentityProvider(ctx, create)
+	config, err := s.encryptIdentityProviderConfig(create.Config)
 	if err != nil {
 		return nil, err
 	}
+	encryptedCreate := *create
+	encryptedCreate.Config = config
+	identityProvider, err := s.driver.CreateIdentityProvider(ctx, &encryptedCreate)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.decryptIdentityProviderConfig(identityProvider.Config); err != nil {
+		return nil, err
+	}
 
 	s.idpCache.Store(identityProvider.ID, identityProvider)
 	return identityProvider, nil
@@ -75,6 +84,9 @@ func (s *Store) ListIdentityProviders(ctx context.Context, find *FindIdentityPro
 	}
 
 	for _, item := range identityProviders {
+		if err := s.decryptIdentityProviderConfig(item.Config); err != nil {
+			return nil, err
+		}
 		s.idpCache.Store(item.ID, item)
 	}
 	return identityProviders, nil
@@ -100,10 +112,19 @@ func (s *Store) GetIdentityProvider(ctx context.Context, find *FindIdentityProvi
 }
 
 func (s *Store) UpdateIdentityProvider(ctx context.Context, update *UpdateIdentityProvider) (*IdentityProvider, error) {
-	identityProvider, err := s.driver.UpdateIdentityProvider(ctx, update)
+	config, err := s.encryptIdentityProviderConfig(update.Config)
 	if err != nil {
 		return nil, err
 	}
+	encryptedUpdate := *update
+	encryptedUpdate.Config = config
+	identityProvider, err := s.driver.UpdateIdentityProvider(ctx, &encryptedUpdate)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.decryptIdentityProviderConfig(identityProvider.Config); err != nil {
+		return nil, err
+	}
 
 	s.idpCache.Store(identityProvider.ID, identityProvider)
 	return identityProvider, nil
@@ -118,3 +139,31 @@ func (s *Store) DeleteIdentityProvider(ctx context.Context, delete *DeleteIdenti
 	s.idpCache.Delete(delete.ID)
 	return nil
 }
+
+// encryptIdentityProviderConfig returns a copy of the config with the client secret encrypted.
+func (s *Store) encryptIdentityProviderConfig(config *IdentityProviderConfig) (*IdentityProviderConfig, error) {
+	if config == nil || config.OAuth2Config == nil {
+		return config, nil
+	}
+	oauth2Config := *config.OAuth2Config
+	clientSecret, err := s.encryptSecret(oauth2Config.ClientSecret)
+	if err != nil {
+		return nil, err
+	}
+	oauth2Config.ClientSecret = clientSecret
+	return &IdentityProviderConfig{
+		OAuth2Config: &oauth2Config,
+	}, nil
+}
+
+func (s *Store) decryptIdentityProviderConfig(config *IdentityProviderConfig) error {
+	if config == nil || config.OAuth2Config == nil {
+		return nil
+	}
+	clientSecret, err := s.decryptSecret(config.OAuth2Config.ClientSecret)
+	if err != nil {
+		return err
+	}
+	config.OAuth2Config.ClientSecret = clientSecret
+	return nil
+}
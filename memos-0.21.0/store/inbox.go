@@ -37,6 +37,9 @@ type FindInbox struct {
 	SenderID   *int32
 	ReceiverID *int32
 	Status     *InboxStatus
+	Limit      *int
+	// Cursor lists the inboxes after it.
+	Cursor *Cursor
 }
 
 type DeleteInbox struct {
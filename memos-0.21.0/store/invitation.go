@@ -0,0 +1,65 @@
+package store
+
+import (
+	"context"
+)
+
+// Invitation lets users sign up while the sign up is otherwise disallowed.
+type Invitation struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+
+	Code string
+	// Role is the role given to the users signing up with the invitation.
+	Role Role
+	// GroupID is the group the users signing up with the invitation join, zero if none.
+	GroupID int32
+	// MaxUses is zero if the invitation can be used any number of times.
+	MaxUses  int32
+	UseCount int32
+	// ExpiresTs is zero if the invitation never expires.
+	ExpiresTs int64
+	// RevokedTs is zero unless the invitation was revoked.
+	RevokedTs int64
+}
+
+type FindInvitation struct {
+	ID        *int32
+	CreatorID *int32
+	Code      *string
+}
+
+type UpdateInvitation struct {
+	ID        int32
+	RevokedTs *int64
+}
+
+func (s *Store) CreateInvitation(ctx context.Context, create *Invitation) (*Invitation, error) {
+	return s.driver.CreateInvitation(ctx, create)
+}
+
+func (s *Store) ListInvitations(ctx context.Context, find *FindInvitation) ([]*Invitation, error) {
+	return s.driver.ListInvitations(ctx, find)
+}
+
+func (s *Store) GetInvitation(ctx context.Context, find *FindInvitation) (*Invitation, error) {
+	list, err := s.ListInvitations(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateInvitation(ctx context.Context, update *UpdateInvitation) (*Invitation, error) {
+	return s.driver.UpdateInvitation(ctx, update)
+}
+
+// IncreaseInvitationUseCount counts a use of the invitation unless it is revoked, expired at the time
+// or reached its max uses. It returns false if the use was not counted.
+func (s *Store) IncreaseInvitationUseCount(ctx context.Context, id int32, ts int64) (bool, error) {
+	return s.driver.IncreaseInvitationUseCount(ctx, id, ts)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/filter"
 )
 
 // Visibility is the type of a visibility.
@@ -46,6 +47,10 @@ type Memo struct {
 	// Domain specific fields
 	Content    string
 	Visibility Visibility
+	// GroupID is the group space the memo is posted to, zero for personal memos.
+	GroupID int32
+	// Encrypted is true if the content is an envelope encrypted by the client, which the server cannot read.
+	Encrypted bool
 
 	// Composed fields
 	Pinned   bool
@@ -65,17 +70,35 @@ type FindMemo struct {
 	UpdatedTsBefore *int64
 
 	// Domain specific fields
+	// ContentSearch matches the content of the memos, the encrypted memos never match.
 	ContentSearch   []string
 	VisibilityList  []Visibility
 	ExcludeContent  bool
 	ExcludeComments bool
 	Random          bool
+	// VisibleToUserID limits the memos to those the user can see: their own memos,
+	// public and protected memos, memos shared with them or their groups,
+	// and memos posted to the spaces of their groups.
+	VisibleToUserID *int32
+	GroupID         *int32
+	// Filter is a condition on the fields id, uid, creator_id, created_ts, updated_ts, row_status,
+	// content, visibility, group_id, encrypted, pinned, tag, has_resource, has_task and has_reference of the memos,
+	// and on their properties as property.<key> for the text values and property_number.<key> for the number values.
+	Filter filter.Expr
 
 	// Pagination
-	Limit            *int
-	Offset           *int
+	Limit  *int
+	Offset *int
+	// Cursor lists the memos after it, instead of an offset.
+	Cursor           *Cursor
 	OrderByUpdatedTs bool
 	OrderByPinned    bool
+	// OrderByTimeAsc lists the oldest memos first instead of the newest ones.
+	OrderByTimeAsc bool
+	// OrderByProperty sorts the memos by the value of the property with the key before their time,
+	// with the memos without the property last. The memos sorted by a property are paged by offset.
+	OrderByProperty     string
+	OrderByPropertyDesc bool
 }
 
 type UpdateMemo struct {
@@ -86,6 +109,7 @@ type UpdateMemo struct {
 	RowStatus  *RowStatus
 	Content    *string
 	Visibility *Visibility
+	Encrypted  *bool
 }
 
 type DeleteMemo struct {
@@ -96,7 +120,20 @@ func (s *Store) CreateMemo(ctx context.Context, create *Memo) (*Memo, error) {
 	if !util.UIDMatcher.MatchString(create.UID) {
 		return nil, errors.New("invalid uid")
 	}
-	return s.driver.CreateMemo(ctx, create)
+	memo, err := s.driver.CreateMemo(ctx, create)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.syncMemoTags(ctx, memo); err != nil {
+		return nil, err
+	}
+	if err := s.syncMemoTasks(ctx, memo); err != nil {
+		return nil, err
+	}
+	if err := s.syncMemoRelations(ctx, memo); err != nil {
+		return nil, err
+	}
+	return memo, nil
 }
 
 func (s *Store) ListMemos(ctx context.Context, find *FindMemo) ([]*Memo, error) {
@@ -120,7 +157,26 @@ func (s *Store) UpdateMemo(ctx context.Context, update *UpdateMemo) error {
 	if update.UID != nil && !util.UIDMatcher.MatchString(*update.UID) {
 		return errors.New("invalid uid")
 	}
-	return s.driver.UpdateMemo(ctx, update)
+	if err := s.driver.UpdateMemo(ctx, update); err != nil {
+		return err
+	}
+	if update.Content == nil && update.Encrypted == nil {
+		return nil
+	}
+	memo, err := s.GetMemo(ctx, &FindMemo{ID: &update.ID})
+	if err != nil {
+		return err
+	}
+	if memo == nil {
+		return nil
+	}
+	if err := s.syncMemoTags(ctx, memo); err != nil {
+		return err
+	}
+	if err := s.syncMemoTasks(ctx, memo); err != nil {
+		return err
+	}
+	return s.syncMemoRelations(ctx, memo)
 }
 
 func (s *Store) DeleteMemo(ctx context.Context, delete *DeleteMemo) error {
@@ -0,0 +1,71 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoGrantRole is the level of access granted to a memo.
+type MemoGrantRole string
+
+const (
+	// MemoGrantViewer can read the memo.
+	MemoGrantViewer MemoGrantRole = "VIEWER"
+	// MemoGrantCommenter can read and comment on the memo.
+	MemoGrantCommenter MemoGrantRole = "COMMENTER"
+	// MemoGrantEditor can read, comment on and edit the memo.
+	MemoGrantEditor MemoGrantRole = "EDITOR"
+)
+
+func (r MemoGrantRole) String() string {
+	return string(r)
+}
+
+// MemoGranteeType is the type of the principal a memo is shared with.
+type MemoGranteeType string
+
+const (
+	// MemoGranteeUser is a single user.
+	MemoGranteeUser MemoGranteeType = "USER"
+	// MemoGranteeGroup is every member of a user group.
+	MemoGranteeGroup MemoGranteeType = "GROUP"
+)
+
+func (t MemoGranteeType) String() string {
+	return string(t)
+}
+
+type MemoGrant struct {
+	ID        int32
+	CreatedTs int64
+
+	MemoID      int32
+	GranteeType MemoGranteeType
+	GranteeID   int32
+	Role        MemoGrantRole
+}
+
+type FindMemoGrant struct {
+	ID          *int32
+	MemoID      *int32
+	GranteeType *MemoGranteeType
+	GranteeID   *int32
+}
+
+type DeleteMemoGrant struct {
+	ID          *int32
+	MemoID      *int32
+	GranteeType *MemoGranteeType
+	GranteeID   *int32
+}
+
+func (s *Store) UpsertMemoGrant(ctx context.Context, upsert *MemoGrant) (*MemoGrant, error) {
+	return s.driver.UpsertMemoGrant(ctx, upsert)
+}
+
+func (s *Store) ListMemoGrants(ctx context.Context, find *FindMemoGrant) ([]*MemoGrant, error) {
+	return s.driver.ListMemoGrants(ctx, find)
+}
+
+func (s *Store) DeleteMemoGrant(ctx context.Context, delete *DeleteMemoGrant) error {
+	return s.driver.DeleteMemoGrant(ctx, delete)
+}
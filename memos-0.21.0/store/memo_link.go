@@ -0,0 +1,198 @@
+package store
+
+import (
+	"context"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/yourselfhosted/gomark/ast"
+	"github.com/yourselfhosted/gomark/parser"
+	"github.com/yourselfhosted/gomark/parser/tokenizer"
+
+	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/filter"
+)
+
+// MemoLink is a link to another memo in the content of a memo, either [[Memo title]], [[memos/123]] or memos/123.
+type MemoLink struct {
+	// MemoID is the id of the linked memo, 0 if the memo is linked by its title.
+	MemoID int32
+	// Title is the title of the linked memo, empty if the memo is linked by its id.
+	Title string
+	// Snippet is the text around the link.
+	Snippet string
+}
+
+// maxMemoLinkSnippetLength is the maximum number of characters of the snippets of the memo links.
+const maxMemoLinkSnippetLength = 200
+
+// memoNameRegexp matches the names of the memos written in the text, such as "memos/123".
+var memoNameRegexp = regexp.MustCompile(`(?:^|[^\w/])memos/(\d+)\b`)
+
+// ExtractMemoLinks returns the links to other memos of the memo content, in the order they appear.
+// The snippet of a link is the block of the content it is written in.
+func ExtractMemoLinks(content string) []*MemoLink {
+	links := []*MemoLink{}
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return links
+	}
+	for _, block := range nodes {
+		snippet := ""
+		getSnippet := func() string {
+			if snippet == "" {
+				snippet = truncateMemoLinkSnippet(block.Restore())
+			}
+			return snippet
+		}
+		TraverseASTNodes([]ast.Node{block}, func(node ast.Node) {
+			switch node := node.(type) {
+			case *ast.ReferencedContent:
+				link := &MemoLink{Snippet: getSnippet()}
+				if id, ok := parseMemoName(node.ResourceName); ok {
+					link.MemoID = id
+				} else if title := strings.TrimSpace(node.ResourceName); title != "" {
+					link.Title = title
+				} else {
+					return
+				}
+				links = append(links, link)
+			case *ast.Text:
+				for _, matches := range memoNameRegexp.FindAllStringSubmatch(node.Content, -1) {
+					if id, err := util.ConvertStringToInt32(matches[1]); err == nil {
+						links = append(links, &MemoLink{MemoID: id, Snippet: getSnippet()})
+					}
+				}
+			}
+		})
+	}
+	return links
+}
+
+// GetMemoTitle returns the title of the memo content, which is its first line without the heading marks.
+func GetMemoTitle(content string) string {
+	title, _, _ := strings.Cut(strings.TrimSpace(content), "\n")
+	return strings.TrimSpace(strings.TrimLeft(title, "#"))
+}
+
+func parseMemoName(name string) (int32, bool) {
+	idString, ok := strings.CutPrefix(strings.TrimSpace(name), "memos/")
+	if !ok {
+		return 0, false
+	}
+	id, err := util.ConvertStringToInt32(idString)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+func truncateMemoLinkSnippet(snippet string) string {
+	snippet = strings.Join(strings.Fields(snippet), " ")
+	if utf8.RuneCountInString(snippet) <= maxMemoLinkSnippetLength {
+		return snippet
+	}
+	return string([]rune(snippet)[:maxMemoLinkSnippetLength]) + "…"
+}
+
+// syncMemoRelations sets the reference relations parsed from the links in the content of the memo.
+// The manual relations are kept, and the encrypted memos have no links.
+func (s *Store) syncMemoRelations(ctx context.Context, memo *Memo) error {
+	relatedMemoIDs := []int32{}
+	if !memo.Encrypted {
+		var err error
+		if relatedMemoIDs, err = s.resolveMemoLinks(ctx, memo, ExtractMemoLinks(memo.Content)); err != nil {
+			return err
+		}
+	}
+
+	referenceType := MemoRelationReference
+	relations, err := s.ListMemoRelations(ctx, &FindMemoRelation{
+		MemoID: &memo.ID,
+		Type:   &referenceType,
+	})
+	if err != nil {
+		return err
+	}
+	existingMemoIDs := []int32{}
+	for _, relation := range relations {
+		existingMemoIDs = append(existingMemoIDs, relation.RelatedMemoID)
+		if relation.Source != MemoRelationSourceContent || slices.Contains(relatedMemoIDs, relation.RelatedMemoID) {
+			continue
+		}
+		contentSource := MemoRelationSourceContent
+		if err := s.DeleteMemoRelation(ctx, &DeleteMemoRelation{
+			MemoID:        &memo.ID,
+			RelatedMemoID: &relation.RelatedMemoID,
+			Type:          &referenceType,
+			Source:        &contentSource,
+		}); err != nil {
+			return err
+		}
+	}
+	for _, relatedMemoID := range relatedMemoIDs {
+		if slices.Contains(existingMemoIDs, relatedMemoID) {
+			continue
+		}
+		if _, err := s.UpsertMemoRelation(ctx, &MemoRelation{
+			MemoID:        memo.ID,
+			RelatedMemoID: relatedMemoID,
+			Type:          MemoRelationReference,
+			Source:        MemoRelationSourceContent,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolveMemoLinks returns the ids of the existing memos the links point to, without duplicates and without the memo itself.
+// The titles are resolved among the memos of the same creator, the most recent memo first.
+func (s *Store) resolveMemoLinks(ctx context.Context, memo *Memo, links []*MemoLink) ([]int32, error) {
+	memoIDs, linkedIDs := []int32{}, []any{}
+	for _, link := range links {
+		if link.MemoID != 0 {
+			linkedIDs = append(linkedIDs, link.MemoID)
+			continue
+		}
+		memos, err := s.ListMemos(ctx, &FindMemo{
+			CreatorID: &memo.CreatorID,
+			Filter: filter.And(
+				&filter.CompareExpr{Field: "encrypted", Operator: filter.OperatorEqual, Value: false},
+				&filter.MatchExpr{Field: "content", Kind: filter.MatchContains, Value: link.Title},
+			),
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, linkedMemo := range memos {
+			if linkedMemo.ID != memo.ID && strings.EqualFold(GetMemoTitle(linkedMemo.Content), link.Title) {
+				linkedIDs = append(linkedIDs, linkedMemo.ID)
+				break
+			}
+		}
+	}
+	if len(linkedIDs) == 0 {
+		return memoIDs, nil
+	}
+
+	memos, err := s.ListMemos(ctx, &FindMemo{
+		ExcludeContent: true,
+		Filter:         &filter.InExpr{Field: "id", Values: linkedIDs},
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, linkedID := range linkedIDs {
+		id := linkedID.(int32)
+		if id == memo.ID || slices.Contains(memoIDs, id) {
+			continue
+		}
+		if slices.ContainsFunc(memos, func(linkedMemo *Memo) bool { return linkedMemo.ID == id }) {
+			memoIDs = append(memoIDs, id)
+		}
+	}
+	return memoIDs, nil
+}
@@ -0,0 +1,155 @@
+package store
+
+import (
+	"context"
+	"math"
+	"net/url"
+	"regexp"
+	"strconv"
+	"time"
+	"unicode/utf8"
+
+	"github.com/pkg/errors"
+)
+
+// MemoPropertyType is the type of the value of a memo property.
+type MemoPropertyType string
+
+const (
+	MemoPropertyString  MemoPropertyType = "STRING"
+	MemoPropertyNumber  MemoPropertyType = "NUMBER"
+	MemoPropertyBoolean MemoPropertyType = "BOOLEAN"
+	// MemoPropertyDate is a date such as "2024-03-10".
+	MemoPropertyDate MemoPropertyType = "DATE"
+	MemoPropertyURL  MemoPropertyType = "URL"
+)
+
+// MemoProperty is a typed key/value property of a memo, such as "priority: 2".
+type MemoProperty struct {
+	MemoID int32
+	// Key is unique among the properties of the memo.
+	Key  string
+	Type MemoPropertyType
+	// Value is the value in its text form, such as "2", "true" or "2024-03-10".
+	Value string
+}
+
+// NumberValue returns the value the property is compared and sorted with as a number,
+// which is the unix timestamp of the dates and 1 or 0 for the booleans, or nil if the property is not a number.
+func (p *MemoProperty) NumberValue() *float64 {
+	var number float64
+	switch p.Type {
+	case MemoPropertyNumber:
+		v, err := strconv.ParseFloat(p.Value, 64)
+		if err != nil {
+			return nil
+		}
+		number = v
+	case MemoPropertyBoolean:
+		if p.Value == "true" {
+			number = 1
+		}
+	case MemoPropertyDate:
+		date, err := time.Parse(time.DateOnly, p.Value)
+		if err != nil {
+			return nil
+		}
+		number = float64(date.Unix())
+	default:
+		return nil
+	}
+	return &number
+}
+
+type FindMemoProperty struct {
+	MemoID     *int32
+	MemoIDList []int32
+}
+
+// SetMemoProperties replaces the properties of a memo.
+type SetMemoProperties struct {
+	MemoID     int32
+	Properties []*MemoProperty
+}
+
+const (
+	// maxMemoPropertyCount is the maximum number of properties of a memo.
+	maxMemoPropertyCount = 32
+	// maxMemoPropertyValueLength is the maximum number of characters of the values of the properties.
+	maxMemoPropertyValueLength = 1024
+)
+
+// memoPropertyKeyRegexp matches the keys of the properties, which are the variables of the filters.
+var memoPropertyKeyRegexp = regexp.MustCompile(`^[a-z][a-z0-9_]{0,31}$`)
+
+// NormalizeMemoProperty checks the key and the value of the property, and sets the value to its canonical form,
+// such as "2" for the number "2.0".
+func NormalizeMemoProperty(property *MemoProperty) error {
+	if !memoPropertyKeyRegexp.MatchString(property.Key) {
+		return errors.Errorf("invalid property key %q, must be lowercase letters, digits and underscores starting with a letter", property.Key)
+	}
+	if utf8.RuneCountInString(property.Value) > maxMemoPropertyValueLength {
+		return errors.Errorf("the value of %s is longer than %d characters", property.Key, maxMemoPropertyValueLength)
+	}
+	switch property.Type {
+	case MemoPropertyString:
+	case MemoPropertyNumber:
+		number, err := strconv.ParseFloat(property.Value, 64)
+		if err != nil || math.IsInf(number, 0) || math.IsNaN(number) {
+			return errors.Errorf("the value of %s must be a number", property.Key)
+		}
+		property.Value = strconv.FormatFloat(number, 'f', -1, 64)
+	case MemoPropertyBoolean:
+		b, err := strconv.ParseBool(property.Value)
+		if err != nil {
+			return errors.Errorf("the value of %s must be true or false", property.Key)
+		}
+		property.Value = strconv.FormatBool(b)
+	case MemoPropertyDate:
+		if _, err := time.Parse(time.DateOnly, property.Value); err != nil {
+			return errors.Errorf("the value of %s must be a date such as 2024-03-10", property.Key)
+		}
+	case MemoPropertyURL:
+		u, err := url.Parse(property.Value)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errors.Errorf("the value of %s must be a http or https url", property.Key)
+		}
+	default:
+		return errors.Errorf("invalid type of %s", property.Key)
+	}
+	return nil
+}
+
+// NormalizeMemoProperties checks the properties of a memo, which have distinct keys, and normalizes their values.
+func NormalizeMemoProperties(properties []*MemoProperty) error {
+	if len(properties) > maxMemoPropertyCount {
+		return errors.Errorf("a memo can have at most %d properties", maxMemoPropertyCount)
+	}
+	keys := map[string]bool{}
+	for _, property := range properties {
+		if err := NormalizeMemoProperty(property); err != nil {
+			return err
+		}
+		if keys[property.Key] {
+			return errors.Errorf("duplicate property key %s", property.Key)
+		}
+		keys[property.Key] = true
+	}
+	return nil
+}
+
+// SetMemoProperties checks the properties and replaces the ones of the memo with them.
+func (s *Store) SetMemoProperties(ctx context.Context, set *SetMemoProperties) error {
+	if err := NormalizeMemoProperties(set.Properties); err != nil {
+		return err
+	}
+	for _, property := range set.Properties {
+		property.MemoID = set.MemoID
+	}
+	return s.driver.SetMemoProperties(ctx, set)
+}
+
+// ListMemoProperties returns the properties sorted by memo and key.
+func (s *Store) ListMemoProperties(ctx context.Context, find *FindMemoProperty) ([]*MemoProperty, error) {
+	return s.driver.ListMemoProperties(ctx, find)
+}
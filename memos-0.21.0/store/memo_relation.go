@@ -13,25 +13,45 @@ const (
 	MemoRelationComment MemoRelationType = "COMMENT"
 )
 
+// MemoRelationSource tells how a memo relation was set.
+type MemoRelationSource string
+
+const (
+	// MemoRelationSourceManual is the source for the relations set explicitly.
+	MemoRelationSourceManual MemoRelationSource = "MANUAL"
+	// MemoRelationSourceContent is the source for the reference relations parsed from the links in the content of the memo.
+	MemoRelationSourceContent MemoRelationSource = "CONTENT"
+)
+
 type MemoRelation struct {
 	MemoID        int32
 	RelatedMemoID int32
 	Type          MemoRelationType
+	Source        MemoRelationSource
 }
 
 type FindMemoRelation struct {
-	MemoID        *int32
-	RelatedMemoID *int32
-	Type          *MemoRelationType
+	MemoID            *int32
+	RelatedMemoID     *int32
+	MemoIDList        []int32
+	RelatedMemoIDList []int32
+	Type              *MemoRelationType
+	Source            *MemoRelationSource
 }
 
 type DeleteMemoRelation struct {
 	MemoID        *int32
 	RelatedMemoID *int32
 	Type          *MemoRelationType
+	Source        *MemoRelationSource
 }
 
+// UpsertMemoRelation creates the relation, or sets the source of the existing one.
+// The relations without source are manual.
 func (s *Store) UpsertMemoRelation(ctx context.Context, create *MemoRelation) (*MemoRelation, error) {
+	if create.Source == "" {
+		create.Source = MemoRelationSourceManual
+	}
 	return s.driver.UpsertMemoRelation(ctx, create)
 }
 
@@ -0,0 +1,60 @@
+package store
+
+import (
+	"context"
+)
+
+type MemoShareLink struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+
+	MemoID int32
+	Token  string
+	// ExpiresTs is zero if the link never expires.
+	ExpiresTs int64
+	// MaxViews is zero if the link can be viewed any number of times.
+	MaxViews     int32
+	ViewCount    int32
+	PasswordHash string
+}
+
+type FindMemoShareLink struct {
+	ID     *int32
+	MemoID *int32
+	Token  *string
+}
+
+type DeleteMemoShareLink struct {
+	ID     *int32
+	MemoID *int32
+}
+
+func (s *Store) CreateMemoShareLink(ctx context.Context, create *MemoShareLink) (*MemoShareLink, error) {
+	return s.driver.CreateMemoShareLink(ctx, create)
+}
+
+func (s *Store) ListMemoShareLinks(ctx context.Context, find *FindMemoShareLink) ([]*MemoShareLink, error) {
+	return s.driver.ListMemoShareLinks(ctx, find)
+}
+
+func (s *Store) GetMemoShareLink(ctx context.Context, find *FindMemoShareLink) (*MemoShareLink, error) {
+	list, err := s.ListMemoShareLinks(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+// IncreaseMemoShareLinkViewCount counts a view of the link unless it reached its max views.
+// It returns false if the view was not counted.
+func (s *Store) IncreaseMemoShareLinkViewCount(ctx context.Context, id int32) (bool, error) {
+	return s.driver.IncreaseMemoShareLinkViewCount(ctx, id)
+}
+
+func (s *Store) DeleteMemoShareLink(ctx context.Context, delete *DeleteMemoShareLink) error {
+	return s.driver.DeleteMemoShareLink(ctx, delete)
+}
@@ -0,0 +1,103 @@
+package store
+
+import (
+	"context"
+	"slices"
+
+	"github.com/yourselfhosted/gomark/ast"
+	"github.com/yourselfhosted/gomark/parser"
+	"github.com/yourselfhosted/gomark/parser/tokenizer"
+)
+
+// MemoTag is a tag used in the content of a memo.
+type MemoTag struct {
+	MemoID int32
+	Tag    string
+}
+
+type FindMemoTag struct {
+	MemoID     *int32
+	MemoIDList []int32
+	CreatorID  *int32
+	TagList    []string
+}
+
+// SetMemoTags replaces the tags of a memo.
+type SetMemoTags struct {
+	MemoID int32
+	Tags   []string
+}
+
+// MemoTagCount is the number of memos using a tag.
+type MemoTagCount struct {
+	Tag   string
+	Count int32
+}
+
+type FindMemoTagCount struct {
+	CreatorID *int32
+	RowStatus *RowStatus
+}
+
+func (s *Store) SetMemoTags(ctx context.Context, set *SetMemoTags) error {
+	return s.driver.SetMemoTags(ctx, set)
+}
+
+func (s *Store) ListMemoTags(ctx context.Context, find *FindMemoTag) ([]*MemoTag, error) {
+	return s.driver.ListMemoTags(ctx, find)
+}
+
+// ListMemoTagCounts returns the tags of the memos with the number of memos using them, sorted by tag.
+func (s *Store) ListMemoTagCounts(ctx context.Context, find *FindMemoTagCount) ([]*MemoTagCount, error) {
+	return s.driver.ListMemoTagCounts(ctx, find)
+}
+
+// syncMemoTags sets the tags of the memo to the ones of its content. The encrypted memos have no tags.
+func (s *Store) syncMemoTags(ctx context.Context, memo *Memo) error {
+	tags := []string{}
+	if !memo.Encrypted {
+		tags = ExtractMemoTags(memo.Content)
+	}
+	return s.SetMemoTags(ctx, &SetMemoTags{
+		MemoID: memo.ID,
+		Tags:   tags,
+	})
+}
+
+// ExtractMemoTags returns the tags of the memo content without duplicates, in the order they appear.
+func ExtractMemoTags(content string) []string {
+	tags := []string{}
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return tags
+	}
+	TraverseASTNodes(nodes, func(node ast.Node) {
+		if tag, ok := node.(*ast.Tag); ok && !slices.Contains(tags, tag.Content) {
+			tags = append(tags, tag.Content)
+		}
+	})
+	return tags
+}
+
+// TraverseASTNodes calls fn on the nodes of the memo content and the nodes nested in them, in the order they appear.
+func TraverseASTNodes(nodes []ast.Node, fn func(ast.Node)) {
+	for _, node := range nodes {
+		fn(node)
+		switch n := node.(type) {
+		case *ast.Paragraph:
+			TraverseASTNodes(n.Children, fn)
+		case *ast.Heading:
+			TraverseASTNodes(n.Children, fn)
+		case *ast.Blockquote:
+			TraverseASTNodes(n.Children, fn)
+		case *ast.OrderedList:
+			TraverseASTNodes(n.Children, fn)
+		case *ast.UnorderedList:
+			TraverseASTNodes(n.Children, fn)
+		case *ast.TaskList:
+			TraverseASTNodes(n.Children, fn)
+		case *ast.Bold:
+			TraverseASTNodes(n.Children, fn)
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package store
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/yourselfhosted/gomark/ast"
+	"github.com/yourselfhosted/gomark/parser"
+	"github.com/yourselfhosted/gomark/parser/tokenizer"
+	"github.com/yourselfhosted/gomark/restore"
+)
+
+// MemoTask is a task item in the content of a memo, such as "- [ ] review the draft due:2024-03-10 @frank".
+type MemoTask struct {
+	MemoID int32
+	// Position is the index of the task among the tasks of the memo, in the order they appear.
+	Position int32
+	// Content is the text of the task after its checkbox.
+	Content   string
+	Completed bool
+	// DueTs is the start of the due date in UTC, 0 if the task has no due date.
+	DueTs int64
+	// AssigneeID is the id of the user mentioned in the task, 0 if the task is not assigned.
+	AssigneeID int32
+	// OverdueNotified is true once the task has been reported overdue.
+	OverdueNotified bool
+}
+
+type FindMemoTask struct {
+	MemoID   *int32
+	Position *int32
+	// CreatorOrAssigneeID lists the tasks of the memos created by the user and the tasks assigned to them.
+	CreatorOrAssigneeID *int32
+	// VisibleToUserID limits the tasks to those of the memos the user can see, like FindMemo.VisibleToUserID.
+	VisibleToUserID *int32
+	Completed       *bool
+	// DueTsBefore lists the tasks with a due date before the time.
+	DueTsBefore     *int64
+	OverdueNotified *bool
+	// RowStatus is the row status of the memos of the tasks.
+	RowStatus *RowStatus
+}
+
+// SetMemoTasks replaces the tasks of a memo.
+type SetMemoTasks struct {
+	MemoID int32
+	Tasks  []*MemoTask
+}
+
+type UpdateMemoTask struct {
+	MemoID          int32
+	Position        int32
+	OverdueNotified *bool
+}
+
+func (s *Store) SetMemoTasks(ctx context.Context, set *SetMemoTasks) error {
+	return s.driver.SetMemoTasks(ctx, set)
+}
+
+// ListMemoTasks returns the tasks sorted by due date, with the tasks without due date last, then by memo and position.
+func (s *Store) ListMemoTasks(ctx context.Context, find *FindMemoTask) ([]*MemoTask, error) {
+	return s.driver.ListMemoTasks(ctx, find)
+}
+
+func (s *Store) UpdateMemoTask(ctx context.Context, update *UpdateMemoTask) error {
+	return s.driver.UpdateMemoTask(ctx, update)
+}
+
+// syncMemoTasks sets the tasks of the memo to the ones of its content. The encrypted memos have no tasks.
+// The tasks already reported overdue stay reported as long as their content and due date are unchanged.
+func (s *Store) syncMemoTasks(ctx context.Context, memo *Memo) error {
+	tasks, err := s.getMemoContentTasks(ctx, memo)
+	if err != nil {
+		return err
+	}
+	return s.SetMemoTasks(ctx, &SetMemoTasks{
+		MemoID: memo.ID,
+		Tasks:  tasks,
+	})
+}
+
+// getMemoContentTasks returns the tasks of the memo content, with their assignees and overdue reports.
+func (s *Store) getMemoContentTasks(ctx context.Context, memo *Memo) ([]*MemoTask, error) {
+	tasks := []*MemoTask{}
+	if !memo.Encrypted {
+		tasks = ExtractMemoTasks(memo.Content)
+	}
+	existingTasks, err := s.ListMemoTasks(ctx, &FindMemoTask{MemoID: &memo.ID})
+	if err != nil {
+		return nil, err
+	}
+	for _, task := range tasks {
+		task.MemoID = memo.ID
+		if task.AssigneeID, err = s.getTaskAssigneeID(ctx, task.Content); err != nil {
+			return nil, err
+		}
+		for _, existingTask := range existingTasks {
+			if existingTask.Content == task.Content && existingTask.DueTs == task.DueTs {
+				task.OverdueNotified = existingTask.OverdueNotified
+				break
+			}
+		}
+	}
+	return tasks, nil
+}
+
+var (
+	// taskDueDateRegexp matches the due dates of the tasks, such as "due:2024-03-10".
+	taskDueDateRegexp = regexp.MustCompile(`(?:^|\s)due:(\d{4}-\d{2}-\d{2})(?:\s|$)`)
+	// taskAssigneeRegexp matches the usernames the tasks are assigned to, such as "@frank".
+	taskAssigneeRegexp = regexp.MustCompile(`(?:^|\s)@([a-zA-Z0-9][a-zA-Z0-9-]*)`)
+)
+
+// ExtractMemoTasks returns the tasks of the memo content in the order they appear, with their due dates.
+// The assignees are only resolved to users when the tasks are stored.
+func ExtractMemoTasks(content string) []*MemoTask {
+	tasks := []*MemoTask{}
+	nodes, err := parser.Parse(tokenizer.Tokenize(content))
+	if err != nil {
+		return tasks
+	}
+	TraverseASTNodes(nodes, func(node ast.Node) {
+		taskList, ok := node.(*ast.TaskList)
+		if !ok {
+			return
+		}
+		task := &MemoTask{
+			Position:  int32(len(tasks)),
+			Content:   strings.TrimSpace(restore.Restore(taskList.Children)),
+			Completed: taskList.Complete,
+		}
+		if matches := taskDueDateRegexp.FindStringSubmatch(task.Content); matches != nil {
+			if dueDate, err := time.Parse(time.DateOnly, matches[1]); err == nil {
+				task.DueTs = dueDate.Unix()
+			}
+		}
+		tasks = append(tasks, task)
+	})
+	return tasks
+}
+
+// getTaskAssigneeID returns the id of the first user mentioned in the task content, or 0 if none exists.
+func (s *Store) getTaskAssigneeID(ctx context.Context, content string) (int32, error) {
+	for _, matches := range taskAssigneeRegexp.FindAllStringSubmatch(content, -1) {
+		username := matches[1]
+		user, err := s.GetUser(ctx, &FindUser{Username: &username})
+		if err != nil {
+			return 0, err
+		}
+		if user != nil {
+			return user.ID, nil
+		}
+	}
+	return 0, nil
+}
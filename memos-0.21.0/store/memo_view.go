@@ -0,0 +1,81 @@
+package store
+
+import (
+	"context"
+)
+
+// MemoViewVisibility is who can use a memo view.
+type MemoViewVisibility string
+
+const (
+	// MemoViewPrivate is only used by its creator.
+	MemoViewPrivate MemoViewVisibility = "PRIVATE"
+	// MemoViewWorkspace is shared with all the users of the workspace.
+	MemoViewWorkspace MemoViewVisibility = "WORKSPACE"
+)
+
+func (v MemoViewVisibility) String() string {
+	return string(v)
+}
+
+// MemoView is a saved search of memos.
+type MemoView struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+	UpdatedTs int64
+
+	Name string
+	// Filter is the CEL filter of the memos, with the relative times resolved when the view is used.
+	Filter string
+	// OrderBy is the sort order of the memos, such as "pinned desc, display_time desc". Empty for the default order.
+	OrderBy    string
+	Visibility MemoViewVisibility
+}
+
+type FindMemoView struct {
+	ID        *int32
+	CreatorID *int32
+	// VisibleToUserID limits the views to the ones of the user and the ones shared with the workspace.
+	VisibleToUserID *int32
+}
+
+type UpdateMemoView struct {
+	ID         int32
+	UpdatedTs  *int64
+	Name       *string
+	Filter     *string
+	OrderBy    *string
+	Visibility *MemoViewVisibility
+}
+
+type DeleteMemoView struct {
+	ID int32
+}
+
+func (s *Store) CreateMemoView(ctx context.Context, create *MemoView) (*MemoView, error) {
+	return s.driver.CreateMemoView(ctx, create)
+}
+
+func (s *Store) ListMemoViews(ctx context.Context, find *FindMemoView) ([]*MemoView, error) {
+	return s.driver.ListMemoViews(ctx, find)
+}
+
+func (s *Store) GetMemoView(ctx context.Context, find *FindMemoView) (*MemoView, error) {
+	list, err := s.ListMemoViews(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateMemoView(ctx context.Context, update *UpdateMemoView) (*MemoView, error) {
+	return s.driver.UpdateMemoView(ctx, update)
+}
+
+func (s *Store) DeleteMemoView(ctx context.Context, delete *DeleteMemoView) error {
+	return s.driver.DeleteMemoView(ctx, delete)
+}
@@ -5,8 +5,10 @@ import (
 	"encoding/json"
 	"fmt"
 
+	"github.com/golang-jwt/jwt/v5"
 	"github.com/pkg/errors"
 
+	"github.com/usememos/memos/plugin/filter"
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
 
@@ -59,3 +61,120 @@ func (s *Store) MigrateWorkspaceSetting(ctx context.Context) error {
 
 	return nil
 }
+
+// MigrateAccessTokens replaces the raw access tokens kept in user settings with their hashes.
+func (s *Store) MigrateAccessTokens(ctx context.Context) error {
+	userSettings, err := s.ListUserSettings(ctx, &FindUserSetting{
+		Key: storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list access tokens user settings")
+	}
+
+	for _, userSetting := range userSettings {
+		accessTokens := userSetting.GetAccessTokens().GetAccessTokens()
+		migrated := false
+		for _, accessToken := range accessTokens {
+			if accessToken.AccessToken == "" {
+				continue
+			}
+			// The signature has been verified when the token was issued, only the timestamps are needed here.
+			claims := &jwt.RegisteredClaims{}
+			if _, _, err := jwt.NewParser().ParseUnverified(accessToken.AccessToken, claims); err == nil {
+				if claims.IssuedAt != nil {
+					accessToken.IssuedTs = claims.IssuedAt.Unix()
+				}
+				if claims.ExpiresAt != nil {
+					accessToken.ExpiresTs = claims.ExpiresAt.Unix()
+				}
+			}
+			accessToken.TokenHash = HashAccessToken(accessToken.AccessToken)
+			accessToken.Id = accessToken.TokenHash[:accessTokenIDLength]
+			accessToken.AccessToken = ""
+			migrated = true
+		}
+		if !migrated {
+			continue
+		}
+		if err := s.upsertUserAccessTokens(ctx, userSetting.UserId, accessTokens); err != nil {
+			return errors.Wrapf(err, "failed to migrate access tokens of user %d", userSetting.UserId)
+		}
+	}
+
+	return nil
+}
+
+// MigrateMemoTags fills the tags of the memos created before the tags of the memos were kept.
+// Only the memos with a # in their content and no tags are parsed, so it is cheap once the tags are filled.
+func (s *Store) MigrateMemoTags(ctx context.Context) error {
+	memos, err := s.ListMemos(ctx, &FindMemo{
+		Filter: filter.And(
+			&filter.CompareExpr{Field: "encrypted", Operator: filter.OperatorEqual, Value: false},
+			&filter.MatchExpr{Field: "content", Kind: filter.MatchContains, Value: "#"},
+			&filter.NotExpr{Expr: &filter.CompareExpr{Field: "tag", Operator: filter.OperatorNotEqual, Value: nil}},
+		),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos without tags")
+	}
+
+	for _, memo := range memos {
+		if err := s.syncMemoTags(ctx, memo); err != nil {
+			return errors.Wrapf(err, "failed to set tags of memo %d", memo.ID)
+		}
+	}
+
+	return nil
+}
+
+// MigrateMemoTasks fills the tasks of the memos created before the tasks of the memos were kept.
+// Only the memos with a checkbox in their content and no tasks are parsed, so it is cheap once the tasks are filled.
+func (s *Store) MigrateMemoTasks(ctx context.Context) error {
+	memos, err := s.ListMemos(ctx, &FindMemo{
+		Filter: filter.And(
+			&filter.CompareExpr{Field: "encrypted", Operator: filter.OperatorEqual, Value: false},
+			&filter.OrExpr{Exprs: []filter.Expr{
+				&filter.MatchExpr{Field: "content", Kind: filter.MatchContains, Value: "[ ]"},
+				&filter.MatchExpr{Field: "content", Kind: filter.MatchContains, Value: "[x]"},
+			}},
+			&filter.CompareExpr{Field: "has_task", Operator: filter.OperatorEqual, Value: false},
+		),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos without tasks")
+	}
+
+	for _, memo := range memos {
+		if err := s.syncMemoTasks(ctx, memo); err != nil {
+			return errors.Wrapf(err, "failed to set tasks of memo %d", memo.ID)
+		}
+	}
+
+	return nil
+}
+
+// MigrateMemoRelations fills the reference relations of the links in the memos created before the links were parsed.
+// Only the memos with a link in their content and no references are parsed, so it is cheap once the relations are filled.
+func (s *Store) MigrateMemoRelations(ctx context.Context) error {
+	memos, err := s.ListMemos(ctx, &FindMemo{
+		Filter: filter.And(
+			&filter.CompareExpr{Field: "encrypted", Operator: filter.OperatorEqual, Value: false},
+			&filter.OrExpr{Exprs: []filter.Expr{
+				&filter.MatchExpr{Field: "content", Kind: filter.MatchContains, Value: "[["},
+				&filter.MatchExpr{Field: "content", Kind: filter.MatchContains, Value: "memos/"},
+			}},
+			&filter.CompareExpr{Field: "has_reference", Operator: filter.OperatorEqual, Value: false},
+		),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to list memos without references")
+	}
+
+	for _, memo := range memos {
+		if err := s.syncMemoRelations(ctx, memo); err != nil {
+			return errors.Wrapf(err, "failed to set relations of memo %d", memo.ID)
+		}
+	}
+
+	return nil
+}
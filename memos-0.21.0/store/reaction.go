@@ -7,9 +7,10 @@ import (
 )
 
 type FindReaction struct {
-	ID        *int32
-	CreatorID *int32
-	ContentID *string
+	ID            *int32
+	CreatorID     *int32
+	ContentID     *string
+	ContentIDList []string
 }
 
 type DeleteReaction struct {
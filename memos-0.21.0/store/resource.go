@@ -9,6 +9,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/usememos/memos/internal/util"
+	"github.com/usememos/memos/plugin/filter"
 )
 
 const (
@@ -44,9 +45,14 @@ type FindResource struct {
 	CreatorID      *int32
 	Filename       *string
 	MemoID         *int32
+	MemoIDList     []int32
 	HasRelatedMemo bool
-	Limit          *int
-	Offset         *int
+	// Filter is a condition on the fields id, uid, creator_id, created_ts, updated_ts, filename, type, size and memo_id of the resources.
+	Filter filter.Expr
+	Limit  *int
+	Offset *int
+	// Cursor lists the resources after it, instead of an offset.
+	Cursor *Cursor
 }
 
 type UpdateResource struct {
@@ -105,12 +111,26 @@ func (s *Store) DeleteResource(ctx context.Context, delete *DeleteResource) erro
 		return errors.Wrap(nil, "resource not found")
 	}
 
+	s.DeleteResourceLocalFiles(resource)
+	return s.driver.DeleteResource(ctx, delete)
+}
+
+// GetResourceLocalPath returns the path of the local file of the resource, or an empty string if it is not stored locally.
+func (s *Store) GetResourceLocalPath(resource *Resource) string {
+	if resource.InternalPath == "" {
+		return ""
+	}
+	resourcePath := filepath.FromSlash(resource.InternalPath)
+	if !filepath.IsAbs(resourcePath) {
+		resourcePath = filepath.Join(s.Profile.Data, resourcePath)
+	}
+	return resourcePath
+}
+
+// DeleteResourceLocalFiles deletes the local file and the thumbnail of the resource.
+func (s *Store) DeleteResourceLocalFiles(resource *Resource) {
 	// Delete the local file.
-	if resource.InternalPath != "" {
-		resourcePath := filepath.FromSlash(resource.InternalPath)
-		if !filepath.IsAbs(resourcePath) {
-			resourcePath = filepath.Join(s.Profile.Data, resourcePath)
-		}
+	if resourcePath := s.GetResourceLocalPath(resource); resourcePath != "" {
 		_ = os.Remove(resourcePath)
 	}
 
@@ -120,5 +140,4 @@ func (s *Store) DeleteResource(ctx context.Context, delete *DeleteResource) erro
 		thumbnailPath := filepath.Join(s.Profile.Data, thumbnailImagePath, fmt.Sprintf("%d%s", resource.ID, ext))
 		_ = os.Remove(thumbnailPath)
 	}
-	return s.driver.DeleteResource(ctx, delete)
 }
@@ -0,0 +1,222 @@
+package store
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+)
+
+const (
+	// encryptedSecretPrefix marks the secrets encrypted with a master key, followed by
+	// the id of the master key, the wrapped data key and the ciphertext separated by colons.
+	encryptedSecretPrefix = "enc:v1:"
+	// dataKeySize is the size of the random AES-256 key generated for every secret.
+	dataKeySize = 32
+)
+
+// secretCipher encrypts the secrets stored in the database with envelope encryption:
+// every secret is encrypted with its own data key, which is encrypted with the master key.
+type secretCipher struct {
+	keyID string
+	key   []byte
+	// previous is the cipher of the master key being rotated, used to decrypt the secrets not re-encrypted yet,
+	// either during the rotation or after an interrupted one when the previous master key is configured.
+	previous *secretCipher
+}
+
+// newSecretCipher derives the AES-256 key from the master key, which should be a long random string.
+func newSecretCipher(masterKey string) *secretCipher {
+	key := sha256.Sum256([]byte(masterKey))
+	keyHash := sha256.Sum256(key[:])
+	return &secretCipher{
+		keyID: hex.EncodeToString(keyHash[:4]),
+		key:   key[:],
+	}
+}
+
+func (c *secretCipher) encrypt(plaintext string) (string, error) {
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return "", errors.Wrap(err, "failed to generate data key")
+	}
+	wrappedDataKey, err := seal(c.key, dataKey)
+	if err != nil {
+		return "", err
+	}
+	ciphertext, err := seal(dataKey, []byte(plaintext))
+	if err != nil {
+		return "", err
+	}
+	return encryptedSecretPrefix + strings.Join([]string{
+		c.keyID,
+		base64.StdEncoding.EncodeToString(wrappedDataKey),
+		base64.StdEncoding.EncodeToString(ciphertext),
+	}, ":"), nil
+}
+
+func (c *secretCipher) decrypt(value string) (string, error) {
+	parts := strings.Split(strings.TrimPrefix(value, encryptedSecretPrefix), ":")
+	if len(parts) != 3 {
+		return "", errors.New("malformed encrypted secret")
+	}
+	keyCipher := c
+	for keyCipher != nil && keyCipher.keyID != parts[0] {
+		keyCipher = keyCipher.previous
+	}
+	if keyCipher == nil {
+		return "", errors.Errorf("secret is encrypted with an unknown master key %s", parts[0])
+	}
+	wrappedDataKey, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return "", errors.Wrap(err, "malformed data key")
+	}
+	ciphertext, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		return "", errors.Wrap(err, "malformed ciphertext")
+	}
+	dataKey, err := open(keyCipher.key, wrappedDataKey)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decrypt data key")
+	}
+	plaintext, err := open(dataKey, ciphertext)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to decrypt secret")
+	}
+	return string(plaintext), nil
+}
+
+// seal encrypts the plaintext with AES-GCM, prefixing the random nonce to the ciphertext.
+func seal(key, plaintext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate nonce")
+	}
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func open(key, ciphertext []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("ciphertext too short")
+	}
+	nonce, ciphertext := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// encryptSecret encrypts the secret with the master key, or returns it as is if no master key is configured.
+func (s *Store) encryptSecret(secret string) (string, error) {
+	if s.secretCipher == nil || secret == "" {
+		return secret, nil
+	}
+	return s.secretCipher.encrypt(secret)
+}
+
+// decryptSecret decrypts the secret if it is encrypted. The secrets saved before
+// a master key was configured are stored in plaintext and returned as is.
+func (s *Store) decryptSecret(secret string) (string, error) {
+	if !strings.HasPrefix(secret, encryptedSecretPrefix) {
+		return secret, nil
+	}
+	if s.secretCipher == nil {
+		return "", errors.New("a master key is required to decrypt the secrets")
+	}
+	return s.secretCipher.decrypt(secret)
+}
+
+// RotateMasterKey re-encrypts all the secrets stored in the database with the new master key.
+// The secrets still stored in plaintext are encrypted as well, so it also encrypts the secrets
+// of an instance configuring a master key for the first time.
+//
+// The secrets are re-encrypted one by one, so an interrupted rotation leaves some of them encrypted
+// with the new master key and the others with the current one. Rotating again is safe since both keys
+// decrypt the secrets, and the instance can meanwhile be started with the new master key and the
+// current one as the previous master key.
+func (s *Store) RotateMasterKey(ctx context.Context, newMasterKey string) error {
+	if newMasterKey == "" {
+		return errors.New("new master key is required")
+	}
+	newCipher := newSecretCipher(newMasterKey)
+	if s.secretCipher != nil && s.secretCipher.keyID == newCipher.keyID {
+		// Resuming with the new master key already configured keeps the previous master key to decrypt with.
+		newCipher = s.secretCipher
+	} else {
+		newCipher.previous = s.secretCipher
+	}
+	s.secretCipher = newCipher
+
+	identityProviders, err := s.ListIdentityProviders(ctx, &FindIdentityProvider{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list identity providers")
+	}
+	for _, identityProvider := range identityProviders {
+		if _, err := s.UpdateIdentityProvider(ctx, &UpdateIdentityProvider{
+			ID:     identityProvider.ID,
+			Type:   identityProvider.Type,
+			Config: identityProvider.Config,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to re-encrypt identity provider %d", identityProvider.ID)
+		}
+	}
+
+	storages, err := s.ListStorages(ctx, &FindStorage{})
+	if err != nil {
+		return errors.Wrap(err, "failed to list storages")
+	}
+	for _, storage := range storages {
+		if _, err := s.UpdateStorage(ctx, &UpdateStorage{
+			ID:     storage.ID,
+			Config: &storage.Config,
+		}); err != nil {
+			return errors.Wrapf(err, "failed to re-encrypt storage %d", storage.ID)
+		}
+	}
+
+	smtpSettings, err := s.ListWorkspaceSettingsV1(ctx, &FindWorkspaceSettingV1{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SMTP,
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to find smtp setting")
+	}
+	for _, smtpSetting := range smtpSettings {
+		if _, err := s.UpsertWorkspaceSettingV1(ctx, smtpSetting); err != nil {
+			return errors.Wrap(err, "failed to re-encrypt smtp setting")
+		}
+	}
+
+	for name := range secretWorkspaceSettingNames {
+		workspaceSettings, err := s.ListWorkspaceSettings(ctx, &FindWorkspaceSetting{Name: name})
+		if err != nil {
+			return errors.Wrapf(err, "failed to find workspace setting %s", name)
+		}
+		for _, workspaceSetting := range workspaceSettings {
+			if _, err := s.UpsertWorkspaceSetting(ctx, workspaceSetting); err != nil {
+				return errors.Wrapf(err, "failed to re-encrypt workspace setting %s", name)
+			}
+		}
+	}
+	return nil
+}
@@ -2,8 +2,12 @@ package store
 
 import (
 	"context"
+	"encoding/json"
 )
 
+// storageSecretKeyField is the field of the S3 storage config holding the secret key.
+const storageSecretKeyField = "secretKey"
+
 type Storage struct {
 	ID     int32
 	Name   string
@@ -26,11 +30,33 @@ type DeleteStorage struct {
 }
 
 func (s *Store) CreateStorage(ctx context.Context, create *Storage) (*Storage, error) {
-	return s.driver.CreateStorage(ctx, create)
+	config, err := s.encryptStorageConfig(create.Config)
+	if err != nil {
+		return nil, err
+	}
+	encryptedCreate := *create
+	encryptedCreate.Config = config
+	storage, err := s.driver.CreateStorage(ctx, &encryptedCreate)
+	if err != nil {
+		return nil, err
+	}
+	if storage.Config, err = s.decryptStorageConfig(storage.Config); err != nil {
+		return nil, err
+	}
+	return storage, nil
 }
 
 func (s *Store) ListStorages(ctx context.Context, find *FindStorage) ([]*Storage, error) {
-	return s.driver.ListStorages(ctx, find)
+	list, err := s.driver.ListStorages(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	for _, storage := range list {
+		if storage.Config, err = s.decryptStorageConfig(storage.Config); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
 }
 
 func (s *Store) GetStorage(ctx context.Context, find *FindStorage) (*Storage, error) {
@@ -46,9 +72,58 @@ func (s *Store) GetStorage(ctx context.Context, find *FindStorage) (*Storage, er
 }
 
 func (s *Store) UpdateStorage(ctx context.Context, update *UpdateStorage) (*Storage, error) {
-	return s.driver.UpdateStorage(ctx, update)
+	encryptedUpdate := *update
+	if update.Config != nil {
+		config, err := s.encryptStorageConfig(*update.Config)
+		if err != nil {
+			return nil, err
+		}
+		encryptedUpdate.Config = &config
+	}
+	storage, err := s.driver.UpdateStorage(ctx, &encryptedUpdate)
+	if err != nil {
+		return nil, err
+	}
+	if storage.Config, err = s.decryptStorageConfig(storage.Config); err != nil {
+		return nil, err
+	}
+	return storage, nil
 }
 
 func (s *Store) DeleteStorage(ctx context.Context, delete *DeleteStorage) error {
 	return s.driver.DeleteStorage(ctx, delete)
 }
+
+// encryptStorageConfig encrypts the secret key in the JSON config of the storage.
+func (s *Store) encryptStorageConfig(config string) (string, error) {
+	return s.transformStorageConfig(config, s.encryptSecret)
+}
+
+func (s *Store) decryptStorageConfig(config string) (string, error) {
+	return s.transformStorageConfig(config, s.decryptSecret)
+}
+
+func (s *Store) transformStorageConfig(config string, transform func(string) (string, error)) (string, error) {
+	if config == "" {
+		return config, nil
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal([]byte(config), &fields); err != nil {
+		// Leave the configs that are not JSON objects as they are.
+		return config, nil
+	}
+	secretKey, ok := fields[storageSecretKeyField].(string)
+	if !ok || secretKey == "" {
+		return config, nil
+	}
+	secretKey, err := transform(secretKey)
+	if err != nil {
+		return "", err
+	}
+	fields[storageSecretKeyField] = secretKey
+	bytes, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
@@ -15,21 +15,43 @@ type Store struct {
 	workspaceSettingV1Cache sync.Map // map[string]*storepb.WorkspaceSetting
 	userCache               sync.Map // map[int]*User
 	userSettingCache        sync.Map // map[string]*UserSetting
+	userSettingMutexes      sync.Map // map[int32]*sync.Mutex
 	idpCache                sync.Map // map[int]*IdentityProvider
+	auditLogFileMutex       sync.Mutex
+	secretCipher            *secretCipher
 }
 
 // New creates a new instance of Store.
 func New(driver Driver, profile *profile.Profile) *Store {
-	return &Store{
+	store := &Store{
 		driver:  driver,
 		Profile: profile,
 	}
+	if profile != nil && profile.MasterKey != "" {
+		store.secretCipher = newSecretCipher(profile.MasterKey)
+		if profile.PreviousMasterKey != "" {
+			store.secretCipher.previous = newSecretCipher(profile.PreviousMasterKey)
+		}
+	}
+	return store
 }
 
 func (s *Store) MigrateManually(ctx context.Context) error {
 	if err := s.MigrateWorkspaceSetting(ctx); err != nil {
 		return err
 	}
+	if err := s.MigrateAccessTokens(ctx); err != nil {
+		return err
+	}
+	if err := s.MigrateMemoTags(ctx); err != nil {
+		return err
+	}
+	if err := s.MigrateMemoTasks(ctx); err != nil {
+		return err
+	}
+	if err := s.MigrateMemoRelations(ctx); err != nil {
+		return err
+	}
 	return nil
 }
 
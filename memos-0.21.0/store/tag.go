@@ -2,15 +2,36 @@ package store
 
 import (
 	"context"
+	"strings"
 )
 
+// TagSeparator separates the names of the parent tags from their children, such as project/alpha.
+const TagSeparator = "/"
+
 type Tag struct {
 	Name      string
 	CreatorID int32
+
+	// Metadata of the tag.
+	Color       string
+	Emoji       string
+	Description string
+	// Pinned is true if the tag is pinned in the sidebar.
+	Pinned bool
 }
 
 type FindTag struct {
 	CreatorID int32
+	Name      *string
+}
+
+type UpdateTag struct {
+	Name        string
+	CreatorID   int32
+	Color       *string
+	Emoji       *string
+	Description *string
+	Pinned      *bool
 }
 
 type DeleteTag struct {
@@ -18,6 +39,22 @@ type DeleteTag struct {
 	CreatorID int32
 }
 
+// MoveTags renames or merges the tags of a user, rewriting their memos and replacing their saved tags.
+type MoveTags struct {
+	CreatorID int32
+	// Memos are the memos with their content rewritten with the new names of the tags.
+	Memos []*Memo
+	// DeleteNames are the names of the saved tags moved.
+	DeleteNames []string
+	// Tags are the saved tags created in place of the moved ones, with their metadata.
+	Tags []*Tag
+
+	// MemoTags and MemoTasks are set by the store from the new content of the memos.
+	MemoTags  []*SetMemoTags
+	MemoTasks []*SetMemoTasks
+}
+
+// UpsertTag creates the tag if it does not exist, the metadata of the existing tags are kept.
 func (s *Store) UpsertTag(ctx context.Context, upsert *Tag) (*Tag, error) {
 	return s.driver.UpsertTag(ctx, upsert)
 }
@@ -26,6 +63,45 @@ func (s *Store) ListTags(ctx context.Context, find *FindTag) ([]*Tag, error) {
 	return s.driver.ListTags(ctx, find)
 }
 
+func (s *Store) GetTag(ctx context.Context, find *FindTag) (*Tag, error) {
+	list, err := s.ListTags(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateTag(ctx context.Context, update *UpdateTag) error {
+	return s.driver.UpdateTag(ctx, update)
+}
+
 func (s *Store) DeleteTag(ctx context.Context, delete *DeleteTag) error {
 	return s.driver.DeleteTag(ctx, delete)
 }
+
+// MoveTags rewrites the memos and the saved tags in a single transaction, so a failure leaves the tags unchanged.
+// Renaming tags does not change the memo links of the content, so the relations of the memos are kept.
+func (s *Store) MoveTags(ctx context.Context, move *MoveTags) error {
+	move.MemoTags, move.MemoTasks = []*SetMemoTags{}, []*SetMemoTasks{}
+	for _, memo := range move.Memos {
+		tags := []string{}
+		if !memo.Encrypted {
+			tags = ExtractMemoTags(memo.Content)
+		}
+		tasks, err := s.getMemoContentTasks(ctx, memo)
+		if err != nil {
+			return err
+		}
+		move.MemoTags = append(move.MemoTags, &SetMemoTags{MemoID: memo.ID, Tags: tags})
+		move.MemoTasks = append(move.MemoTasks, &SetMemoTasks{MemoID: memo.ID, Tasks: tasks})
+	}
+	return s.driver.MoveTags(ctx, move)
+}
+
+// IsTagDescendant returns true if the tag is a child of the parent tag, or a child of its children.
+func IsTagDescendant(tag, parent string) bool {
+	return strings.HasPrefix(tag, parent+TagSeparator)
+}
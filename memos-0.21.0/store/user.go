@@ -2,9 +2,13 @@ package store
 
 import (
 	"context"
+
+	"github.com/usememos/memos/plugin/filter"
+	storepb "github.com/usememos/memos/proto/gen/store"
 )
 
 // Role is the type of a role.
+// Besides the built-in roles, it can be the name of a custom role.
 type Role string
 
 const (
@@ -25,7 +29,8 @@ func (e Role) String() string {
 	case RoleUser:
 		return "USER"
 	}
-	return "USER"
+	// Custom roles are defined in the workspace roles setting.
+	return string(e)
 }
 
 const (
@@ -77,21 +82,29 @@ type UpdateUser struct {
 
 type FindUser struct {
 	ID        *int32
+	IDList    []int32
 	RowStatus *RowStatus
 	Username  *string
 	Role      *Role
 	Email     *string
 	Nickname  *string
+	// Filter is a condition on the fields id, username, nickname, role, row_status and created_ts of the users.
+	Filter filter.Expr
 
 	// Random and limit are used in list users.
 	// Whether to return random users.
 	Random bool
 	// The maximum number of users to return.
 	Limit *int
+	// Cursor lists the users after it.
+	Cursor *Cursor
 }
 
 type DeleteUser struct {
 	ID int32
+	// TransferToID is the id of the user to transfer the memos, resources, tags, share links, webhooks and groups of the user to.
+	// If nil, they are deleted with the user. The other rows of the user are deleted either way.
+	TransferToID *int32
 }
 
 func (s *Store) CreateUser(ctx context.Context, create *User) (*User, error) {
@@ -152,10 +165,10 @@ func (s *Store) GetUser(ctx context.Context, find *FindUser) (*User, error) {
 
 func (s *Store) DeleteUser(ctx context.Context, delete *DeleteUser) error {
 	err := s.driver.DeleteUser(ctx, delete)
-	if err != nil {
-		return err
-	}
-
+	// The driver can fail after committing the deletion, e.g. when vacuuming sqlite, so the caches are evicted either way.
 	s.userCache.Delete(delete.ID)
-	return nil
+	for key := range storepb.UserSettingKey_value {
+		s.userSettingCache.Delete(getUserSettingV1CacheKey(delete.ID, key))
+	}
+	return err
 }
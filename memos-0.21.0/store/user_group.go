@@ -0,0 +1,143 @@
+package store
+
+import (
+	"context"
+)
+
+// UserGroupMemberRole is the role of a member in a user group.
+type UserGroupMemberRole string
+
+const (
+	// UserGroupMemberAdmin manages the group, its members, tags and webhooks.
+	UserGroupMemberAdmin UserGroupMemberRole = "ADMIN"
+	// UserGroupMemberMember reads and posts to the group space.
+	UserGroupMemberMember UserGroupMemberRole = "MEMBER"
+)
+
+func (r UserGroupMemberRole) String() string {
+	return string(r)
+}
+
+type UserGroup struct {
+	ID        int32
+	CreatorID int32
+	CreatedTs int64
+	UpdatedTs int64
+
+	Name        string
+	Description string
+}
+
+type FindUserGroup struct {
+	ID *int32
+	// MemberID limits the groups to those the user is a member of.
+	MemberID *int32
+}
+
+type UpdateUserGroup struct {
+	ID          int32
+	UpdatedTs   *int64
+	Name        *string
+	Description *string
+}
+
+type DeleteUserGroup struct {
+	ID int32
+}
+
+type UserGroupMember struct {
+	GroupID   int32
+	UserID    int32
+	CreatedTs int64
+	Role      UserGroupMemberRole
+}
+
+type FindUserGroupMember struct {
+	GroupID *int32
+	UserID  *int32
+}
+
+type DeleteUserGroupMember struct {
+	GroupID *int32
+	UserID  *int32
+}
+
+// UserGroupTag is a tag shared by the members of a group.
+type UserGroupTag struct {
+	GroupID int32
+	Name    string
+}
+
+type FindUserGroupTag struct {
+	GroupID int32
+}
+
+type DeleteUserGroupTag struct {
+	GroupID int32
+	Name    string
+}
+
+func (s *Store) CreateUserGroup(ctx context.Context, create *UserGroup) (*UserGroup, error) {
+	return s.driver.CreateUserGroup(ctx, create)
+}
+
+func (s *Store) ListUserGroups(ctx context.Context, find *FindUserGroup) ([]*UserGroup, error) {
+	return s.driver.ListUserGroups(ctx, find)
+}
+
+func (s *Store) GetUserGroup(ctx context.Context, find *FindUserGroup) (*UserGroup, error) {
+	list, err := s.ListUserGroups(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpdateUserGroup(ctx context.Context, update *UpdateUserGroup) (*UserGroup, error) {
+	return s.driver.UpdateUserGroup(ctx, update)
+}
+
+func (s *Store) DeleteUserGroup(ctx context.Context, delete *DeleteUserGroup) error {
+	return s.driver.DeleteUserGroup(ctx, delete)
+}
+
+func (s *Store) UpsertUserGroupMember(ctx context.Context, upsert *UserGroupMember) (*UserGroupMember, error) {
+	if upsert.Role == "" {
+		upsert.Role = UserGroupMemberMember
+	}
+	return s.driver.UpsertUserGroupMember(ctx, upsert)
+}
+
+func (s *Store) ListUserGroupMembers(ctx context.Context, find *FindUserGroupMember) ([]*UserGroupMember, error) {
+	return s.driver.ListUserGroupMembers(ctx, find)
+}
+
+func (s *Store) DeleteUserGroupMember(ctx context.Context, delete *DeleteUserGroupMember) error {
+	return s.driver.DeleteUserGroupMember(ctx, delete)
+}
+
+func (s *Store) GetUserGroupMember(ctx context.Context, find *FindUserGroupMember) (*UserGroupMember, error) {
+	list, err := s.ListUserGroupMembers(ctx, find)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return list[0], nil
+}
+
+func (s *Store) UpsertUserGroupTag(ctx context.Context, upsert *UserGroupTag) (*UserGroupTag, error) {
+	return s.driver.UpsertUserGroupTag(ctx, upsert)
+}
+
+func (s *Store) ListUserGroupTags(ctx context.Context, find *FindUserGroupTag) ([]*UserGroupTag, error) {
+	return s.driver.ListUserGroupTags(ctx, find)
+}
+
+func (s *Store) DeleteUserGroupTag(ctx context.Context, delete *DeleteUserGroupTag) error {
+	return s.driver.DeleteUserGroupTag(ctx, delete)
+}
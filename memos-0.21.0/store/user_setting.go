@@ -2,10 +2,24 @@ package store
 
 import (
 	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/proto"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
 
+const (
+	// accessTokenIDLength is the length of the displayable access token id derived from its hash.
+	accessTokenIDLength = 12
+	// accessTokenLastUsedInterval is the minimum number of seconds between two last-used updates.
+	accessTokenLastUsedInterval = 60
+)
+
 type FindUserSetting struct {
 	UserID *int32
 	Key    storepb.UserSettingKey
@@ -17,7 +31,8 @@ func (s *Store) UpsertUserSetting(ctx context.Context, upsert *storepb.UserSetti
 		return nil, err
 	}
 
-	s.userSettingCache.Store(getUserSettingV1CacheKey(userSettingMessage.UserId, userSettingMessage.Key.String()), userSettingMessage)
+	// The cache keeps its own copy, so that the callers changing the setting afterwards do not change the cached one.
+	s.userSettingCache.Store(getUserSettingV1CacheKey(userSettingMessage.UserId, userSettingMessage.Key.String()), proto.Clone(userSettingMessage))
 	return userSettingMessage, nil
 }
 
@@ -28,7 +43,7 @@ func (s *Store) ListUserSettings(ctx context.Context, find *FindUserSetting) ([]
 	}
 
 	for _, userSetting := range userSettingList {
-		s.userSettingCache.Store(getUserSettingV1CacheKey(userSetting.UserId, userSetting.Key.String()), userSetting)
+		s.userSettingCache.Store(getUserSettingV1CacheKey(userSetting.UserId, userSetting.Key.String()), proto.Clone(userSetting))
 	}
 	return userSettingList, nil
 }
@@ -49,12 +64,20 @@ func (s *Store) GetUserSetting(ctx context.Context, find *FindUserSetting) (*sto
 		return nil, nil
 	}
 
-	userSetting := list[0]
-	s.userSettingCache.Store(getUserSettingV1CacheKey(userSetting.UserId, userSetting.Key.String()), userSetting)
-	return userSetting, nil
+	return list[0], nil
+}
+
+// lockUserSetting locks the read-modify-write of the list settings of the user, which are the access tokens
+// and the sessions, so that a write never puts back a list that changed since it was read, like a token revoked meanwhile.
+// The server is the only writer of the settings, which makes a lock per user enough.
+func (s *Store) lockUserSetting(userID int32) func() {
+	value, _ := s.userSettingMutexes.LoadOrStore(userID, &sync.Mutex{})
+	mutex := value.(*sync.Mutex)
+	mutex.Lock()
+	return mutex.Unlock
 }
 
-// GetUserAccessTokens returns the access tokens of the user.
+// GetUserAccessTokens returns copies of the access tokens of the user, which can be changed without changing the cached ones.
 func (s *Store) GetUserAccessTokens(ctx context.Context, userID int32) ([]*storepb.AccessTokensUserSetting_AccessToken, error) {
 	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
 		UserID: &userID,
@@ -67,12 +90,98 @@ func (s *Store) GetUserAccessTokens(ctx context.Context, userID int32) ([]*store
 		return []*storepb.AccessTokensUserSetting_AccessToken{}, nil
 	}
 
-	accessTokensUserSetting := userSetting.GetAccessTokens()
-	return accessTokensUserSetting.AccessTokens, nil
+	// The cached setting is read by the concurrent requests of the user.
+	accessTokens := []*storepb.AccessTokensUserSetting_AccessToken{}
+	for _, accessToken := range userSetting.GetAccessTokens().AccessTokens {
+		accessTokens = append(accessTokens, proto.Clone(accessToken).(*storepb.AccessTokensUserSetting_AccessToken))
+	}
+	return accessTokens, nil
+}
+
+// HashAccessToken returns the hex-encoded SHA-256 digest of the access token.
+func HashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// matchAccessToken returns true if the stored access token matches the given raw token.
+func matchAccessToken(accessToken *storepb.AccessTokensUserSetting_AccessToken, token string) bool {
+	if token == "" {
+		return false
+	}
+	if accessToken.TokenHash != "" {
+		return subtle.ConstantTimeCompare([]byte(accessToken.TokenHash), []byte(HashAccessToken(token))) == 1
+	}
+	return accessToken.AccessToken == token
+}
+
+// FindUserAccessToken returns the stored access token of the user matching the raw token.
+func (s *Store) FindUserAccessToken(ctx context.Context, userID int32, token string) (*storepb.AccessTokensUserSetting_AccessToken, error) {
+	accessTokens, err := s.GetUserAccessTokens(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, accessToken := range accessTokens {
+		if matchAccessToken(accessToken, token) {
+			return accessToken, nil
+		}
+	}
+	return nil, nil
 }
 
-// RemoveUserAccessToken remove the access token of the user.
+// AddUserAccessToken hashes the raw access token and appends it to the access tokens of the user.
+func (s *Store) AddUserAccessToken(ctx context.Context, userID int32, token string, accessToken *storepb.AccessTokensUserSetting_AccessToken) error {
+	unlock := s.lockUserSetting(userID)
+	defer unlock()
+
+	accessTokens, err := s.GetUserAccessTokens(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	accessToken.AccessToken = ""
+	accessToken.TokenHash = HashAccessToken(token)
+	accessToken.Id = accessToken.TokenHash[:accessTokenIDLength]
+	accessTokens = append(accessTokens, accessToken)
+	return s.upsertUserAccessTokens(ctx, userID, accessTokens)
+}
+
+// UpdateUserAccessTokenLastUsed records the time and client IP of the last use of the access token.
+// Writes are throttled so that a busy client does not rewrite the setting on every request.
+func (s *Store) UpdateUserAccessTokenLastUsed(ctx context.Context, userID int32, token, ip string) error {
+	unlock := s.lockUserSetting(userID)
+	defer unlock()
+
+	accessTokens, err := s.GetUserAccessTokens(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	updated := false
+	for _, accessToken := range accessTokens {
+		if !matchAccessToken(accessToken, token) {
+			continue
+		}
+		if now-accessToken.LastUsedTs < accessTokenLastUsedInterval && accessToken.LastUsedIp == ip {
+			return nil
+		}
+		accessToken.LastUsedTs = now
+		accessToken.LastUsedIp = ip
+		updated = true
+		break
+	}
+	if !updated {
+		return nil
+	}
+	return s.upsertUserAccessTokens(ctx, userID, accessTokens)
+}
+
+// RemoveUserAccessToken removes the access token of the user, the token can be the raw token or its id.
 func (s *Store) RemoveUserAccessToken(ctx context.Context, userID int32, token string) error {
+	unlock := s.lockUserSetting(userID)
+	defer unlock()
+
 	oldAccessTokens, err := s.GetUserAccessTokens(ctx, userID)
 	if err != nil {
 		return err
@@ -80,20 +189,253 @@ func (s *Store) RemoveUserAccessToken(ctx context.Context, userID int32, token s
 
 	newAccessTokens := make([]*storepb.AccessTokensUserSetting_AccessToken, 0, len(oldAccessTokens))
 	for _, t := range oldAccessTokens {
-		if token != t.AccessToken {
+		if (t.Id == "" || t.Id != token) && !matchAccessToken(t, token) {
 			newAccessTokens = append(newAccessTokens, t)
 		}
 	}
+	return s.upsertUserAccessTokens(ctx, userID, newAccessTokens)
+}
 
-	_, err = s.UpsertUserSetting(ctx, &storepb.UserSetting{
+// RemoveUserAccessTokens removes the access tokens of the user matching the filter.
+func (s *Store) RemoveUserAccessTokens(ctx context.Context, userID int32, filter func(accessToken *storepb.AccessTokensUserSetting_AccessToken) bool) error {
+	unlock := s.lockUserSetting(userID)
+	defer unlock()
+
+	accessTokens, err := s.GetUserAccessTokens(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	newAccessTokens := make([]*storepb.AccessTokensUserSetting_AccessToken, 0, len(accessTokens))
+	for _, accessToken := range accessTokens {
+		if !filter(accessToken) {
+			newAccessTokens = append(newAccessTokens, accessToken)
+		}
+	}
+	if len(newAccessTokens) == len(accessTokens) {
+		return nil
+	}
+	return s.upsertUserAccessTokens(ctx, userID, newAccessTokens)
+}
+
+func (s *Store) upsertUserAccessTokens(ctx context.Context, userID int32, accessTokens []*storepb.AccessTokensUserSetting_AccessToken) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
 		UserId: userID,
 		Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
 		Value: &storepb.UserSetting_AccessTokens{
 			AccessTokens: &storepb.AccessTokensUserSetting{
-				AccessTokens: newAccessTokens,
+				AccessTokens: accessTokens,
 			},
 		},
 	})
+	return err
+}
+
+// GetUserSessions returns copies of the sign-in sessions of the user, which can be changed without changing the cached ones.
+func (s *Store) GetUserSessions(ctx context.Context, userID int32) ([]*storepb.SessionsUserSetting_Session, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_SESSIONS,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return []*storepb.SessionsUserSetting_Session{}, nil
+	}
+
+	// The cached setting is read by the concurrent requests of the user.
+	sessions := []*storepb.SessionsUserSetting_Session{}
+	for _, session := range userSetting.GetSessions().Sessions {
+		sessions = append(sessions, proto.Clone(session).(*storepb.SessionsUserSetting_Session))
+	}
+	return sessions, nil
+}
 
+// FindUserSession returns the session of the user matching the raw session token.
+func (s *Store) FindUserSession(ctx context.Context, userID int32, token string) (*storepb.SessionsUserSetting_Session, error) {
+	sessions, err := s.GetUserSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	tokenHash := HashAccessToken(token)
+	for _, session := range sessions {
+		if subtle.ConstantTimeCompare([]byte(session.TokenHash), []byte(tokenHash)) == 1 {
+			return session, nil
+		}
+	}
+	return nil, nil
+}
+
+// CreateUserSession hashes the raw session token and appends the session to the sessions of the user.
+// Expired sessions are dropped at the same time.
+func (s *Store) CreateUserSession(ctx context.Context, userID int32, token string, session *storepb.SessionsUserSetting_Session) error {
+	unlock := s.lockUserSetting(userID)
+	defer unlock()
+
+	sessions, err := s.GetUserSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	newSessions := make([]*storepb.SessionsUserSetting_Session, 0, len(sessions)+1)
+	for _, existing := range sessions {
+		if existing.ExpiresTs == 0 || existing.ExpiresTs > now {
+			newSessions = append(newSessions, existing)
+		}
+	}
+	session.TokenHash = HashAccessToken(token)
+	session.Id = session.TokenHash[:accessTokenIDLength]
+	newSessions = append(newSessions, session)
+	return s.upsertUserSessions(ctx, userID, newSessions)
+}
+
+// UpdateUserSessionLastSeen records the time and client IP of the last request of the session.
+// Writes are throttled so that a busy client does not rewrite the setting on every request.
+func (s *Store) UpdateUserSessionLastSeen(ctx context.Context, userID int32, token, ip string) error {
+	unlock := s.lockUserSetting(userID)
+	defer unlock()
+
+	sessions, err := s.GetUserSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().Unix()
+	tokenHash := HashAccessToken(token)
+	for _, session := range sessions {
+		if session.TokenHash != tokenHash {
+			continue
+		}
+		if now-session.LastSeenTs < accessTokenLastUsedInterval && session.LastSeenIp == ip {
+			return nil
+		}
+		session.LastSeenTs = now
+		session.LastSeenIp = ip
+		return s.upsertUserSessions(ctx, userID, sessions)
+	}
+	return nil
+}
+
+// RemoveUserSessions removes the sessions of the user matching the filter.
+func (s *Store) RemoveUserSessions(ctx context.Context, userID int32, filter func(session *storepb.SessionsUserSetting_Session) bool) error {
+	unlock := s.lockUserSetting(userID)
+	defer unlock()
+
+	sessions, err := s.GetUserSessions(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	newSessions := make([]*storepb.SessionsUserSetting_Session, 0, len(sessions))
+	for _, session := range sessions {
+		if !filter(session) {
+			newSessions = append(newSessions, session)
+		}
+	}
+	if len(newSessions) == len(sessions) {
+		return nil
+	}
+	return s.upsertUserSessions(ctx, userID, newSessions)
+}
+
+func (s *Store) upsertUserSessions(ctx context.Context, userID int32, sessions []*storepb.SessionsUserSetting_Session) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_SESSIONS,
+		Value: &storepb.UserSetting_Sessions{
+			Sessions: &storepb.SessionsUserSetting{
+				Sessions: sessions,
+			},
+		},
+	})
+	return err
+}
+
+// GetUserEmailVerification returns the email verification of the user, whose pending email is empty if there is none.
+func (s *Store) GetUserEmailVerification(ctx context.Context, userID int32) (*storepb.EmailVerificationUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_EMAIL_VERIFICATION,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.EmailVerificationUserSetting{}, nil
+	}
+	return userSetting.GetEmailVerification(), nil
+}
+
+// SetUserPendingEmail records the email of the user waiting for verification, or clears it once verified.
+func (s *Store) SetUserPendingEmail(ctx context.Context, userID int32, email string) error {
+	emailVerificationUserSetting := &storepb.EmailVerificationUserSetting{
+		PendingEmail: email,
+	}
+	if email != "" {
+		emailVerificationUserSetting.SentTs = time.Now().Unix()
+	}
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_EMAIL_VERIFICATION,
+		Value: &storepb.UserSetting_EmailVerification{
+			EmailVerification: emailVerificationUserSetting,
+		},
+	})
+	return err
+}
+
+// GetUserPassword returns the state of the password of the user, which is empty if it was never recorded.
+func (s *Store) GetUserPassword(ctx context.Context, userID int32) (*storepb.PasswordUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_PASSWORD,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.PasswordUserSetting{}, nil
+	}
+	return userSetting.GetPassword(), nil
+}
+
+// UpsertUserPassword records the state of the password of the user.
+func (s *Store) UpsertUserPassword(ctx context.Context, userID int32, password *storepb.PasswordUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_PASSWORD,
+		Value: &storepb.UserSetting_Password{
+			Password: password,
+		},
+	})
+	return err
+}
+
+// GetUserSuspension returns the suspension of the user, whose suspended_ts is zero if the user is not suspended.
+func (s *Store) GetUserSuspension(ctx context.Context, userID int32) (*storepb.SuspensionUserSetting, error) {
+	userSetting, err := s.GetUserSetting(ctx, &FindUserSetting{
+		UserID: &userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_SUSPENSION,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if userSetting == nil {
+		return &storepb.SuspensionUserSetting{}, nil
+	}
+	return userSetting.GetSuspension(), nil
+}
+
+// UpsertUserSuspension suspends the user, or lifts the suspension with an empty one.
+func (s *Store) UpsertUserSuspension(ctx context.Context, userID int32, suspension *storepb.SuspensionUserSetting) error {
+	_, err := s.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: userID,
+		Key:    storepb.UserSettingKey_USER_SETTING_SUSPENSION,
+		Value: &storepb.UserSetting_Suspension{
+			Suspension: suspension,
+		},
+	})
 	return err
 }
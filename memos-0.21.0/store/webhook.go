@@ -9,6 +9,11 @@ import (
 type FindWebhook struct {
 	ID        *int32
 	CreatorID *int32
+	// GroupID is zero to find personal webhooks only.
+	GroupID *int32
+	Limit   *int
+	// Cursor lists the webhooks after it.
+	Cursor *Cursor
 }
 
 type UpdateWebhook struct {
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
 
 	storepb "github.com/usememos/memos/proto/gen/store"
 )
@@ -22,8 +23,25 @@ type DeleteWorkspaceSetting struct {
 	Name string
 }
 
+// secretWorkspaceSettingNames are the names of the workspace settings holding secrets, which are encrypted at rest.
+var secretWorkspaceSettingNames = map[string]bool{
+	"secret-session":     true,
+	"telegram-bot-token": true,
+}
+
 func (s *Store) UpsertWorkspaceSetting(ctx context.Context, upsert *WorkspaceSetting) (*WorkspaceSetting, error) {
-	return s.driver.UpsertWorkspaceSetting(ctx, upsert)
+	encryptedUpsert := *upsert
+	if secretWorkspaceSettingNames[upsert.Name] {
+		value, err := s.encryptSecret(upsert.Value)
+		if err != nil {
+			return nil, err
+		}
+		encryptedUpsert.Value = value
+	}
+	if _, err := s.driver.UpsertWorkspaceSetting(ctx, &encryptedUpsert); err != nil {
+		return nil, err
+	}
+	return upsert, nil
 }
 
 func (s *Store) ListWorkspaceSettings(ctx context.Context, find *FindWorkspaceSetting) ([]*WorkspaceSetting, error) {
@@ -33,6 +51,11 @@ func (s *Store) ListWorkspaceSettings(ctx context.Context, find *FindWorkspaceSe
 	}
 
 	for _, systemSettingMessage := range list {
+		if secretWorkspaceSettingNames[systemSettingMessage.Name] {
+			if systemSettingMessage.Value, err = s.decryptSecret(systemSettingMessage.Value); err != nil {
+				return nil, err
+			}
+		}
 		s.workspaceSettingCache.Store(systemSettingMessage.Name, systemSettingMessage)
 	}
 	return list, nil
@@ -73,12 +96,20 @@ type FindWorkspaceSettingV1 struct {
 }
 
 func (s *Store) UpsertWorkspaceSettingV1(ctx context.Context, upsert *storepb.WorkspaceSetting) (*storepb.WorkspaceSetting, error) {
-	workspaceSetting, err := s.driver.UpsertWorkspaceSettingV1(ctx, upsert)
-	if err != nil {
+	encryptedUpsert := upsert
+	if smtpSetting := upsert.GetSmtp(); smtpSetting != nil {
+		password, err := s.encryptSecret(smtpSetting.Password)
+		if err != nil {
+			return nil, err
+		}
+		encryptedUpsert = proto.Clone(upsert).(*storepb.WorkspaceSetting)
+		encryptedUpsert.GetSmtp().Password = password
+	}
+	if _, err := s.driver.UpsertWorkspaceSettingV1(ctx, encryptedUpsert); err != nil {
 		return nil, errors.Wrap(err, "Failed to upsert workspace setting")
 	}
-	s.workspaceSettingV1Cache.Store(workspaceSetting.Key.String(), workspaceSetting)
-	return workspaceSetting, nil
+	s.workspaceSettingV1Cache.Store(upsert.Key.String(), upsert)
+	return upsert, nil
 }
 
 func (s *Store) ListWorkspaceSettingsV1(ctx context.Context, find *FindWorkspaceSettingV1) ([]*storepb.WorkspaceSetting, error) {
@@ -88,6 +119,11 @@ func (s *Store) ListWorkspaceSettingsV1(ctx context.Context, find *FindWorkspace
 	}
 
 	for _, workspaceSetting := range list {
+		if smtpSetting := workspaceSetting.GetSmtp(); smtpSetting != nil {
+			if smtpSetting.Password, err = s.decryptSecret(smtpSetting.Password); err != nil {
+				return nil, err
+			}
+		}
 		s.workspaceSettingV1Cache.Store(workspaceSetting.Key.String(), workspaceSetting)
 	}
 	return list, nil
@@ -127,3 +163,47 @@ func (s *Store) GetWorkspaceGeneralSetting(ctx context.Context) (*storepb.Worksp
 	}
 	return workspaceGeneralSetting, nil
 }
+
+func (s *Store) GetWorkspaceRolesSetting(ctx context.Context) (*storepb.WorkspaceRolesSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSettingV1(ctx, &FindWorkspaceSettingV1{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ROLES,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace setting")
+	}
+
+	workspaceRolesSetting := &storepb.WorkspaceRolesSetting{}
+	if workspaceSetting != nil {
+		workspaceRolesSetting = workspaceSetting.GetRoles()
+	}
+	return workspaceRolesSetting, nil
+}
+
+func (s *Store) GetWorkspacePasswordPolicySetting(ctx context.Context) (*storepb.WorkspacePasswordPolicySetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSettingV1(ctx, &FindWorkspaceSettingV1{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_PASSWORD_POLICY,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace setting")
+	}
+
+	workspacePasswordPolicySetting := &storepb.WorkspacePasswordPolicySetting{}
+	if workspaceSetting != nil {
+		workspacePasswordPolicySetting = workspaceSetting.GetPasswordPolicy()
+	}
+	return workspacePasswordPolicySetting, nil
+}
+
+// GetWorkspaceSmtpSetting returns the SMTP setting, or nil if no SMTP server is configured.
+func (s *Store) GetWorkspaceSmtpSetting(ctx context.Context) (*storepb.WorkspaceSmtpSetting, error) {
+	workspaceSetting, err := s.GetWorkspaceSettingV1(ctx, &FindWorkspaceSettingV1{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SMTP,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get workspace setting")
+	}
+	if workspaceSetting == nil || workspaceSetting.GetSmtp().GetHost() == "" {
+		return nil, nil
+	}
+	return workspaceSetting.GetSmtp(), nil
+}
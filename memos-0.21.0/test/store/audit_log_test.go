@@ -0,0 +1,114 @@
+package teststore
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestAuditLogStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	signIn, err := ts.CreateAuditLog(ctx, &store.AuditLog{
+		ActorID: user.ID,
+		Action:  store.AuditActionSignIn,
+		Target:  "users/1",
+		IP:      "127.0.0.1",
+	})
+	require.NoError(t, err)
+	require.NotZero(t, signIn.CreatedTs)
+	userUpdate, err := ts.CreateAuditLog(ctx, &store.AuditLog{
+		ActorID: user.ID,
+		Action:  store.AuditActionUserUpdate,
+		Target:  "users/2",
+		Payload: &storepb.AuditLogPayload{
+			Before: `{"nickname":"a"}`,
+			After:  `{"nickname":"b"}`,
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateAuditLog(ctx, &store.AuditLog{
+		Action: store.AuditActionSignInFailed,
+		Payload: &storepb.AuditLogPayload{
+			Message: "unmatched password",
+		},
+	})
+	require.NoError(t, err)
+
+	auditLogs, err := ts.ListAuditLogs(ctx, &store.FindAuditLog{})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(auditLogs))
+	// The latest audit log comes first.
+	require.Equal(t, store.AuditActionSignInFailed, auditLogs[0].Action)
+	require.Equal(t, "unmatched password", auditLogs[0].Payload.Message)
+
+	auditLogs, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{
+		ActorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(auditLogs))
+	action := store.AuditActionUserUpdate
+	auditLogs, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{
+		Action: &action,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(auditLogs))
+	require.Equal(t, userUpdate.ID, auditLogs[0].ID)
+	require.Equal(t, `{"nickname":"b"}`, auditLogs[0].Payload.After)
+	target := "users/1"
+	auditLogs, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{
+		Target: &target,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(auditLogs))
+	require.Equal(t, "127.0.0.1", auditLogs[0].IP)
+	createdTsAfter := signIn.CreatedTs + 3600
+	auditLogs, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{
+		CreatedTsAfter: &createdTsAfter,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(auditLogs))
+
+	limit, offset := 2, 2
+	auditLogs, err = ts.ListAuditLogs(ctx, &store.FindAuditLog{
+		Limit:  &limit,
+		Offset: &offset,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(auditLogs))
+	ts.Close()
+}
+
+func TestAuditLogFile(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	ts.Profile.AuditLogFile = filepath.Join(t.TempDir(), "audit.log")
+	for _, action := range []store.AuditAction{store.AuditActionSignIn, store.AuditActionSignOut} {
+		_, err := ts.CreateAuditLog(ctx, &store.AuditLog{
+			ActorID: 1,
+			Action:  action,
+			Target:  "users/1",
+		})
+		require.NoError(t, err)
+	}
+
+	bytes, err := os.ReadFile(ts.Profile.AuditLogFile)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(bytes)), "\n")
+	require.Equal(t, 2, len(lines))
+	line := map[string]any{}
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &line))
+	require.Equal(t, "SIGN_OUT", line["action"])
+	require.Equal(t, "users/1", line["target"])
+	ts.Close()
+}
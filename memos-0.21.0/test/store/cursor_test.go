@@ -0,0 +1,109 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestListMemosWithCursor(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	// The memos share their times, so that the pages are only told apart by the ids.
+	for i := 0; i < 5; i++ {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("memo %d", i),
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		createdTs := int64(1700000000)
+		err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:        memo.ID,
+			CreatedTs: &createdTs,
+		})
+		require.NoError(t, err)
+		if i == 1 {
+			_, err = ts.UpsertMemoOrganizer(ctx, &store.MemoOrganizer{
+				MemoID: memo.ID,
+				UserID: user.ID,
+				Pinned: true,
+			})
+			require.NoError(t, err)
+		}
+	}
+
+	for _, orderByTimeAsc := range []bool{false, true} {
+		find := &store.FindMemo{
+			CreatorID:      &user.ID,
+			OrderByPinned:  true,
+			OrderByTimeAsc: orderByTimeAsc,
+		}
+		memos, err := ts.ListMemos(ctx, find)
+		require.NoError(t, err)
+		require.Equal(t, "memo-1", memos[0].UID)
+		want := []string{}
+		for _, memo := range memos {
+			want = append(want, memo.UID)
+		}
+
+		limit := 2
+		find.Limit = &limit
+		got := []string{}
+		for {
+			page, err := ts.ListMemos(ctx, find)
+			require.NoError(t, err)
+			for _, memo := range page {
+				got = append(got, memo.UID)
+			}
+			if len(page) < limit {
+				break
+			}
+			find.Cursor = store.GetMemoCursor(find, page[len(page)-1])
+		}
+		require.Equal(t, want, got)
+	}
+	ts.Close()
+}
+
+func TestListInboxesWithCursor(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	ids := []int32{}
+	for i := 0; i < 3; i++ {
+		inbox, err := ts.CreateInbox(ctx, &store.Inbox{
+			ReceiverID: user.ID,
+			Status:     store.UNREAD,
+			Message: &storepb.InboxMessage{
+				Type: storepb.InboxMessage_TYPE_MEMO_COMMENT,
+			},
+		})
+		require.NoError(t, err)
+		ids = append([]int32{inbox.ID}, ids...)
+	}
+
+	limit := 2
+	find := &store.FindInbox{
+		ReceiverID: &user.ID,
+		Limit:      &limit,
+	}
+	inboxes, err := ts.ListInboxes(ctx, find)
+	require.NoError(t, err)
+	require.Equal(t, ids[:2], []int32{inboxes[0].ID, inboxes[1].ID})
+	find.Cursor = &store.Cursor{Ts: inboxes[1].CreatedTs, ID: inboxes[1].ID}
+	inboxes, err = ts.ListInboxes(ctx, find)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(inboxes))
+	require.Equal(t, ids[2], inboxes[0].ID)
+	ts.Close()
+}
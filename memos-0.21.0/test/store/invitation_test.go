@@ -0,0 +1,75 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestInvitationStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	invitation, err := ts.CreateInvitation(ctx, &store.Invitation{
+		CreatorID: user.ID,
+		Code:      "invitation-code",
+		Role:      store.RoleUser,
+		MaxUses:   2,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, invitation.CreatedTs)
+	require.Equal(t, int32(0), invitation.UseCount)
+
+	code := "invitation-code"
+	found, err := ts.GetInvitation(ctx, &store.FindInvitation{Code: &code})
+	require.NoError(t, err)
+	require.Equal(t, invitation.ID, found.ID)
+	require.Equal(t, store.RoleUser, found.Role)
+	list, err := ts.ListInvitations(ctx, &store.FindInvitation{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+
+	// The invitation can be used up to its max uses.
+	now := time.Now().Unix()
+	for i := 0; i < 2; i++ {
+		used, err := ts.IncreaseInvitationUseCount(ctx, invitation.ID, now)
+		require.NoError(t, err)
+		require.True(t, used)
+	}
+	used, err := ts.IncreaseInvitationUseCount(ctx, invitation.ID, now)
+	require.NoError(t, err)
+	require.False(t, used)
+	found, err = ts.GetInvitation(ctx, &store.FindInvitation{ID: &invitation.ID})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), found.UseCount)
+
+	// Expired and revoked invitations cannot be used.
+	expiring, err := ts.CreateInvitation(ctx, &store.Invitation{
+		CreatorID: user.ID,
+		Code:      "expiring-code",
+		Role:      store.RoleUser,
+		ExpiresTs: now + 60,
+	})
+	require.NoError(t, err)
+	used, err = ts.IncreaseInvitationUseCount(ctx, expiring.ID, now)
+	require.NoError(t, err)
+	require.True(t, used)
+	used, err = ts.IncreaseInvitationUseCount(ctx, expiring.ID, now+60)
+	require.NoError(t, err)
+	require.False(t, used)
+	revoked, err := ts.UpdateInvitation(ctx, &store.UpdateInvitation{
+		ID:        expiring.ID,
+		RevokedTs: &now,
+	})
+	require.NoError(t, err)
+	require.Equal(t, now, revoked.RevokedTs)
+	used, err = ts.IncreaseInvitationUseCount(ctx, expiring.ID, now)
+	require.NoError(t, err)
+	require.False(t, used)
+	ts.Close()
+}
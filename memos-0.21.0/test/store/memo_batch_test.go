@@ -0,0 +1,172 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv2pb "github.com/usememos/memos/proto/gen/api/v2"
+	storepb "github.com/usememos/memos/proto/gen/store"
+	apiv2 "github.com/usememos/memos/server/route/api/v2"
+	"github.com/usememos/memos/store"
+)
+
+// createTestingMemos creates the memos of the user, each with a resource, a reaction and a reference to the previous memo.
+func createTestingMemos(ctx context.Context, t testing.TB, ts *store.Store, user *store.User, count int) []*store.Memo {
+	memos := []*store.Memo{}
+	for i := 0; i < count; i++ {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        fmt.Sprintf("memo-%d", i),
+			CreatorID:  user.ID,
+			Content:    fmt.Sprintf("memo %d", i),
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		_, err = ts.CreateResource(ctx, &store.Resource{
+			UID:       fmt.Sprintf("resource-%d", i),
+			CreatorID: user.ID,
+			Filename:  fmt.Sprintf("resource-%d.txt", i),
+			Type:      "text/plain",
+			MemoID:    &memo.ID,
+		})
+		require.NoError(t, err)
+		_, err = ts.UpsertReaction(ctx, &storepb.Reaction{
+			CreatorId:    user.ID,
+			ContentId:    fmt.Sprintf("memos/%d", memo.ID),
+			ReactionType: storepb.Reaction_HEART,
+		})
+		require.NoError(t, err)
+		if i > 0 {
+			_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+				MemoID:        memo.ID,
+				RelatedMemoID: memos[i-1].ID,
+				Type:          store.MemoRelationReference,
+			})
+			require.NoError(t, err)
+		}
+		memos = append(memos, memo)
+	}
+	return memos
+}
+
+func TestListByIDList(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memos := createTestingMemos(ctx, t, ts, user, 3)
+	memoIDs := []int32{memos[0].ID, memos[1].ID}
+
+	users, err := ts.ListUsers(ctx, &store.FindUser{IDList: []int32{user.ID}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(users))
+	resources, err := ts.ListResources(ctx, &store.FindResource{MemoIDList: memoIDs})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(resources))
+	reactions, err := ts.ListReactions(ctx, &store.FindReaction{ContentIDList: []string{fmt.Sprintf("memos/%d", memos[2].ID)}})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(reactions))
+	relations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{MemoIDList: memoIDs})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(relations))
+	require.Equal(t, memos[1].ID, relations[0].MemoID)
+	relations, err = ts.ListMemoRelations(ctx, &store.FindMemoRelation{RelatedMemoIDList: memoIDs})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(relations))
+	ts.Close()
+}
+
+// queryCountingDriver counts the reads of the memos and of the rows the memos are converted with.
+type queryCountingDriver struct {
+	store.Driver
+	count int
+}
+
+func (d *queryCountingDriver) ListMemos(ctx context.Context, find *store.FindMemo) ([]*store.Memo, error) {
+	d.count++
+	return d.Driver.ListMemos(ctx, find)
+}
+
+func (d *queryCountingDriver) ListUsers(ctx context.Context, find *store.FindUser) ([]*store.User, error) {
+	d.count++
+	return d.Driver.ListUsers(ctx, find)
+}
+
+func (d *queryCountingDriver) ListMemoRelations(ctx context.Context, find *store.FindMemoRelation) ([]*store.MemoRelation, error) {
+	d.count++
+	return d.Driver.ListMemoRelations(ctx, find)
+}
+
+func (d *queryCountingDriver) ListResources(ctx context.Context, find *store.FindResource) ([]*store.Resource, error) {
+	d.count++
+	return d.Driver.ListResources(ctx, find)
+}
+
+func (d *queryCountingDriver) ListReactions(ctx context.Context, find *store.FindReaction) ([]*storepb.Reaction, error) {
+	d.count++
+	return d.Driver.ListReactions(ctx, find)
+}
+
+func (d *queryCountingDriver) ListMemoProperties(ctx context.Context, find *store.FindMemoProperty) ([]*store.MemoProperty, error) {
+	d.count++
+	return d.Driver.ListMemoProperties(ctx, find)
+}
+
+func (d *queryCountingDriver) ListWorkspaceSettings(ctx context.Context, find *store.FindWorkspaceSetting) ([]*store.WorkspaceSetting, error) {
+	d.count++
+	return d.Driver.ListWorkspaceSettings(ctx, find)
+}
+
+func newQueryCountingStore(ctx context.Context, t testing.TB) (*store.Store, *queryCountingDriver) {
+	dbDriver, profile := newTestingDriver(ctx, t)
+	countingDriver := &queryCountingDriver{Driver: dbDriver}
+	return store.New(countingDriver, profile), countingDriver
+}
+
+// listTestingMemos lists a page of the public memos of the user through the API, and returns the number of queries it took.
+func listTestingMemos(ctx context.Context, t testing.TB, service *apiv2.APIV2Service, driver *queryCountingDriver, user *store.User, pageSize int32) int {
+	driver.count = 0
+	response, err := service.ListMemos(ctx, &apiv2pb.ListMemosRequest{
+		PageSize: pageSize,
+		Filter:   fmt.Sprintf(`creator == "users/%d"`, user.ID),
+	})
+	require.NoError(t, err)
+	require.Equal(t, int(pageSize), len(response.Memos))
+	return driver.count
+}
+
+func TestListMemosQueryCount(t *testing.T) {
+	ctx := context.Background()
+	ts, driver := newQueryCountingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	createTestingMemos(ctx, t, ts, user, 50)
+	service := &apiv2.APIV2Service{Store: ts}
+
+	// The memos of a page are converted with the same queries whatever the size of the page:
+	// the memos, the display time setting twice, and the creators, relations both ways, resources, reactions and properties of all of them.
+	require.Equal(t, 9, listTestingMemos(ctx, t, service, driver, user, 5))
+	require.Equal(t, 9, listTestingMemos(ctx, t, service, driver, user, 50))
+	ts.Close()
+}
+
+// BenchmarkListMemos lists a page of memos with their creators, relations, resources and reactions through the API.
+// Run it with DRIVER and DSN set to benchmark MySQL or Postgres.
+func BenchmarkListMemos(b *testing.B) {
+	ctx := context.Background()
+	ts, driver := newQueryCountingStore(ctx, b)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(b, err)
+	createTestingMemos(ctx, b, ts, user, 50)
+	service := &apiv2.APIV2Service{Store: ts}
+
+	b.ResetTimer()
+	queries := 0
+	for i := 0; i < b.N; i++ {
+		queries += listTestingMemos(ctx, b, service, driver, user, 50)
+	}
+	b.ReportMetric(float64(queries)/float64(b.N), "queries/op")
+	ts.Close()
+}
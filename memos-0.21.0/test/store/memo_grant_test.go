@@ -0,0 +1,163 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoGrantStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "shared-memo",
+		CreatorID:  user.ID,
+		Content:    "shared memo content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	member, err := ts.CreateUser(ctx, &store.User{
+		Username: "member",
+		Role:     store.RoleUser,
+		Email:    "member@test.com",
+	})
+	require.NoError(t, err)
+
+	grant, err := ts.UpsertMemoGrant(ctx, &store.MemoGrant{
+		MemoID:      memo.ID,
+		GranteeType: store.MemoGranteeUser,
+		GranteeID:   member.ID,
+		Role:        store.MemoGrantViewer,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.MemoGrantViewer, grant.Role)
+	grant, err = ts.UpsertMemoGrant(ctx, &store.MemoGrant{
+		MemoID:      memo.ID,
+		GranteeType: store.MemoGranteeUser,
+		GranteeID:   member.ID,
+		Role:        store.MemoGrantEditor,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.MemoGrantEditor, grant.Role)
+	grants, err := ts.ListMemoGrants(ctx, &store.FindMemoGrant{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(grants))
+
+	err = ts.DeleteMemoGrant(ctx, &store.DeleteMemoGrant{
+		ID: &grant.ID,
+	})
+	require.NoError(t, err)
+	grants, err = ts.ListMemoGrants(ctx, &store.FindMemoGrant{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(grants))
+	ts.Close()
+}
+
+func TestMemoGrantVisibility(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	member, err := ts.CreateUser(ctx, &store.User{
+		Username: "member",
+		Role:     store.RoleUser,
+		Email:    "member@test.com",
+	})
+	require.NoError(t, err)
+	userShared, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "user-shared",
+		CreatorID:  user.ID,
+		Content:    "shared with member",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	groupShared, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "group-shared",
+		CreatorID:  user.ID,
+		Content:    "shared with group",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "not-shared",
+		CreatorID:  user.ID,
+		Content:    "not shared",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	own, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "own",
+		CreatorID:  member.ID,
+		Content:    "own memo",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	group, err := ts.CreateUserGroup(ctx, &store.UserGroup{
+		CreatorID: user.ID,
+		Name:      "team",
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertUserGroupMember(ctx, &store.UserGroupMember{
+		GroupID: group.ID,
+		UserID:  member.ID,
+	})
+	require.NoError(t, err)
+	groups, err := ts.ListUserGroups(ctx, &store.FindUserGroup{
+		MemberID: &member.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(groups))
+	require.Equal(t, group.ID, groups[0].ID)
+
+	_, err = ts.UpsertMemoGrant(ctx, &store.MemoGrant{
+		MemoID:      userShared.ID,
+		GranteeType: store.MemoGranteeUser,
+		GranteeID:   member.ID,
+		Role:        store.MemoGrantViewer,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoGrant(ctx, &store.MemoGrant{
+		MemoID:      groupShared.ID,
+		GranteeType: store.MemoGranteeGroup,
+		GranteeID:   group.ID,
+		Role:        store.MemoGrantCommenter,
+	})
+	require.NoError(t, err)
+
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{
+		VisibleToUserID: &member.ID,
+	})
+	require.NoError(t, err)
+	memoIDs := []int32{}
+	for _, memo := range memos {
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	require.ElementsMatch(t, []int32{userShared.ID, groupShared.ID, own.ID}, memoIDs)
+
+	// Deleting the group removes its grants.
+	err = ts.DeleteUserGroup(ctx, &store.DeleteUserGroup{
+		ID: group.ID,
+	})
+	require.NoError(t, err)
+	grants, err := ts.ListMemoGrants(ctx, &store.FindMemoGrant{
+		MemoID: &groupShared.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(grants))
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{
+		VisibleToUserID: &member.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memos))
+	ts.Close()
+}
@@ -0,0 +1,117 @@
+package teststore
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestExtractMemoLinks(t *testing.T) {
+	links := store.ExtractMemoLinks("# Notes\n\nSee [[Weekly plan]] and [[memos/12]].\n\n- follow up on memos/7\n\n`memos/8` and https://example.com/memos/9")
+	require.Equal(t, []*store.MemoLink{
+		{Title: "Weekly plan", Snippet: "See [[Weekly plan]] and [[memos/12]]."},
+		{MemoID: 12, Snippet: "See [[Weekly plan]] and [[memos/12]]."},
+		{MemoID: 7, Snippet: "- follow up on memos/7"},
+	}, links)
+	require.Equal(t, "Weekly plan", store.GetMemoTitle("\n## Weekly plan\n\ncontent"))
+}
+
+func TestMemoRelationsFromContent(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	plan, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "plan-memo",
+		CreatorID:  user.ID,
+		Content:    "# Weekly plan\n\n- [ ] write the report",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	other, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "other-memo",
+		CreatorID:  user.ID,
+		Content:    "other",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "linking-memo",
+		CreatorID:  user.ID,
+		Content:    fmt.Sprintf("See [[weekly plan]] and memos/%d, not [[Missing]] or memos/999", other.ID),
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+
+	referenceType := store.MemoRelationReference
+	find := &store.FindMemoRelation{MemoID: &memo.ID, Type: &referenceType}
+	relations, err := ts.ListMemoRelations(ctx, find)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []*store.MemoRelation{
+		{MemoID: memo.ID, RelatedMemoID: plan.ID, Type: store.MemoRelationReference, Source: store.MemoRelationSourceContent},
+		{MemoID: memo.ID, RelatedMemoID: other.ID, Type: store.MemoRelationReference, Source: store.MemoRelationSourceContent},
+	}, relations)
+
+	// A manual relation is kept when the link is removed from the content, the content ones follow the content.
+	_, err = ts.UpsertMemoRelation(ctx, &store.MemoRelation{
+		MemoID:        memo.ID,
+		RelatedMemoID: other.ID,
+		Type:          store.MemoRelationReference,
+	})
+	require.NoError(t, err)
+	content := "nothing linked"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &content})
+	require.NoError(t, err)
+	relations, err = ts.ListMemoRelations(ctx, find)
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoRelation{
+		{MemoID: memo.ID, RelatedMemoID: other.ID, Type: store.MemoRelationReference, Source: store.MemoRelationSourceManual},
+	}, relations)
+
+	// The encrypted memos have no links.
+	content = fmt.Sprintf("[[memos/%d]]", plan.ID)
+	encrypted := true
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &content, Encrypted: &encrypted})
+	require.NoError(t, err)
+	relations, err = ts.ListMemoRelations(ctx, find)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(relations))
+	ts.Close()
+}
+
+func TestMigrateMemoRelations(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	plan, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "plan-memo",
+		CreatorID:  user.ID,
+		Content:    "# Weekly plan",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "linking-memo",
+		CreatorID:  user.ID,
+		Content:    "See [[Weekly plan]]",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	// The memos created before the links were parsed have no relations.
+	err = ts.DeleteMemoRelation(ctx, &store.DeleteMemoRelation{MemoID: &memo.ID})
+	require.NoError(t, err)
+
+	err = ts.MigrateMemoRelations(ctx)
+	require.NoError(t, err)
+	relations, err := ts.ListMemoRelations(ctx, &store.FindMemoRelation{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoRelation{
+		{MemoID: memo.ID, RelatedMemoID: plan.ID, Type: store.MemoRelationReference, Source: store.MemoRelationSourceContent},
+	}, relations)
+	ts.Close()
+}
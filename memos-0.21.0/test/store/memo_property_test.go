@@ -0,0 +1,110 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/filter"
+	"github.com/usememos/memos/store"
+)
+
+func TestNormalizeMemoProperty(t *testing.T) {
+	property := &store.MemoProperty{Key: "priority", Type: store.MemoPropertyNumber, Value: "2.0"}
+	require.NoError(t, store.NormalizeMemoProperty(property))
+	require.Equal(t, "2", property.Value)
+	require.Equal(t, float64(2), *property.NumberValue())
+
+	property = &store.MemoProperty{Key: "due", Type: store.MemoPropertyDate, Value: "2024-03-10"}
+	require.NoError(t, store.NormalizeMemoProperty(property))
+	require.Equal(t, float64(1710028800), *property.NumberValue())
+
+	invalidProperties := []*store.MemoProperty{
+		{Key: "Priority", Type: store.MemoPropertyString, Value: "high"},
+		{Key: "priority", Type: store.MemoPropertyNumber, Value: "high"},
+		{Key: "done", Type: store.MemoPropertyBoolean, Value: "yes"},
+		{Key: "due", Type: store.MemoPropertyDate, Value: "2024-13-40"},
+		{Key: "url", Type: store.MemoPropertyURL, Value: "javascript:alert(1)"},
+		{Key: "status", Type: "COLOR", Value: "red"},
+	}
+	for _, property := range invalidProperties {
+		require.Error(t, store.NormalizeMemoProperty(property), property.Key)
+	}
+}
+
+func TestMemoPropertyStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memoIDs := []int32{}
+	for _, uid := range []string{"first-memo", "second-memo", "third-memo"} {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    uid,
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		memoIDs = append(memoIDs, memo.ID)
+	}
+	err = ts.SetMemoProperties(ctx, &store.SetMemoProperties{
+		MemoID: memoIDs[0],
+		Properties: []*store.MemoProperty{
+			{Key: "status", Type: store.MemoPropertyString, Value: "done"},
+			{Key: "priority", Type: store.MemoPropertyNumber, Value: "10"},
+		},
+	})
+	require.NoError(t, err)
+	err = ts.SetMemoProperties(ctx, &store.SetMemoProperties{
+		MemoID: memoIDs[1],
+		Properties: []*store.MemoProperty{
+			{Key: "priority", Type: store.MemoPropertyNumber, Value: "2"},
+		},
+	})
+	require.NoError(t, err)
+	err = ts.SetMemoProperties(ctx, &store.SetMemoProperties{
+		MemoID: memoIDs[2],
+		Properties: []*store.MemoProperty{
+			{Key: "status", Type: store.MemoPropertyString, Value: "todo"},
+			{Key: "status", Type: store.MemoPropertyString, Value: "done"},
+		},
+	})
+	require.Error(t, err)
+
+	properties, err := ts.ListMemoProperties(ctx, &store.FindMemoProperty{MemoID: &memoIDs[0]})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoProperty{
+		{MemoID: memoIDs[0], Key: "priority", Type: store.MemoPropertyNumber, Value: "10"},
+		{MemoID: memoIDs[0], Key: "status", Type: store.MemoPropertyString, Value: "done"},
+	}, properties)
+
+	// The numbers are compared as numbers, not as text.
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{
+		Filter: &filter.CompareExpr{Field: "property_number.priority", Operator: filter.OperatorGreater, Value: int64(3)},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memos))
+	require.Equal(t, memoIDs[0], memos[0].ID)
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{
+		Filter: &filter.CompareExpr{Field: "property.status", Operator: filter.OperatorEqual, Value: "done"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memos))
+
+	// The memos without the property come last.
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{OrderByProperty: "priority"})
+	require.NoError(t, err)
+	require.Equal(t, []int32{memoIDs[1], memoIDs[0], memoIDs[2]}, []int32{memos[0].ID, memos[1].ID, memos[2].ID})
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{OrderByProperty: "priority", OrderByPropertyDesc: true})
+	require.NoError(t, err)
+	require.Equal(t, []int32{memoIDs[0], memoIDs[1], memoIDs[2]}, []int32{memos[0].ID, memos[1].ID, memos[2].ID})
+
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memoIDs[0]})
+	require.NoError(t, err)
+	properties, err = ts.ListMemoProperties(ctx, &store.FindMemoProperty{MemoIDList: memoIDs})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(properties))
+	ts.Close()
+}
@@ -0,0 +1,110 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/server/route/api/auth"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoShareLinkStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "shared-memo",
+		CreatorID:  user.ID,
+		Content:    "shared memo content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	link, err := ts.CreateMemoShareLink(ctx, &store.MemoShareLink{
+		CreatorID: user.ID,
+		MemoID:    memo.ID,
+		Token:     "test-token",
+		MaxViews:  2,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), link.ViewCount)
+	token := "test-token"
+	link, err = ts.GetMemoShareLink(ctx, &store.FindMemoShareLink{
+		Token: &token,
+	})
+	require.NoError(t, err)
+	require.NotNil(t, link)
+	require.Equal(t, memo.ID, link.MemoID)
+
+	// The third view exceeds the max views of the link.
+	for i := 0; i < 2; i++ {
+		counted, err := ts.IncreaseMemoShareLinkViewCount(ctx, link.ID)
+		require.NoError(t, err)
+		require.True(t, counted)
+	}
+	counted, err := ts.IncreaseMemoShareLinkViewCount(ctx, link.ID)
+	require.NoError(t, err)
+	require.False(t, counted)
+	link, err = ts.GetMemoShareLink(ctx, &store.FindMemoShareLink{
+		ID: &link.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(2), link.ViewCount)
+
+	err = ts.DeleteMemoShareLink(ctx, &store.DeleteMemoShareLink{
+		ID: &link.ID,
+	})
+	require.NoError(t, err)
+	links, err := ts.ListMemoShareLinks(ctx, &store.FindMemoShareLink{
+		MemoID: &memo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(links))
+	ts.Close()
+}
+
+func TestMemoShareLinkLastViewResources(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "shared-memo",
+		CreatorID:  user.ID,
+		Content:    "shared memo content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	link, err := ts.CreateMemoShareLink(ctx, &store.MemoShareLink{
+		CreatorID: user.ID,
+		MemoID:    memo.ID,
+		Token:     "test-token",
+		MaxViews:  1,
+	})
+	require.NoError(t, err)
+	secret := []byte("secret")
+
+	// The only view of the link is counted when the memo is opened, and its key then fetches the resources.
+	now := time.Now()
+	counted, err := ts.IncreaseMemoShareLinkViewCount(ctx, link.ID)
+	require.NoError(t, err)
+	require.True(t, counted)
+	key := auth.GenerateMemoShareKey(link, secret, now)
+	link, err = ts.GetMemoShareLink(ctx, &store.FindMemoShareLink{
+		ID: &link.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, link.MaxViews, link.ViewCount)
+	require.True(t, auth.CanViewMemoResourcesByShareLink(link, memo, key, secret, now))
+
+	// Without the key of a view, the link no longer gives access to the resources.
+	require.False(t, auth.CanViewMemoResourcesByShareLink(link, memo, "", secret, now))
+	counted, err = ts.IncreaseMemoShareLinkViewCount(ctx, link.ID)
+	require.NoError(t, err)
+	require.False(t, counted)
+	ts.Close()
+}
@@ -0,0 +1,95 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/filter"
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoTagStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "tagged-memo",
+		CreatorID:  user.ID,
+		Content:    "#work #todo\n\n- [ ] **#work** report",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	otherMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "other-memo",
+		CreatorID:  user.ID,
+		Content:    "#work",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "encrypted-memo",
+		CreatorID:  user.ID,
+		Content:    `{"ciphertext":"#work"}`,
+		Visibility: store.Private,
+		Encrypted:  true,
+	})
+	require.NoError(t, err)
+
+	memoTags, err := ts.ListMemoTags(ctx, &store.FindMemoTag{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoTag{{MemoID: memo.ID, Tag: "todo"}, {MemoID: memo.ID, Tag: "work"}}, memoTags)
+	memoTags, err = ts.ListMemoTags(ctx, &store.FindMemoTag{MemoIDList: []int32{memo.ID, otherMemo.ID}})
+	require.NoError(t, err)
+	require.Equal(t, 3, len(memoTags))
+	memoTagCounts, err := ts.ListMemoTagCounts(ctx, &store.FindMemoTagCount{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoTagCount{{Tag: "todo", Count: 1}, {Tag: "work", Count: 2}}, memoTagCounts)
+
+	// The tags follow the content of the memos.
+	content := "#done"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &content})
+	require.NoError(t, err)
+	memoTags, err = ts.ListMemoTags(ctx, &store.FindMemoTag{CreatorID: &user.ID, TagList: []string{"done", "todo"}})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoTag{{MemoID: memo.ID, Tag: "done"}}, memoTags)
+
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+		Filter: &filter.InExpr{Field: "tag", Values: []any{"work", "done"}},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoList))
+
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID})
+	require.NoError(t, err)
+	memoTags, err = ts.ListMemoTags(ctx, &store.FindMemoTag{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memoTags))
+	ts.Close()
+}
+
+func TestMigrateMemoTags(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "tagged-memo",
+		CreatorID:  user.ID,
+		Content:    "#work",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	// The memos created before the tags were kept have no tags.
+	err = ts.SetMemoTags(ctx, &store.SetMemoTags{MemoID: memo.ID})
+	require.NoError(t, err)
+
+	err = ts.MigrateMemoTags(ctx)
+	require.NoError(t, err)
+	memoTags, err := ts.ListMemoTags(ctx, &store.FindMemoTag{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoTag{{MemoID: memo.ID, Tag: "work"}}, memoTags)
+	ts.Close()
+}
@@ -0,0 +1,187 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/plugin/filter"
+	"github.com/usememos/memos/server/route/api/auth"
+	"github.com/usememos/memos/store"
+)
+
+func TestExtractMemoTasks(t *testing.T) {
+	tasks := store.ExtractMemoTasks("# Plan\n\n- [ ] review the draft due:2024-03-10 @member\n- [x] send the invites\n\n* [ ] book a room due:2024-13-40")
+	require.Equal(t, []*store.MemoTask{
+		{Position: 0, Content: "review the draft due:2024-03-10 @member", DueTs: 1710028800},
+		{Position: 1, Content: "send the invites", Completed: true},
+		{Position: 2, Content: "book a room due:2024-13-40"},
+	}, tasks)
+}
+
+func TestMemoTaskStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	member, err := ts.CreateUser(ctx, &store.User{
+		Username: "member",
+		Role:     store.RoleUser,
+		Email:    "member@test.com",
+	})
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "task-memo",
+		CreatorID:  user.ID,
+		Content:    "- [ ] review the draft due:2024-03-10 @member\n- [x] send the invites\n- [ ] book a room due:2024-03-01",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "encrypted-memo",
+		CreatorID:  user.ID,
+		Content:    `{"ciphertext":"- [ ] hidden"}`,
+		Visibility: store.Private,
+		Encrypted:  true,
+	})
+	require.NoError(t, err)
+
+	// The tasks with the earliest due date come first, and the ones without due date last.
+	tasks, err := ts.ListMemoTasks(ctx, &store.FindMemoTask{CreatorOrAssigneeID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, []int32{2, 0, 1}, []int32{tasks[0].Position, tasks[1].Position, tasks[2].Position})
+	completed := false
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{CreatorOrAssigneeID: &member.ID, Completed: &completed})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tasks))
+	require.Equal(t, member.ID, tasks[0].AssigneeID)
+
+	dueTsBefore := time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC).Unix()
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{DueTsBefore: &dueTsBefore})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tasks))
+	require.Equal(t, "book a room due:2024-03-01", tasks[0].Content)
+	notified := true
+	err = ts.UpdateMemoTask(ctx, &store.UpdateMemoTask{MemoID: memo.ID, Position: 2, OverdueNotified: &notified})
+	require.NoError(t, err)
+
+	// The tasks follow the content of the memo, and an unchanged task stays reported overdue.
+	content := "- [ ] book a room due:2024-03-01\n- [x] review the draft due:2024-03-10 @member"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{ID: memo.ID, Content: &content})
+	require.NoError(t, err)
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoTask{
+		{MemoID: memo.ID, Position: 0, Content: "book a room due:2024-03-01", DueTs: 1709251200, OverdueNotified: true},
+		{MemoID: memo.ID, Position: 1, Content: "review the draft due:2024-03-10 @member", Completed: true, DueTs: 1710028800, AssigneeID: member.ID},
+	}, tasks)
+
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+		Filter: &filter.CompareExpr{Field: "has_task", Operator: filter.OperatorEqual, Value: true},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoList))
+
+	err = ts.DeleteMemo(ctx, &store.DeleteMemo{ID: memo.ID})
+	require.NoError(t, err)
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(tasks))
+	ts.Close()
+}
+
+func TestMigrateMemoTasks(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "task-memo",
+		CreatorID:  user.ID,
+		Content:    "- [ ] review the draft",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	// The memos created before the tasks were kept have no tasks.
+	err = ts.SetMemoTasks(ctx, &store.SetMemoTasks{MemoID: memo.ID})
+	require.NoError(t, err)
+
+	err = ts.MigrateMemoTasks(ctx)
+	require.NoError(t, err)
+	tasks, err := ts.ListMemoTasks(ctx, &store.FindMemoTask{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, []*store.MemoTask{{MemoID: memo.ID, Content: "review the draft"}}, tasks)
+	ts.Close()
+}
+
+func TestMemoTaskAccess(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	member, err := ts.CreateUser(ctx, &store.User{
+		Username: "member",
+		Role:     store.RoleUser,
+		Email:    "member@test.com",
+	})
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "private-task-memo",
+		CreatorID:  user.ID,
+		Content:    "- [ ] review the secret draft @member",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	tasks, err := ts.ListMemoTasks(ctx, &store.FindMemoTask{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tasks))
+	task := tasks[0]
+	require.Equal(t, member.ID, task.AssigneeID)
+
+	// The assignee of a task in a private memo neither sees nor checks off the task.
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{CreatorOrAssigneeID: &member.ID, VisibleToUserID: &member.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(tasks))
+	ok, err := auth.CanCompleteMemoTask(ctx, ts, member, memo, task)
+	require.NoError(t, err)
+	require.False(t, ok)
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{CreatorOrAssigneeID: &user.ID, VisibleToUserID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tasks))
+
+	// Once the memo is shared with the assignee, viewing it is enough to check off the task.
+	_, err = ts.UpsertMemoGrant(ctx, &store.MemoGrant{
+		MemoID:      memo.ID,
+		GranteeType: store.MemoGranteeUser,
+		GranteeID:   member.ID,
+		Role:        store.MemoGrantViewer,
+	})
+	require.NoError(t, err)
+	tasks, err = ts.ListMemoTasks(ctx, &store.FindMemoTask{CreatorOrAssigneeID: &member.ID, VisibleToUserID: &member.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tasks))
+	ok, err = auth.CanCompleteMemoTask(ctx, ts, member, memo, task)
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// The other viewers need to be able to edit the memo.
+	viewer, err := ts.CreateUser(ctx, &store.User{
+		Username: "viewer",
+		Role:     store.RoleUser,
+		Email:    "viewer@test.com",
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoGrant(ctx, &store.MemoGrant{
+		MemoID:      memo.ID,
+		GranteeType: store.MemoGranteeUser,
+		GranteeID:   viewer.ID,
+		Role:        store.MemoGrantViewer,
+	})
+	require.NoError(t, err)
+	ok, err = auth.CanCompleteMemoTask(ctx, ts, viewer, memo, task)
+	require.NoError(t, err)
+	require.False(t, ok)
+	ts.Close()
+}
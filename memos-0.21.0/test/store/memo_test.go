@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/usememos/memos/plugin/filter"
 	"github.com/usememos/memos/store"
 )
 
@@ -82,3 +83,115 @@ func TestDeleteMemoStore(t *testing.T) {
 	require.NoError(t, err)
 	ts.Close()
 }
+
+func TestEncryptedMemoStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "plaintext-memo",
+		CreatorID:  user.ID,
+		Content:    "#secret plaintext",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	encryptedMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "encrypted-memo",
+		CreatorID:  user.ID,
+		Content:    `{"version":1,"ciphertext":"#secret"}`,
+		Visibility: store.Private,
+		Encrypted:  true,
+	})
+	require.NoError(t, err)
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{
+		ID: &encryptedMemo.ID,
+	})
+	require.NoError(t, err)
+	require.True(t, memo.Encrypted)
+
+	// Encrypted memos never match the content searches.
+	memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+		CreatorID:     &user.ID,
+		ContentSearch: []string{"#secret"},
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memoList))
+	require.Equal(t, "plaintext-memo", memoList[0].UID)
+	memoList, err = ts.ListMemos(ctx, &store.FindMemo{
+		CreatorID: &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(memoList))
+
+	encrypted := false
+	content := "decrypted"
+	err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+		ID:        encryptedMemo.ID,
+		Content:   &content,
+		Encrypted: &encrypted,
+	})
+	require.NoError(t, err)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{
+		ID: &encryptedMemo.ID,
+	})
+	require.NoError(t, err)
+	require.False(t, memo.Encrypted)
+	require.Equal(t, content, memo.Content)
+	ts.Close()
+}
+
+func TestListMemosWithFilterStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	publicMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "public-memo",
+		CreatorID:  user.ID,
+		Content:    "#work 100% done",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "private-memo",
+		CreatorID:  user.ID,
+		Content:    "#home 100 items",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertMemoOrganizer(ctx, &store.MemoOrganizer{
+		MemoID: publicMemo.ID,
+		UserID: user.ID,
+		Pinned: true,
+	})
+	require.NoError(t, err)
+
+	listMemoUIDs := func(memoFilter filter.Expr) []string {
+		memoList, err := ts.ListMemos(ctx, &store.FindMemo{
+			CreatorID: &user.ID,
+			Filter:    memoFilter,
+		})
+		require.NoError(t, err)
+		uids := []string{}
+		for _, memo := range memoList {
+			uids = append(uids, memo.UID)
+		}
+		return uids
+	}
+	require.Equal(t, []string{"public-memo"}, listMemoUIDs(&filter.CompareExpr{Field: "pinned", Operator: filter.OperatorEqual, Value: true}))
+	require.Equal(t, []string{"private-memo"}, listMemoUIDs(&filter.NotExpr{Expr: &filter.CompareExpr{Field: "pinned", Operator: filter.OperatorEqual, Value: true}}))
+	// The wildcards in the values are matched as they are.
+	require.Equal(t, []string{"public-memo"}, listMemoUIDs(&filter.MatchExpr{Field: "content", Kind: filter.MatchContains, Value: "100%"}))
+	require.Equal(t, []string{"private-memo"}, listMemoUIDs(&filter.OrExpr{Exprs: []filter.Expr{
+		&filter.MatchExpr{Field: "content", Kind: filter.MatchStartsWith, Value: "#home"},
+		&filter.InExpr{Field: "visibility", Values: []any{"PROTECTED"}},
+	}}))
+	require.Equal(t, 2, len(listMemoUIDs(&filter.InExpr{Field: "visibility", Values: []any{"PUBLIC", "PRIVATE"}})))
+
+	_, err = ts.ListMemos(ctx, &store.FindMemo{
+		Filter: &filter.CompareExpr{Field: "email", Operator: filter.OperatorEqual, Value: "a@b.c"},
+	})
+	require.Error(t, err)
+	ts.Close()
+}
@@ -0,0 +1,87 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/usememos/memos/store"
+)
+
+func TestMemoViewStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	host, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	user, _ := createTestingUserContent(ctx, t, ts, host)
+
+	view, err := ts.CreateMemoView(ctx, &store.MemoView{
+		CreatorID:  host.ID,
+		Name:       "Meetings",
+		Filter:     `tag == "meeting" && create_time > now - 7d`,
+		OrderBy:    "display_time asc",
+		Visibility: store.MemoViewPrivate,
+	})
+	require.NoError(t, err)
+	require.NotZero(t, view.ID)
+	require.NotZero(t, view.CreatedTs)
+	found, err := ts.GetMemoView(ctx, &store.FindMemoView{ID: &view.ID})
+	require.NoError(t, err)
+	require.Equal(t, view, found)
+
+	// The private views are only visible to their creators.
+	views, err := ts.ListMemoViews(ctx, &store.FindMemoView{VisibleToUserID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(views))
+	visibility, name := store.MemoViewWorkspace, "Team meetings"
+	updatedView, err := ts.UpdateMemoView(ctx, &store.UpdateMemoView{
+		ID:         view.ID,
+		Name:       &name,
+		Visibility: &visibility,
+	})
+	require.NoError(t, err)
+	require.Equal(t, name, updatedView.Name)
+	require.Equal(t, view.Filter, updatedView.Filter)
+	views, err = ts.ListMemoViews(ctx, &store.FindMemoView{VisibleToUserID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(views))
+
+	err = ts.DeleteMemoView(ctx, &store.DeleteMemoView{ID: view.ID})
+	require.NoError(t, err)
+	views, err = ts.ListMemoViews(ctx, &store.FindMemoView{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(views))
+	ts.Close()
+}
+
+func TestListMemosOrderByTimeAsc(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	// The newer memo is created first, so that the memos are sorted by their times before their ids.
+	for i, uid := range []string{"new", "old"} {
+		memo, err := ts.CreateMemo(ctx, &store.Memo{
+			UID:        uid,
+			CreatorID:  user.ID,
+			Content:    uid,
+			Visibility: store.Public,
+		})
+		require.NoError(t, err)
+		createdTs := int64(1700000000 - i)
+		err = ts.UpdateMemo(ctx, &store.UpdateMemo{
+			ID:        memo.ID,
+			CreatedTs: &createdTs,
+		})
+		require.NoError(t, err)
+	}
+
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, []string{"new", "old"}, []string{memos[0].UID, memos[1].UID})
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{CreatorID: &user.ID, OrderByTimeAsc: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"old", "new"}, []string{memos[0].UID, memos[1].UID})
+	ts.Close()
+}
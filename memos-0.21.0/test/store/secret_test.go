@@ -0,0 +1,214 @@
+package teststore
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+	"github.com/usememos/memos/store/db"
+	"github.com/usememos/memos/test"
+)
+
+func TestSecretEncryption(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	resetTestingDB(ctx, profile, dbDriver)
+	require.NoError(t, dbDriver.Migrate(ctx))
+	newStore := func(masterKey string) *store.Store {
+		storeProfile := *profile
+		storeProfile.MasterKey = masterKey
+		return store.New(dbDriver, &storeProfile)
+	}
+
+	// The secrets saved without a master key are stored in plaintext.
+	ts := newStore("")
+	_, err = ts.CreateIdentityProvider(ctx, &store.IdentityProvider{
+		Name: "GitHub OAuth",
+		Type: store.IdentityProviderOAuth2Type,
+		Config: &store.IdentityProviderConfig{
+			OAuth2Config: &store.IdentityProviderOAuth2Config{
+				ClientID:     "client_id",
+				ClientSecret: "client_secret",
+				FieldMapping: &store.FieldMapping{
+					Identifier: "login",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateStorage(ctx, &store.Storage{
+		Name:   "S3",
+		Type:   "S3",
+		Config: `{"accessKey":"access_key","secretKey":"secret_key","bucket":"memos"}`,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertWorkspaceSetting(ctx, &store.WorkspaceSetting{
+		Name:  "telegram-bot-token",
+		Value: "123:bot_token",
+	})
+	require.NoError(t, err)
+	require.Contains(t, queryRawSecrets(ctx, t, dbDriver), "client_secret")
+
+	// Rotating the master key encrypts the plaintext secrets.
+	require.NoError(t, ts.RotateMasterKey(ctx, "first master key"))
+	rawSecrets := queryRawSecrets(ctx, t, dbDriver)
+	for _, secret := range []string{"client_secret", "secret_key", "bot_token"} {
+		require.NotContains(t, rawSecrets, secret)
+	}
+	require.Contains(t, rawSecrets, `"bucket":"memos"`)
+
+	ts = newStore("first master key")
+	identityProviders, err := ts.ListIdentityProviders(ctx, &store.FindIdentityProvider{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(identityProviders))
+	require.Equal(t, "client_secret", identityProviders[0].Config.OAuth2Config.ClientSecret)
+	storages, err := ts.ListStorages(ctx, &store.FindStorage{})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(storages))
+	require.Contains(t, storages[0].Config, `"secretKey":"secret_key"`)
+	workspaceSetting, err := ts.GetWorkspaceSetting(ctx, &store.FindWorkspaceSetting{Name: "telegram-bot-token"})
+	require.NoError(t, err)
+	require.Equal(t, "123:bot_token", workspaceSetting.Value)
+
+	_, err = newStore("").ListIdentityProviders(ctx, &store.FindIdentityProvider{})
+	require.Error(t, err)
+
+	// Rotating again re-encrypts the secrets with the new master key only.
+	require.NoError(t, ts.RotateMasterKey(ctx, "second master key"))
+	_, err = newStore("first master key").ListStorages(ctx, &store.FindStorage{})
+	require.Error(t, err)
+	storages, err = newStore("second master key").ListStorages(ctx, &store.FindStorage{})
+	require.NoError(t, err)
+	require.Contains(t, storages[0].Config, `"secretKey":"secret_key"`)
+	ts.Close()
+}
+
+func TestSecretRotationResume(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	resetTestingDB(ctx, profile, dbDriver)
+	require.NoError(t, dbDriver.Migrate(ctx))
+	newStore := func(masterKey, previousMasterKey string) *store.Store {
+		storeProfile := *profile
+		storeProfile.MasterKey = masterKey
+		storeProfile.PreviousMasterKey = previousMasterKey
+		return store.New(dbDriver, &storeProfile)
+	}
+
+	ts := newStore("first master key", "")
+	identityProvider, err := ts.CreateIdentityProvider(ctx, &store.IdentityProvider{
+		Name: "GitHub OAuth",
+		Type: store.IdentityProviderOAuth2Type,
+		Config: &store.IdentityProviderConfig{
+			OAuth2Config: &store.IdentityProviderOAuth2Config{
+				ClientID:     "client_id",
+				ClientSecret: "client_secret",
+				FieldMapping: &store.FieldMapping{
+					Identifier: "login",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	storage, err := ts.CreateStorage(ctx, &store.Storage{
+		Name:   "S3",
+		Type:   "S3",
+		Config: `{"accessKey":"access_key","secretKey":"secret_key","bucket":"memos"}`,
+	})
+	require.NoError(t, err)
+
+	// An interrupted rotation has re-encrypted the identity provider but not the storage yet.
+	ts = newStore("second master key", "first master key")
+	_, err = ts.UpdateIdentityProvider(ctx, &store.UpdateIdentityProvider{
+		ID:     identityProvider.ID,
+		Type:   identityProvider.Type,
+		Config: identityProvider.Config,
+	})
+	require.NoError(t, err)
+	_, err = newStore("first master key", "").ListIdentityProviders(ctx, &store.FindIdentityProvider{})
+	require.Error(t, err)
+	_, err = newStore("second master key", "").ListStorages(ctx, &store.FindStorage{})
+	require.Error(t, err)
+
+	// The previous master key decrypts the secrets not re-encrypted yet.
+	identityProviders, err := ts.ListIdentityProviders(ctx, &store.FindIdentityProvider{})
+	require.NoError(t, err)
+	require.Equal(t, "client_secret", identityProviders[0].Config.OAuth2Config.ClientSecret)
+	storages, err := ts.ListStorages(ctx, &store.FindStorage{})
+	require.NoError(t, err)
+	require.Equal(t, storage.Config, storages[0].Config)
+
+	// Running the rotation again finishes it, from either master key.
+	require.NoError(t, newStore("first master key", "").RotateMasterKey(ctx, "second master key"))
+	require.NoError(t, ts.RotateMasterKey(ctx, "second master key"))
+	ts = newStore("second master key", "")
+	identityProviders, err = ts.ListIdentityProviders(ctx, &store.FindIdentityProvider{})
+	require.NoError(t, err)
+	require.Equal(t, "client_secret", identityProviders[0].Config.OAuth2Config.ClientSecret)
+	storages, err = ts.ListStorages(ctx, &store.FindStorage{})
+	require.NoError(t, err)
+	require.Equal(t, storage.Config, storages[0].Config)
+	ts.Close()
+}
+
+// queryRawSecrets returns the secrets as stored in the database.
+func queryRawSecrets(ctx context.Context, t *testing.T, dbDriver store.Driver) string {
+	secrets := []string{}
+	for _, query := range []string{
+		"SELECT config FROM idp",
+		"SELECT config FROM storage",
+		"SELECT value FROM system_setting WHERE name = 'telegram-bot-token'",
+	} {
+		var secret string
+		require.NoError(t, dbDriver.GetDB().QueryRowContext(ctx, query).Scan(&secret))
+		secrets = append(secrets, secret)
+	}
+	return strings.Join(secrets, "\n")
+}
+
+func TestSmtpSettingEncryption(t *testing.T) {
+	ctx := context.Background()
+	profile := test.GetTestingProfile(t)
+	dbDriver, err := db.NewDBDriver(profile)
+	require.NoError(t, err)
+	resetTestingDB(ctx, profile, dbDriver)
+	require.NoError(t, dbDriver.Migrate(ctx))
+	storeProfile := *profile
+	storeProfile.MasterKey = "master key"
+	ts := store.New(dbDriver, &storeProfile)
+
+	smtpSetting, err := ts.GetWorkspaceSmtpSetting(ctx)
+	require.NoError(t, err)
+	require.Nil(t, smtpSetting)
+	_, err = ts.UpsertWorkspaceSettingV1(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_SMTP,
+		Value: &storepb.WorkspaceSetting_Smtp{
+			Smtp: &storepb.WorkspaceSmtpSetting{
+				Host:      "smtp.example.com",
+				Port:      587,
+				Username:  "memos",
+				Password:  "smtp_password",
+				FromEmail: "memos@example.com",
+			},
+		},
+	})
+	require.NoError(t, err)
+	var rawSetting string
+	require.NoError(t, dbDriver.GetDB().QueryRowContext(ctx, "SELECT value FROM system_setting WHERE name = 'WORKSPACE_SETTING_SMTP'").Scan(&rawSetting))
+	require.NotContains(t, rawSetting, "smtp_password")
+	require.Contains(t, rawSetting, "smtp.example.com")
+
+	// A new store reads the setting from the database rather than the cache.
+	smtpSetting, err = store.New(dbDriver, &storeProfile).GetWorkspaceSmtpSetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, "smtp_password", smtpSetting.Password)
+	ts.Close()
+}
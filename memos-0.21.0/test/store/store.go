@@ -14,7 +14,14 @@ import (
 	"github.com/usememos/memos/test"
 )
 
-func NewTestingStore(ctx context.Context, t *testing.T) *store.Store {
+func NewTestingStore(ctx context.Context, t testing.TB) *store.Store {
+	dbDriver, profile := newTestingDriver(ctx, t)
+	store := store.New(dbDriver, profile)
+	return store
+}
+
+// newTestingDriver returns the driver of an empty migrated database, to build a store from.
+func newTestingDriver(ctx context.Context, t testing.TB) (store.Driver, *profile.Profile) {
 	profile := test.GetTestingProfile(t)
 	dbDriver, err := db.NewDBDriver(profile)
 	if err != nil {
@@ -24,9 +31,7 @@ func NewTestingStore(ctx context.Context, t *testing.T) *store.Store {
 	if err := dbDriver.Migrate(ctx); err != nil {
 		fmt.Printf("failed to migrate db, error: %+v\n", err)
 	}
-
-	store := store.New(dbDriver, profile)
-	return store
+	return dbDriver, profile
 }
 
 func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver store.Driver) {
@@ -46,7 +51,18 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS idp;
 		DROP TABLE IF EXISTS inbox;
 		DROP TABLE IF EXISTS webhook;
-		DROP TABLE IF EXISTS reaction;`)
+		DROP TABLE IF EXISTS reaction;
+		DROP TABLE IF EXISTS user_group;
+		DROP TABLE IF EXISTS user_group_member;
+		DROP TABLE IF EXISTS memo_grant;
+		DROP TABLE IF EXISTS memo_share_link;
+		DROP TABLE IF EXISTS user_group_tag;
+		DROP TABLE IF EXISTS audit_log;
+		DROP TABLE IF EXISTS invitation;
+		DROP TABLE IF EXISTS memo_tag;
+		DROP TABLE IF EXISTS memo_view;
+		DROP TABLE IF EXISTS memo_task;
+		DROP TABLE IF EXISTS memo_property;`)
 		if err != nil {
 			fmt.Printf("failed to reset testing db, error: %+v\n", err)
 			panic(err)
@@ -67,7 +83,18 @@ func resetTestingDB(ctx context.Context, profile *profile.Profile, dbDriver stor
 		DROP TABLE IF EXISTS idp CASCADE;
 		DROP TABLE IF EXISTS inbox CASCADE;
 		DROP TABLE IF EXISTS webhook CASCADE;
-		DROP TABLE IF EXISTS reaction CASCADE;`)
+		DROP TABLE IF EXISTS reaction CASCADE;
+		DROP TABLE IF EXISTS user_group CASCADE;
+		DROP TABLE IF EXISTS user_group_member CASCADE;
+		DROP TABLE IF EXISTS memo_grant CASCADE;
+		DROP TABLE IF EXISTS memo_share_link CASCADE;
+		DROP TABLE IF EXISTS user_group_tag CASCADE;
+		DROP TABLE IF EXISTS audit_log CASCADE;
+		DROP TABLE IF EXISTS invitation CASCADE;
+		DROP TABLE IF EXISTS memo_tag CASCADE;
+		DROP TABLE IF EXISTS memo_view CASCADE;
+		DROP TABLE IF EXISTS memo_task CASCADE;
+		DROP TABLE IF EXISTS memo_property CASCADE;`)
 		if err != nil {
 			fmt.Printf("failed to reset testing db, error: %+v\n", err)
 			panic(err)
@@ -37,3 +37,126 @@ func TestTagStore(t *testing.T) {
 	require.Equal(t, 0, len(tags))
 	ts.Close()
 }
+
+func TestTagStoreMetadata(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.UpsertTag(ctx, &store.Tag{
+		CreatorID: user.ID,
+		Name:      "project/alpha",
+	})
+	require.NoError(t, err)
+	color, emoji, pinned := "#ff0000", "🚀", true
+	err = ts.UpdateTag(ctx, &store.UpdateTag{
+		Name:      "project/alpha",
+		CreatorID: user.ID,
+		Color:     &color,
+		Emoji:     &emoji,
+		Pinned:    &pinned,
+	})
+	require.NoError(t, err)
+	// Upserting an existing tag keeps its metadata.
+	_, err = ts.UpsertTag(ctx, &store.Tag{
+		CreatorID: user.ID,
+		Name:      "project/alpha",
+	})
+	require.NoError(t, err)
+	name := "project/alpha"
+	tag, err := ts.GetTag(ctx, &store.FindTag{
+		CreatorID: user.ID,
+		Name:      &name,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &store.Tag{
+		Name:      "project/alpha",
+		CreatorID: user.ID,
+		Color:     color,
+		Emoji:     emoji,
+		Pinned:    true,
+	}, tag)
+	require.True(t, store.IsTagDescendant("project/alpha", "project"))
+	require.False(t, store.IsTagDescendant("projects", "project"))
+	require.False(t, store.IsTagDescendant("project", "project"))
+	ts.Close()
+}
+
+func TestMoveTagsStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "move-tags",
+		CreatorID:  user.ID,
+		Content:    "- [ ] ship it #work/alpha #home",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+	for _, name := range []string{"work/alpha", "home"} {
+		_, err = ts.UpsertTag(ctx, &store.Tag{
+			CreatorID: user.ID,
+			Name:      name,
+		})
+		require.NoError(t, err)
+	}
+	color := "#ff0000"
+	err = ts.UpdateTag(ctx, &store.UpdateTag{
+		Name:      "work/alpha",
+		CreatorID: user.ID,
+		Color:     &color,
+	})
+	require.NoError(t, err)
+	listMemoTags := func() []string {
+		memoTags, err := ts.ListMemoTags(ctx, &store.FindMemoTag{MemoID: &memo.ID})
+		require.NoError(t, err)
+		tags := []string{}
+		for _, memoTag := range memoTags {
+			tags = append(tags, memoTag.Tag)
+		}
+		return tags
+	}
+
+	// A failure leaves the memos and the saved tags unchanged.
+	movedMemo := *memo
+	movedMemo.Content = "- [ ] ship it #job/alpha #home"
+	err = ts.MoveTags(ctx, &store.MoveTags{
+		CreatorID:   user.ID,
+		Memos:       []*store.Memo{&movedMemo},
+		DeleteNames: []string{"work/alpha"},
+		Tags:        []*store.Tag{{Name: "job/alpha"}, {Name: "home"}},
+	})
+	require.Error(t, err)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, "- [ ] ship it #work/alpha #home", memo.Content)
+	require.Equal(t, []string{"home", "work/alpha"}, listMemoTags())
+	tags, err := ts.ListTags(ctx, &store.FindTag{CreatorID: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tags))
+	require.Equal(t, "home", tags[0].Name)
+	require.Equal(t, "work/alpha", tags[1].Name)
+
+	err = ts.MoveTags(ctx, &store.MoveTags{
+		CreatorID:   user.ID,
+		Memos:       []*store.Memo{&movedMemo},
+		DeleteNames: []string{"work/alpha"},
+		Tags:        []*store.Tag{{Name: "job/alpha", Color: color}},
+	})
+	require.NoError(t, err)
+	memo, err = ts.GetMemo(ctx, &store.FindMemo{ID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, movedMemo.Content, memo.Content)
+	require.Equal(t, []string{"home", "job/alpha"}, listMemoTags())
+	tasks, err := ts.ListMemoTasks(ctx, &store.FindMemoTask{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tasks))
+	require.Equal(t, "ship it #job/alpha #home", tasks[0].Content)
+	tags, err = ts.ListTags(ctx, &store.FindTag{CreatorID: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tags))
+	require.Equal(t, "job/alpha", tags[1].Name)
+	require.Equal(t, color, tags[1].Color)
+	ts.Close()
+}
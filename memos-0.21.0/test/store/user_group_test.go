@@ -0,0 +1,216 @@
+package teststore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storepb "github.com/usememos/memos/proto/gen/store"
+	"github.com/usememos/memos/store"
+)
+
+func TestUserGroupMemberStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	group, err := ts.CreateUserGroup(ctx, &store.UserGroup{
+		CreatorID: user.ID,
+		Name:      "team",
+	})
+	require.NoError(t, err)
+
+	member, err := ts.UpsertUserGroupMember(ctx, &store.UserGroupMember{
+		GroupID: group.ID,
+		UserID:  user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.UserGroupMemberMember, member.Role)
+	member, err = ts.UpsertUserGroupMember(ctx, &store.UserGroupMember{
+		GroupID: group.ID,
+		UserID:  user.ID,
+		Role:    store.UserGroupMemberAdmin,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.UserGroupMemberAdmin, member.Role)
+	member, err = ts.GetUserGroupMember(ctx, &store.FindUserGroupMember{
+		GroupID: &group.ID,
+		UserID:  &user.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.UserGroupMemberAdmin, member.Role)
+
+	err = ts.DeleteUserGroupMember(ctx, &store.DeleteUserGroupMember{
+		GroupID: &group.ID,
+		UserID:  &user.ID,
+	})
+	require.NoError(t, err)
+	member, err = ts.GetUserGroupMember(ctx, &store.FindUserGroupMember{
+		GroupID: &group.ID,
+		UserID:  &user.ID,
+	})
+	require.NoError(t, err)
+	require.Nil(t, member)
+	ts.Close()
+}
+
+func TestUserGroupTagStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	group, err := ts.CreateUserGroup(ctx, &store.UserGroup{
+		CreatorID: user.ID,
+		Name:      "team",
+	})
+	require.NoError(t, err)
+
+	for _, name := range []string{"roadmap", "meeting", "roadmap"} {
+		_, err := ts.UpsertUserGroupTag(ctx, &store.UserGroupTag{
+			GroupID: group.ID,
+			Name:    name,
+		})
+		require.NoError(t, err)
+	}
+	tags, err := ts.ListUserGroupTags(ctx, &store.FindUserGroupTag{
+		GroupID: group.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tags))
+	require.Equal(t, "meeting", tags[0].Name)
+
+	err = ts.DeleteUserGroupTag(ctx, &store.DeleteUserGroupTag{
+		GroupID: group.ID,
+		Name:    "meeting",
+	})
+	require.NoError(t, err)
+	tags, err = ts.ListUserGroupTags(ctx, &store.FindUserGroupTag{
+		GroupID: group.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(tags))
+	ts.Close()
+}
+
+func TestUserGroupSpace(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	member, err := ts.CreateUser(ctx, &store.User{
+		Username: "member",
+		Role:     store.RoleUser,
+		Email:    "member@test.com",
+	})
+	require.NoError(t, err)
+	outsider, err := ts.CreateUser(ctx, &store.User{
+		Username: "outsider",
+		Role:     store.RoleUser,
+		Email:    "outsider@test.com",
+	})
+	require.NoError(t, err)
+	group, err := ts.CreateUserGroup(ctx, &store.UserGroup{
+		CreatorID: user.ID,
+		Name:      "team",
+	})
+	require.NoError(t, err)
+	for _, userID := range []int32{user.ID, member.ID} {
+		_, err := ts.UpsertUserGroupMember(ctx, &store.UserGroupMember{
+			GroupID: group.ID,
+			UserID:  userID,
+		})
+		require.NoError(t, err)
+	}
+
+	groupMemo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "group-memo",
+		CreatorID:  user.ID,
+		Content:    "group memo content",
+		Visibility: store.Private,
+		GroupID:    group.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, group.ID, groupMemo.GroupID)
+	_, err = ts.CreateMemo(ctx, &store.Memo{
+		UID:        "personal-memo",
+		CreatorID:  user.ID,
+		Content:    "personal memo content",
+		Visibility: store.Private,
+	})
+	require.NoError(t, err)
+
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{
+		GroupID: &group.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memos))
+	require.Equal(t, groupMemo.ID, memos[0].ID)
+	require.Equal(t, group.ID, memos[0].GroupID)
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{
+		VisibleToUserID: &member.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memos))
+	require.Equal(t, groupMemo.ID, memos[0].ID)
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{
+		VisibleToUserID: &outsider.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memos))
+
+	groupWebhook, err := ts.CreateWebhook(ctx, &storepb.Webhook{
+		CreatorId: user.ID,
+		Name:      "group_webhook",
+		Url:       "https://example.com/group",
+		GroupId:   group.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, group.ID, groupWebhook.GroupId)
+	_, err = ts.CreateWebhook(ctx, &storepb.Webhook{
+		CreatorId: user.ID,
+		Name:      "personal_webhook",
+		Url:       "https://example.com/personal",
+	})
+	require.NoError(t, err)
+	personalGroupID := int32(0)
+	webhooks, err := ts.ListWebhooks(ctx, &store.FindWebhook{
+		CreatorID: &user.ID,
+		GroupID:   &personalGroupID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(webhooks))
+	require.Equal(t, "personal_webhook", webhooks[0].Name)
+	_, err = ts.UpsertUserGroupTag(ctx, &store.UserGroupTag{
+		GroupID: group.ID,
+		Name:    "roadmap",
+	})
+	require.NoError(t, err)
+
+	// Deleting the group gives the memos back to their creators.
+	err = ts.DeleteUserGroup(ctx, &store.DeleteUserGroup{
+		ID: group.ID,
+	})
+	require.NoError(t, err)
+	memo, err := ts.GetMemo(ctx, &store.FindMemo{
+		ID: &groupMemo.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, int32(0), memo.GroupID)
+	memos, err = ts.ListMemos(ctx, &store.FindMemo{
+		VisibleToUserID: &member.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memos))
+	webhooks, err = ts.ListWebhooks(ctx, &store.FindWebhook{
+		GroupID: &group.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(webhooks))
+	tags, err := ts.ListUserGroupTags(ctx, &store.FindUserGroupTag{
+		GroupID: group.ID,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(tags))
+	ts.Close()
+}
@@ -2,6 +2,9 @@ package teststore
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -26,3 +29,372 @@ func TestUserSettingStore(t *testing.T) {
 	require.Equal(t, 1, len(list))
 	ts.Close()
 }
+
+func TestUserAccessTokenStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	err = ts.AddUserAccessToken(ctx, user.ID, "raw-token", &storepb.AccessTokensUserSetting_AccessToken{
+		Description: "test",
+		Scopes:      []string{"memos:read"},
+	})
+	require.NoError(t, err)
+	accessTokens, err := ts.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(accessTokens))
+	require.Equal(t, "", accessTokens[0].AccessToken)
+	require.Equal(t, store.HashAccessToken("raw-token"), accessTokens[0].TokenHash)
+	accessToken, err := ts.FindUserAccessToken(ctx, user.ID, "raw-token")
+	require.NoError(t, err)
+	require.NotNil(t, accessToken)
+	require.Equal(t, []string{"memos:read"}, accessToken.Scopes)
+	accessToken, err = ts.FindUserAccessToken(ctx, user.ID, accessTokens[0].Id)
+	require.NoError(t, err)
+	require.Nil(t, accessToken)
+	err = ts.UpdateUserAccessTokenLastUsed(ctx, user.ID, "raw-token", "127.0.0.1")
+	require.NoError(t, err)
+	accessTokens, err = ts.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, "127.0.0.1", accessTokens[0].LastUsedIp)
+	require.NotZero(t, accessTokens[0].LastUsedTs)
+	err = ts.RemoveUserAccessToken(ctx, user.ID, accessTokens[0].Id)
+	require.NoError(t, err)
+	accessTokens, err = ts.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(accessTokens))
+
+	// A password reset revokes all the access tokens of the user.
+	for _, token := range []string{"first-token", "second-token"} {
+		err = ts.AddUserAccessToken(ctx, user.ID, token, &storepb.AccessTokensUserSetting_AccessToken{})
+		require.NoError(t, err)
+	}
+	err = ts.RemoveUserAccessTokens(ctx, user.ID, func(*storepb.AccessTokensUserSetting_AccessToken) bool {
+		return true
+	})
+	require.NoError(t, err)
+	accessToken, err = ts.FindUserAccessToken(ctx, user.ID, "second-token")
+	require.NoError(t, err)
+	require.Nil(t, accessToken)
+	accessTokens, err = ts.GetUserAccessTokens(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(accessTokens))
+	ts.Close()
+}
+
+func TestUserAccessTokenRemoveWhileUsed(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	err = ts.AddUserAccessToken(ctx, user.ID, "kept-token", &storepb.AccessTokensUserSetting_AccessToken{})
+	require.NoError(t, err)
+
+	// A use of the token at the same time as its removal must not put it back.
+	for round := 0; round < 10; round++ {
+		token := fmt.Sprintf("raw-token-%d", round)
+		err = ts.AddUserAccessToken(ctx, user.ID, token, &storepb.AccessTokensUserSetting_AccessToken{})
+		require.NoError(t, err)
+		var wg sync.WaitGroup
+		errs := make(chan error, 9)
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(ip string) {
+				defer wg.Done()
+				errs <- ts.UpdateUserAccessTokenLastUsed(ctx, user.ID, token, ip)
+			}(fmt.Sprintf("10.0.0.%d", i))
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- ts.RemoveUserAccessToken(ctx, user.ID, token)
+		}()
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			require.NoError(t, err)
+		}
+
+		userSettings, err := ts.ListUserSettings(ctx, &store.FindUserSetting{
+			UserID: &user.ID,
+			Key:    storepb.UserSettingKey_USER_SETTING_ACCESS_TOKENS,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 1, len(userSettings))
+		accessTokens := userSettings[0].GetAccessTokens().AccessTokens
+		require.Equal(t, 1, len(accessTokens), token)
+		require.Equal(t, store.HashAccessToken("kept-token"), accessTokens[0].TokenHash)
+	}
+	ts.Close()
+}
+
+func TestUserAccessTokenConcurrentUse(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	err = ts.AddUserAccessToken(ctx, user.ID, "raw-token", &storepb.AccessTokensUserSetting_AccessToken{})
+	require.NoError(t, err)
+
+	// The requests of the token read it while others record their use of it.
+	var wg sync.WaitGroup
+	errs := make(chan error, 16)
+	lastUsedIPs := make(chan string, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(ip string) {
+			defer wg.Done()
+			errs <- ts.UpdateUserAccessTokenLastUsed(ctx, user.ID, "raw-token", ip)
+		}(fmt.Sprintf("10.0.0.%d", i))
+		go func() {
+			defer wg.Done()
+			accessToken, err := ts.FindUserAccessToken(ctx, user.ID, "raw-token")
+			errs <- err
+			if accessToken != nil {
+				lastUsedIPs <- accessToken.LastUsedIp
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	close(lastUsedIPs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	for ip := range lastUsedIPs {
+		require.True(t, ip == "" || strings.HasPrefix(ip, "10.0.0."), ip)
+	}
+
+	// A use that failed to be saved is not kept in the cache.
+	accessToken, err := ts.FindUserAccessToken(ctx, user.ID, "raw-token")
+	require.NoError(t, err)
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = ts.UpdateUserAccessTokenLastUsed(canceledCtx, user.ID, "raw-token", "10.0.1.1")
+	require.Error(t, err)
+	cachedAccessToken, err := ts.FindUserAccessToken(ctx, user.ID, "raw-token")
+	require.NoError(t, err)
+	require.Equal(t, accessToken.LastUsedIp, cachedAccessToken.LastUsedIp)
+	ts.Close()
+}
+
+func TestUserSessionStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	err = ts.CreateUserSession(ctx, user.ID, "session-1", &storepb.SessionsUserSetting_Session{
+		UserAgent: "test-agent",
+		Ip:        "127.0.0.1",
+	})
+	require.NoError(t, err)
+	err = ts.CreateUserSession(ctx, user.ID, "session-2", &storepb.SessionsUserSetting_Session{})
+	require.NoError(t, err)
+	sessions, err := ts.GetUserSessions(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 2, len(sessions))
+	session, err := ts.FindUserSession(ctx, user.ID, "session-1")
+	require.NoError(t, err)
+	require.Equal(t, "test-agent", session.UserAgent)
+	err = ts.UpdateUserSessionLastSeen(ctx, user.ID, "session-1", "10.0.0.1")
+	require.NoError(t, err)
+	session, err = ts.FindUserSession(ctx, user.ID, "session-1")
+	require.NoError(t, err)
+	require.Equal(t, "10.0.0.1", session.LastSeenIp)
+	err = ts.RemoveUserSessions(ctx, user.ID, func(s *storepb.SessionsUserSetting_Session) bool {
+		return s.Id != session.Id
+	})
+	require.NoError(t, err)
+	sessions, err = ts.GetUserSessions(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(sessions))
+	require.Equal(t, session.Id, sessions[0].Id)
+	ts.Close()
+}
+
+func TestUserSessionConcurrentUpdates(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+
+	// The sessions created at the same time are all kept.
+	var wg sync.WaitGroup
+	errs := make(chan error, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(token string) {
+			defer wg.Done()
+			errs <- ts.CreateUserSession(ctx, user.ID, token, &storepb.SessionsUserSetting_Session{})
+		}(fmt.Sprintf("session-%d", i))
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	sessions, err := ts.GetUserSessions(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, 8, len(sessions))
+
+	// A request of a session at the same time as its revocation must not bring it back.
+	for i := 0; i < 8; i++ {
+		token := fmt.Sprintf("session-%d", i)
+		errs := make(chan error, 9)
+		for j := 0; j < 8; j++ {
+			wg.Add(1)
+			go func(ip string) {
+				defer wg.Done()
+				errs <- ts.UpdateUserSessionLastSeen(ctx, user.ID, token, ip)
+			}(fmt.Sprintf("10.0.0.%d", j))
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs <- ts.RemoveUserSessions(ctx, user.ID, func(session *storepb.SessionsUserSetting_Session) bool {
+				return session.TokenHash == store.HashAccessToken(token)
+			})
+		}()
+		wg.Wait()
+		close(errs)
+		for err := range errs {
+			require.NoError(t, err)
+		}
+		userSettings, err := ts.ListUserSettings(ctx, &store.FindUserSetting{
+			UserID: &user.ID,
+			Key:    storepb.UserSettingKey_USER_SETTING_SESSIONS,
+		})
+		require.NoError(t, err)
+		require.Equal(t, 7-i, len(userSettings[0].GetSessions().Sessions), token)
+	}
+	ts.Close()
+}
+
+func TestUserSessionConcurrentUse(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	err = ts.CreateUserSession(ctx, user.ID, "session", &storepb.SessionsUserSetting_Session{})
+	require.NoError(t, err)
+
+	// The requests of the session read it while others record that it was seen.
+	var wg sync.WaitGroup
+	errs := make(chan error, 16)
+	lastSeenIPs := make(chan string, 8)
+	for i := 0; i < 8; i++ {
+		wg.Add(2)
+		go func(ip string) {
+			defer wg.Done()
+			errs <- ts.UpdateUserSessionLastSeen(ctx, user.ID, "session", ip)
+		}(fmt.Sprintf("10.0.0.%d", i))
+		go func() {
+			defer wg.Done()
+			session, err := ts.FindUserSession(ctx, user.ID, "session")
+			errs <- err
+			if session != nil {
+				lastSeenIPs <- session.LastSeenIp
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	close(lastSeenIPs)
+	for err := range errs {
+		require.NoError(t, err)
+	}
+	for ip := range lastSeenIPs {
+		require.True(t, ip == "" || strings.HasPrefix(ip, "10.0.0."), ip)
+	}
+
+	// A request that failed to be saved is not kept in the cache.
+	session, err := ts.FindUserSession(ctx, user.ID, "session")
+	require.NoError(t, err)
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	err = ts.UpdateUserSessionLastSeen(canceledCtx, user.ID, "session", "10.0.1.1")
+	require.Error(t, err)
+	cachedSession, err := ts.FindUserSession(ctx, user.ID, "session")
+	require.NoError(t, err)
+	require.Equal(t, session.LastSeenIp, cachedSession.LastSeenIp)
+	ts.Close()
+}
+
+func TestUserEmailVerificationSetting(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	emailVerification, err := ts.GetUserEmailVerification(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, "", emailVerification.PendingEmail)
+
+	err = ts.SetUserPendingEmail(ctx, user.ID, "test@example.com")
+	require.NoError(t, err)
+	emailVerification, err = ts.GetUserEmailVerification(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, "test@example.com", emailVerification.PendingEmail)
+	require.NotZero(t, emailVerification.SentTs)
+	list, err := ts.ListUserSettings(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_EMAIL_VERIFICATION,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	require.Equal(t, "test@example.com", list[0].GetEmailVerification().PendingEmail)
+
+	err = ts.SetUserPendingEmail(ctx, user.ID, "")
+	require.NoError(t, err)
+	emailVerification, err = ts.GetUserEmailVerification(ctx, user.ID)
+	require.NoError(t, err)
+	require.Equal(t, "", emailVerification.PendingEmail)
+	ts.Close()
+}
+
+func TestUserPasswordAndSuspensionSetting(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	user, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	password, err := ts.GetUserPassword(ctx, user.ID)
+	require.NoError(t, err)
+	require.False(t, password.ChangeRequired)
+	require.Zero(t, password.UpdatedTs)
+
+	err = ts.UpsertUserPassword(ctx, user.ID, &storepb.PasswordUserSetting{
+		UpdatedTs:      1700000000,
+		ChangeRequired: true,
+	})
+	require.NoError(t, err)
+	password, err = ts.GetUserPassword(ctx, user.ID)
+	require.NoError(t, err)
+	require.True(t, password.ChangeRequired)
+	require.Equal(t, int64(1700000000), password.UpdatedTs)
+
+	suspension, err := ts.GetUserSuspension(ctx, user.ID)
+	require.NoError(t, err)
+	require.Zero(t, suspension.SuspendedTs)
+	err = ts.UpsertUserSuspension(ctx, user.ID, &storepb.SuspensionUserSetting{
+		SuspendedTs: 1700000000,
+		ExpiresTs:   1800000000,
+		Reason:      "spam",
+		SuspenderId: user.ID,
+	})
+	require.NoError(t, err)
+	list, err := ts.ListUserSettings(ctx, &store.FindUserSetting{
+		UserID: &user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_SUSPENSION,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	require.Equal(t, "spam", list[0].GetSuspension().Reason)
+	require.Equal(t, int64(1800000000), list[0].GetSuspension().ExpiresTs)
+
+	// An empty suspension lifts it.
+	err = ts.UpsertUserSuspension(ctx, user.ID, &storepb.SuspensionUserSetting{})
+	require.NoError(t, err)
+	suspension, err = ts.GetUserSuspension(ctx, user.ID)
+	require.NoError(t, err)
+	require.Zero(t, suspension.SuspendedTs)
+	ts.Close()
+}
@@ -2,11 +2,13 @@ package teststore
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"golang.org/x/crypto/bcrypt"
 
+	storepb "github.com/usememos/memos/proto/gen/store"
 	"github.com/usememos/memos/store"
 )
 
@@ -38,6 +40,185 @@ func TestUserStore(t *testing.T) {
 	ts.Close()
 }
 
+func TestDeleteUserStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	host, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	user, memo := createTestingUserContent(ctx, t, ts, host)
+
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: user.ID,
+	})
+	require.NoError(t, err)
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(memos))
+	resources, err := ts.ListResources(ctx, &store.FindResource{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(resources))
+	tags, err := ts.ListTags(ctx, &store.FindTag{CreatorID: user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(tags))
+	reactions, err := ts.ListReactions(ctx, &store.FindReaction{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(reactions))
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(inboxes))
+	webhooks, err := ts.ListWebhooks(ctx, &store.FindWebhook{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(webhooks))
+	userSettings, err := ts.ListUserSettings(ctx, &store.FindUserSetting{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(userSettings))
+	shareLinks, err := ts.ListMemoShareLinks(ctx, &store.FindMemoShareLink{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(shareLinks))
+	ts.Close()
+}
+
+func TestDeleteUserEvictsCaches(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	host, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	user, _ := createTestingUserContent(ctx, t, ts, host)
+	err = ts.AddUserAccessToken(ctx, user.ID, "access-token", &storepb.AccessTokensUserSetting_AccessToken{})
+	require.NoError(t, err)
+	err = ts.CreateUserSession(ctx, user.ID, "session-token", &storepb.SessionsUserSetting_Session{})
+	require.NoError(t, err)
+	accessToken, err := ts.FindUserAccessToken(ctx, user.ID, "access-token")
+	require.NoError(t, err)
+	require.NotNil(t, accessToken)
+	session, err := ts.FindUserSession(ctx, user.ID, "session-token")
+	require.NoError(t, err)
+	require.NotNil(t, session)
+
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID: user.ID,
+	})
+	require.NoError(t, err)
+	deletedUser, err := ts.GetUser(ctx, &store.FindUser{ID: &user.ID})
+	require.NoError(t, err)
+	require.Nil(t, deletedUser)
+	accessToken, err = ts.FindUserAccessToken(ctx, user.ID, "access-token")
+	require.NoError(t, err)
+	require.Nil(t, accessToken)
+	session, err = ts.FindUserSession(ctx, user.ID, "session-token")
+	require.NoError(t, err)
+	require.Nil(t, session)
+	ts.Close()
+}
+
+func TestDeleteUserWithTransferStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	host, err := createTestingHostUser(ctx, ts)
+	require.NoError(t, err)
+	_, err = ts.UpsertTag(ctx, &store.Tag{Name: "shared", CreatorID: host.ID})
+	require.NoError(t, err)
+	user, memo := createTestingUserContent(ctx, t, ts, host)
+
+	err = ts.DeleteUser(ctx, &store.DeleteUser{
+		ID:           user.ID,
+		TransferToID: &host.ID,
+	})
+	require.NoError(t, err)
+	memos, err := ts.ListMemos(ctx, &store.FindMemo{CreatorID: &host.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(memos))
+	require.Equal(t, memo.ID, memos[0].ID)
+	resources, err := ts.ListResources(ctx, &store.FindResource{CreatorID: &host.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(resources))
+	tags, err := ts.ListTags(ctx, &store.FindTag{CreatorID: host.ID})
+	require.NoError(t, err)
+	require.Equal(t, 2, len(tags))
+	webhooks, err := ts.ListWebhooks(ctx, &store.FindWebhook{CreatorID: &host.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(webhooks))
+	shareLinks, err := ts.ListMemoShareLinks(ctx, &store.FindMemoShareLink{MemoID: &memo.ID})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(shareLinks))
+	require.Equal(t, host.ID, shareLinks[0].CreatorID)
+	// The personal rows of the user are deleted.
+	reactions, err := ts.ListReactions(ctx, &store.FindReaction{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(reactions))
+	inboxes, err := ts.ListInboxes(ctx, &store.FindInbox{})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(inboxes))
+	userSettings, err := ts.ListUserSettings(ctx, &store.FindUserSetting{UserID: &user.ID})
+	require.NoError(t, err)
+	require.Equal(t, 0, len(userSettings))
+	ts.Close()
+}
+
+// createTestingUserContent creates a user with a memo and the rows around it, and returns the user and the memo.
+func createTestingUserContent(ctx context.Context, t *testing.T, ts *store.Store, host *store.User) (*store.User, *store.Memo) {
+	user, err := ts.CreateUser(ctx, &store.User{
+		Username: "member",
+		Role:     store.RoleUser,
+		Email:    "member@test.com",
+	})
+	require.NoError(t, err)
+	memo, err := ts.CreateMemo(ctx, &store.Memo{
+		UID:        "member-memo",
+		CreatorID:  user.ID,
+		Content:    "#shared #personal",
+		Visibility: store.Public,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateResource(ctx, &store.Resource{
+		UID:       "member-resource",
+		CreatorID: user.ID,
+		Filename:  "test.txt",
+		Blob:      []byte("test"),
+		Type:      "text/plain",
+		Size:      4,
+		MemoID:    &memo.ID,
+	})
+	require.NoError(t, err)
+	for _, name := range []string{"shared", "personal"} {
+		_, err = ts.UpsertTag(ctx, &store.Tag{Name: name, CreatorID: user.ID})
+		require.NoError(t, err)
+	}
+	_, err = ts.UpsertReaction(ctx, &storepb.Reaction{
+		CreatorId:    user.ID,
+		ContentId:    fmt.Sprintf("memos/%d", memo.ID),
+		ReactionType: storepb.Reaction_HEART,
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateInbox(ctx, &store.Inbox{
+		SenderID:   host.ID,
+		ReceiverID: user.ID,
+		Status:     store.UNREAD,
+		Message:    &storepb.InboxMessage{Type: storepb.InboxMessage_TYPE_MEMO_COMMENT},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateWebhook(ctx, &storepb.Webhook{
+		CreatorId: user.ID,
+		Name:      "member_webhook",
+		Url:       "https://example.com",
+		RowStatus: storepb.RowStatus_NORMAL,
+	})
+	require.NoError(t, err)
+	_, err = ts.UpsertUserSetting(ctx, &storepb.UserSetting{
+		UserId: user.ID,
+		Key:    storepb.UserSettingKey_USER_SETTING_LOCALE,
+		Value:  &storepb.UserSetting_Locale{Locale: "en"},
+	})
+	require.NoError(t, err)
+	_, err = ts.CreateMemoShareLink(ctx, &store.MemoShareLink{
+		CreatorID: user.ID,
+		MemoID:    memo.ID,
+		Token:     "member-token",
+	})
+	require.NoError(t, err)
+	return user, memo
+}
+
 func createTestingHostUser(ctx context.Context, ts *store.Store) (*store.User, error) {
 	userCreate := &store.User{
 		Username:    "test",
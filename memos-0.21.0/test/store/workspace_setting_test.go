@@ -28,3 +28,68 @@ func TestWorkspaceSettingV1Store(t *testing.T) {
 	require.Equal(t, workspaceSetting, list[0])
 	ts.Close()
 }
+
+func TestWorkspaceRolesSettingStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	rolesSetting, err := ts.GetWorkspaceRolesSetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 0, len(rolesSetting.Roles))
+
+	_, err = ts.UpsertWorkspaceSettingV1(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ROLES,
+		Value: &storepb.WorkspaceSetting_Roles{
+			Roles: &storepb.WorkspaceRolesSetting{
+				Roles: []*storepb.WorkspaceRole{
+					{Name: "MODERATOR", Permissions: []string{"memos.moderate"}},
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	list, err := ts.ListWorkspaceSettingsV1(ctx, &store.FindWorkspaceSettingV1{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_ROLES,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	require.Equal(t, "MODERATOR", list[0].GetRoles().Roles[0].Name)
+
+	// Users can be given a custom role.
+	user, err := ts.CreateUser(ctx, &store.User{
+		Username:     "moderator",
+		Role:         "MODERATOR",
+		PasswordHash: "hash",
+	})
+	require.NoError(t, err)
+	require.Equal(t, store.Role("MODERATOR"), user.Role)
+	ts.Close()
+}
+
+func TestWorkspacePasswordPolicySettingStore(t *testing.T) {
+	ctx := context.Background()
+	ts := NewTestingStore(ctx, t)
+	passwordPolicySetting, err := ts.GetWorkspacePasswordPolicySetting(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int32(0), passwordPolicySetting.MinLength)
+
+	_, err = ts.UpsertWorkspaceSettingV1(ctx, &storepb.WorkspaceSetting{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_PASSWORD_POLICY,
+		Value: &storepb.WorkspaceSetting_PasswordPolicy{
+			PasswordPolicy: &storepb.WorkspacePasswordPolicySetting{
+				MinLength:    12,
+				RequireDigit: true,
+				ExpiryDays:   90,
+			},
+		},
+	})
+	require.NoError(t, err)
+	list, err := ts.ListWorkspaceSettingsV1(ctx, &store.FindWorkspaceSettingV1{
+		Key: storepb.WorkspaceSettingKey_WORKSPACE_SETTING_PASSWORD_POLICY,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(list))
+	require.Equal(t, int32(12), list[0].GetPasswordPolicy().MinLength)
+	require.True(t, list[0].GetPasswordPolicy().RequireDigit)
+	require.Equal(t, int32(90), list[0].GetPasswordPolicy().ExpiryDays)
+	ts.Close()
+}
@@ -25,7 +25,7 @@ func getUnusedPort() int {
 	return port
 }
 
-func GetTestingProfile(t *testing.T) *profile.Profile {
+func GetTestingProfile(t testing.TB) *profile.Profile {
 	if err := godotenv.Load(".env"); err != nil {
 		t.Log("failed to load .env file, but it's ok")
 	}
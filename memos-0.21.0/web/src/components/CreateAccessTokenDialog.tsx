@@ -1,4 +1,5 @@
-import { Button, IconButton, Input, Radio, RadioGroup } from "@mui/joy";
+import { Button, Checkbox, IconButton, Input, Radio, RadioGroup } from "@mui/joy";
+import copy from "copy-to-clipboard";
 import React, { useState } from "react";
 import { toast } from "react-hot-toast";
 import { userServiceClient } from "@/grpcweb";
@@ -27,18 +28,22 @@ const expirationOptions = [
   },
 ];
 
+const scopeOptions = ["memos:read", "memos:write", "resources:read", "resources:write", "user:read", "user:write", "admin"];
+
 interface State {
   description: string;
   expiration: number;
+  scopes: string[];
 }
 
 const CreateAccessTokenDialog: React.FC<Props> = (props: Props) => {
   const { destroy, onConfirm } = props;
   const t = useTranslate();
   const currentUser = useCurrentUser();
-  const [state, setState] = useState({
+  const [state, setState] = useState<State>({
     description: "",
     expiration: 3600 * 8,
+    scopes: [],
   });
   const requestState = useLoading(false);
 
@@ -61,6 +66,12 @@ const CreateAccessTokenDialog: React.FC<Props> = (props: Props) => {
     });
   };
 
+  const handleScopeCheckboxChange = (scope: string, checked: boolean) => {
+    setPartialState({
+      scopes: checked ? [...state.scopes, scope] : state.scopes.filter((s) => s !== scope),
+    });
+  };
+
   const handleSaveBtnClick = async () => {
     if (!state.description) {
       toast.error("Description is required");
@@ -68,11 +79,17 @@ const CreateAccessTokenDialog: React.FC<Props> = (props: Props) => {
     }
 
     try {
-      await userServiceClient.createUserAccessToken({
+      const { accessToken } = await userServiceClient.createUserAccessToken({
         name: currentUser.name,
         description: state.description,
         expiresAt: state.expiration ? new Date(Date.now() + state.expiration * 1000) : undefined,
+        scopes: state.scopes,
       });
+      // The access token is only returned once, so copy it for the user right away.
+      if (accessToken) {
+        copy(accessToken.accessToken);
+        toast.success("Access token copied to clipboard, it will not be shown again");
+      }
 
       onConfirm();
       destroy();
@@ -117,6 +134,20 @@ const CreateAccessTokenDialog: React.FC<Props> = (props: Props) => {
             </RadioGroup>
           </div>
         </div>
+        <div className="w-full flex flex-col justify-start items-start mb-3">
+          <span className="mb-2">Scopes</span>
+          <div className="w-full flex flex-row flex-wrap justify-start items-center gap-2">
+            {scopeOptions.map((scope) => (
+              <Checkbox
+                key={scope}
+                label={scope}
+                checked={state.scopes.includes(scope)}
+                onChange={(e) => handleScopeCheckboxChange(scope, e.target.checked)}
+              />
+            ))}
+          </div>
+          <p className="text-sm text-gray-500 mt-1">Leave empty to grant full access.</p>
+        </div>
         <div className="w-full flex flex-row justify-end items-center mt-4 space-x-2">
           <Button color="neutral" variant="plain" disabled={requestState.isLoading} loading={requestState.isLoading} onClick={destroy}>
             {t("common.cancel")}
@@ -1,7 +1,5 @@
 import { Button, IconButton } from "@mui/joy";
-import copy from "copy-to-clipboard";
 import { useEffect, useState } from "react";
-import { toast } from "react-hot-toast";
 import { userServiceClient } from "@/grpcweb";
 import useCurrentUser from "@/hooks/useCurrentUser";
 import { UserAccessToken } from "@/types/proto/api/v2/user_service";
@@ -32,28 +30,19 @@ const AccessTokenSection = () => {
     setUserAccessTokens(accessTokens);
   };
 
-  const copyAccessToken = (accessToken: string) => {
-    copy(accessToken);
-    toast.success("Access token copied to clipboard");
-  };
-
-  const handleDeleteAccessToken = async (accessToken: string) => {
+  const handleDeleteAccessToken = async (userAccessToken: UserAccessToken) => {
     showCommonDialog({
       title: "Delete Access Token",
-      content: `Are you sure to delete access token \`${getFormatedAccessToken(accessToken)}\`? You cannot undo this action.`,
+      content: `Are you sure to delete access token \`${userAccessToken.description}\`? You cannot undo this action.`,
       style: "danger",
       dialogName: "delete-access-token-dialog",
       onConfirm: async () => {
-        await userServiceClient.deleteUserAccessToken({ name: currentUser.name, accessToken: accessToken });
-        setUserAccessTokens(userAccessTokens.filter((token) => token.accessToken !== accessToken));
+        await userServiceClient.deleteUserAccessToken({ name: currentUser.name, accessToken: userAccessToken.id });
+        setUserAccessTokens(userAccessTokens.filter((token) => token.id !== userAccessToken.id));
       },
     });
   };
 
-  const getFormatedAccessToken = (accessToken: string) => {
-    return `${accessToken.slice(0, 4)}****${accessToken.slice(-4)}`;
-  };
-
   return (
     <div className="mt-6 w-full flex flex-col justify-start items-start space-y-4">
       <div className="w-full">
@@ -92,9 +81,15 @@ const AccessTokenSection = () => {
                     <th scope="col" className="px-3 py-2 text-left text-sm font-semibold text-gray-900 dark:text-gray-400">
                       Created At
                     </th>
+                    <th scope="col" className="px-3 py-2 text-left text-sm font-semibold text-gray-900 dark:text-gray-400">
+                      Scopes
+                    </th>
                     <th scope="col" className="px-3 py-2 text-left text-sm font-semibold text-gray-900 dark:text-gray-400">
                       Expires At
                     </th>
+                    <th scope="col" className="px-3 py-2 text-left text-sm font-semibold text-gray-900 dark:text-gray-400">
+                      Last Used
+                    </th>
                     <th scope="col" className="relative py-3.5 pl-3 pr-4">
                       <span className="sr-only">{t("common.delete")}</span>
                     </th>
@@ -102,12 +97,9 @@ const AccessTokenSection = () => {
                 </thead>
                 <tbody className="divide-y divide-gray-200 dark:divide-zinc-700">
                   {userAccessTokens.map((userAccessToken) => (
-                    <tr key={userAccessToken.accessToken}>
-                      <td className="whitespace-nowrap px-3 py-2 text-sm text-gray-900 dark:text-gray-400 flex flex-row justify-start items-center gap-x-1">
-                        <span className="font-mono">{getFormatedAccessToken(userAccessToken.accessToken)}</span>
-                        <IconButton color="neutral" variant="plain" size="sm" onClick={() => copyAccessToken(userAccessToken.accessToken)}>
-                          <Icon.Clipboard className="w-4 h-auto text-gray-400" />
-                        </IconButton>
+                    <tr key={userAccessToken.id}>
+                      <td className="whitespace-nowrap px-3 py-2 text-sm text-gray-900 dark:text-gray-400">
+                        <span className="font-mono">{userAccessToken.id}</span>
                       </td>
                       <td className="whitespace-nowrap py-2 pl-4 pr-3 text-sm text-gray-900 dark:text-gray-400">
                         {userAccessToken.description}
@@ -115,16 +107,22 @@ const AccessTokenSection = () => {
                       <td className="whitespace-nowrap px-3 py-2 text-sm text-gray-500 dark:text-gray-400">
                         {userAccessToken.issuedAt?.toLocaleString()}
                       </td>
+                      <td className="whitespace-nowrap px-3 py-2 text-sm text-gray-500 dark:text-gray-400">
+                        {userAccessToken.scopes.length > 0 ? userAccessToken.scopes.join(", ") : "All"}
+                      </td>
                       <td className="whitespace-nowrap px-3 py-2 text-sm text-gray-500 dark:text-gray-400">
                         {userAccessToken.expiresAt?.toLocaleString() ?? "Never"}
                       </td>
+                      <td className="whitespace-nowrap px-3 py-2 text-sm text-gray-500 dark:text-gray-400">
+                        {userAccessToken.lastUsedAt ? `${userAccessToken.lastUsedAt.toLocaleString()} (${userAccessToken.lastUsedIp})` : "Never"}
+                      </td>
                       <td className="relative whitespace-nowrap py-2 pl-3 pr-4 text-right text-sm">
                         <IconButton
                           color="danger"
                           variant="plain"
                           size="sm"
                           onClick={() => {
-                            handleDeleteAccessToken(userAccessToken.accessToken);
+                            handleDeleteAccessToken(userAccessToken);
                           }}
                         >
                           <Icon.Trash className="w-4 h-auto" />
@@ -120,6 +120,19 @@ const MemberSection = () => {
     });
   };
 
+  const handleSignOutUserClick = (user: User) => {
+    showCommonDialog({
+      title: "Sign out everywhere",
+      content: `Are you sure to sign out all sessions of ${user.nickname}?`,
+      style: "danger",
+      dialogName: "sign-out-user-dialog",
+      onConfirm: async () => {
+        await userServiceClient.revokeAllOtherSessions({ name: user.name });
+        toast.success("Signed out all sessions");
+      },
+    });
+  };
+
   const handleRestoreUserClick = async (user: User) => {
     await userServiceClient.updateUser({
       user: {
@@ -221,6 +234,7 @@ const MemberSection = () => {
                           <MenuItem onClick={() => handleChangePasswordClick(user)}>
                             {t("setting.account-section.change-password")}
                           </MenuItem>
+                          <MenuItem onClick={() => handleSignOutUserClick(user)}>Sign out everywhere</MenuItem>
                           {user.rowStatus === RowStatus.ACTIVE ? (
                             <MenuItem onClick={() => handleArchiveUserClick(user)}>{t("setting.member-section.archive-member")}</MenuItem>
                           ) : (
@@ -8,6 +8,7 @@ import Icon from "../Icon";
 import showUpdateAccountDialog from "../UpdateAccountDialog";
 import UserAvatar from "../UserAvatar";
 import AccessTokenSection from "./AccessTokenSection";
+import SessionSection from "./SessionSection";
 
 const MyAccountSection = () => {
   const t = useTranslate();
@@ -52,6 +53,7 @@ const MyAccountSection = () => {
       </div>
 
       <AccessTokenSection />
+      <SessionSection />
     </div>
   );
 };
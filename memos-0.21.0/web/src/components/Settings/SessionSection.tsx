@@ -0,0 +1,120 @@
+import { Button, IconButton } from "@mui/joy";
+import { useEffect, useState } from "react";
+import { userServiceClient } from "@/grpcweb";
+import useCurrentUser from "@/hooks/useCurrentUser";
+import { UserSession } from "@/types/proto/api/v2/user_service";
+import { showCommonDialog } from "../Dialog/CommonDialog";
+import Icon from "../Icon";
+
+const listSessions = async (name: string) => {
+  const { sessions } = await userServiceClient.listSessions({ name });
+  return sessions;
+};
+
+const SessionSection = () => {
+  const currentUser = useCurrentUser();
+  const [sessions, setSessions] = useState<UserSession[]>([]);
+
+  useEffect(() => {
+    listSessions(currentUser.name).then((sessions) => {
+      setSessions(sessions);
+    });
+  }, []);
+
+  const handleRevokeSession = async (session: UserSession) => {
+    showCommonDialog({
+      title: "Revoke Session",
+      content: `Are you sure to sign out the session from \`${session.ip}\`?`,
+      style: "danger",
+      dialogName: "revoke-session-dialog",
+      onConfirm: async () => {
+        await userServiceClient.revokeSession({ name: currentUser.name, sessionId: session.id });
+        setSessions(sessions.filter((s) => s.id !== session.id));
+      },
+    });
+  };
+
+  const handleRevokeAllOtherSessions = async () => {
+    showCommonDialog({
+      title: "Sign Out Other Sessions",
+      content: "Are you sure to sign out all other sessions?",
+      style: "danger",
+      dialogName: "revoke-all-other-sessions-dialog",
+      onConfirm: async () => {
+        await userServiceClient.revokeAllOtherSessions({ name: currentUser.name });
+        setSessions(sessions.filter((s) => s.current));
+      },
+    });
+  };
+
+  return (
+    <div className="mt-6 w-full flex flex-col justify-start items-start space-y-4">
+      <div className="w-full">
+        <div className="sm:flex sm:items-center sm:justify-between">
+          <div className="sm:flex-auto space-y-1">
+            <p className="flex flex-row justify-start items-center font-medium text-gray-700 dark:text-gray-400">Sessions</p>
+            <p className="text-sm text-gray-700 dark:text-gray-500">A list of all devices signed in to your account.</p>
+          </div>
+          <div className="mt-4 sm:mt-0">
+            <Button variant="outlined" color="neutral" onClick={handleRevokeAllOtherSessions}>
+              Sign out others
+            </Button>
+          </div>
+        </div>
+        <div className="flow-root">
+          <div className="overflow-x-auto">
+            <div className="inline-block min-w-full py-2 align-middle">
+              <table className="min-w-full divide-y divide-gray-300 dark:divide-zinc-600">
+                <thead>
+                  <tr>
+                    <th scope="col" className="px-3 py-2 text-left text-sm font-semibold text-gray-900 dark:text-gray-400">
+                      Device
+                    </th>
+                    <th scope="col" className="px-3 py-2 text-left text-sm font-semibold text-gray-900 dark:text-gray-400">
+                      IP
+                    </th>
+                    <th scope="col" className="px-3 py-2 text-left text-sm font-semibold text-gray-900 dark:text-gray-400">
+                      Created At
+                    </th>
+                    <th scope="col" className="px-3 py-2 text-left text-sm font-semibold text-gray-900 dark:text-gray-400">
+                      Last Seen
+                    </th>
+                    <th scope="col" className="relative py-3.5 pl-3 pr-4">
+                      <span className="sr-only">Revoke</span>
+                    </th>
+                  </tr>
+                </thead>
+                <tbody className="divide-y divide-gray-200 dark:divide-zinc-700">
+                  {sessions.map((session) => (
+                    <tr key={session.id}>
+                      <td className="max-w-[16rem] truncate px-3 py-2 text-sm text-gray-900 dark:text-gray-400" title={session.userAgent}>
+                        {session.userAgent || "Unknown"}
+                        {session.current && <span className="ml-1 text-green-600">(current)</span>}
+                      </td>
+                      <td className="whitespace-nowrap px-3 py-2 text-sm text-gray-500 dark:text-gray-400">{session.lastSeenIp || session.ip}</td>
+                      <td className="whitespace-nowrap px-3 py-2 text-sm text-gray-500 dark:text-gray-400">
+                        {session.createTime?.toLocaleString()}
+                      </td>
+                      <td className="whitespace-nowrap px-3 py-2 text-sm text-gray-500 dark:text-gray-400">
+                        {session.lastSeenTime?.toLocaleString()}
+                      </td>
+                      <td className="relative whitespace-nowrap py-2 pl-3 pr-4 text-right text-sm">
+                        {!session.current && (
+                          <IconButton color="danger" variant="plain" size="sm" onClick={() => handleRevokeSession(session)}>
+                            <Icon.Trash className="w-4 h-auto" />
+                          </IconButton>
+                        )}
+                      </td>
+                    </tr>
+                  ))}
+                </tbody>
+              </table>
+            </div>
+          </div>
+        </div>
+      </div>
+    </div>
+  );
+};
+
+export default SessionSection;
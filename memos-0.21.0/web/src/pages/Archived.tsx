@@ -40,7 +40,7 @@ const Archived = () => {
     const filters = [`creator == "${user.name}"`, `row_status == "ARCHIVED"`];
     const contentSearch: string[] = [];
     if (tagQuery) {
-      contentSearch.push(JSON.stringify(`#${tagQuery}`));
+      filters.push(`tag in [${JSON.stringify(tagQuery)}]`);
     }
     if (textQuery) {
       contentSearch.push(JSON.stringify(textQuery));
@@ -36,7 +36,7 @@ const Explore = () => {
     const filters = [`row_status == "NORMAL"`, `visibilities == [${user ? "'PUBLIC', 'PROTECTED'" : "'PUBLIC'"}]`];
     const contentSearch: string[] = [];
     if (tagQuery) {
-      contentSearch.push(JSON.stringify(`#${tagQuery}`));
+      filters.push(`tag in [${JSON.stringify(tagQuery)}]`);
     }
     if (textQuery) {
       contentSearch.push(JSON.stringify(textQuery));
@@ -42,7 +42,7 @@ const Home = () => {
     const filters = [`creator == "${user.name}"`, `row_status == "NORMAL"`, `order_by_pinned == true`];
     const contentSearch: string[] = [];
     if (tagQuery) {
-      contentSearch.push(JSON.stringify(`#${tagQuery}`));
+      filters.push(`tag in [${JSON.stringify(tagQuery)}]`);
     }
     if (textQuery) {
       contentSearch.push(JSON.stringify(textQuery));
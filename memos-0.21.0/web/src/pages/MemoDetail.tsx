@@ -1,7 +1,7 @@
-import { ClientError } from "nice-grpc-web";
+import { ClientError, Status } from "nice-grpc-web";
 import { useEffect, useState } from "react";
 import { toast } from "react-hot-toast";
-import { Link, useParams } from "react-router-dom";
+import { Link, useParams, useSearchParams } from "react-router-dom";
 import Icon from "@/components/Icon";
 import MemoEditor from "@/components/MemoEditor";
 import MemoView from "@/components/MemoView";
@@ -12,14 +12,17 @@ import { MemoNamePrefix, useMemoStore } from "@/store/v1";
 import { MemoRelation_Type } from "@/types/proto/api/v2/memo_relation_service";
 import { Memo } from "@/types/proto/api/v2/memo_service";
 import { useTranslate } from "@/utils/i18n";
+import { setResourceShareLink } from "@/utils/resource";
 
 const MemoDetail = () => {
   const t = useTranslate();
   const params = useParams();
+  const [searchParams] = useSearchParams();
   const navigateTo = useNavigateTo();
   const currentUser = useCurrentUser();
   const memoStore = useMemoStore();
   const uid = params.uid;
+  const shareToken = searchParams.get("share") || "";
   const memo = memoStore.getMemoByUid(uid || "");
   const [parentMemo, setParentMemo] = useState<Memo | undefined>(undefined);
   const commentRelations =
@@ -28,7 +31,9 @@ const MemoDetail = () => {
 
   // Prepare memo.
   useEffect(() => {
-    if (uid) {
+    if (uid && shareToken) {
+      fetchSharedMemo();
+    } else if (uid) {
       memoStore.searchMemos(`uid == "${uid}"`).catch((error: ClientError) => {
         toast.error(error.details);
         navigateTo("/403");
@@ -36,7 +41,24 @@ const MemoDetail = () => {
     } else {
       navigateTo("/404");
     }
-  }, [uid]);
+    return () => setResourceShareLink("", "");
+  }, [uid, shareToken]);
+
+  const fetchSharedMemo = async (password?: string) => {
+    try {
+      await memoStore.fetchSharedMemo(shareToken, password);
+    } catch (error: any) {
+      if (error instanceof ClientError && (error.code === Status.UNAUTHENTICATED || error.code === Status.PERMISSION_DENIED)) {
+        const input = window.prompt(t("memo.share-link.enter-password"));
+        if (input !== null) {
+          await fetchSharedMemo(input);
+          return;
+        }
+      }
+      toast.error(error.details);
+      navigateTo("/404");
+    }
+  };
 
   // Prepare memo comments.
   useEffect(() => {
@@ -45,6 +67,10 @@ const MemoDetail = () => {
     }
 
     (async () => {
+      // Comments and parents of a shared memo are not part of the share.
+      if (shareToken) {
+        return;
+      }
       if (memo.parentId) {
         memoStore.getOrFetchMemoByName(`${MemoNamePrefix}${memo.parentId}`).then((memo: Memo) => {
           setParentMemo(memo);
@@ -12,7 +12,6 @@ import StorageSection from "@/components/Settings/StorageSection";
 import SystemSection from "@/components/Settings/SystemSection";
 import useCurrentUser from "@/hooks/useCurrentUser";
 import { useGlobalStore } from "@/store/module";
-import { User_Role } from "@/types/proto/api/v2/user_service";
 import { useTranslate } from "@/utils/i18n";
 
 type SettingSection = "my-account" | "preference" | "member" | "system" | "storage" | "sso";
@@ -24,6 +23,14 @@ interface State {
 const BASIC_SECTIONS: SettingSection[] = ["my-account", "preference"];
 const ADMIN_SECTIONS: SettingSection[] = ["member", "system", "storage", "sso"];
 
+// The permission required to see each admin section.
+const SECTION_PERMISSION_MAP: Partial<Record<SettingSection, string>> = {
+  member: "users.manage",
+  system: "workspace.manage",
+  storage: "storage.manage",
+  sso: "idps.manage",
+};
+
 const SECTION_ICON_MAP: Record<SettingSection, LucideIcon> = {
   "my-account": Icon.User,
   preference: Icon.Cog,
@@ -41,15 +48,14 @@ const Setting = () => {
     selectedSection: "my-account",
   });
 
-  const isHost = user.role === User_Role.HOST;
+  const adminSections = useMemo(
+    () => ADMIN_SECTIONS.filter((section) => user.permissions.includes(SECTION_PERMISSION_MAP[section] || "")),
+    [user.permissions],
+  );
 
   const settingsSectionList = useMemo(() => {
-    let settingList = [...BASIC_SECTIONS];
-    if (isHost) {
-      settingList = settingList.concat(ADMIN_SECTIONS);
-    }
-    return settingList;
-  }, [isHost]);
+    return [...BASIC_SECTIONS, ...adminSections];
+  }, [adminSections]);
 
   const handleSectionSelectorItemClick = useCallback((settingSection: SettingSection) => {
     setState({
@@ -75,11 +81,11 @@ const Setting = () => {
                 />
               ))}
             </div>
-            {isHost ? (
+            {adminSections.length > 0 ? (
               <>
                 <span className="text-sm mt-4 pl-3 font-mono select-none text-gray-400 dark:text-gray-500">{t("common.admin")}</span>
                 <div className="w-full flex flex-col justify-start items-start mt-1">
-                  {ADMIN_SECTIONS.map((item) => (
+                  {adminSections.map((item) => (
                     <SectionMenuItem
                       key={item}
                       text={t(`setting.${item}`)}
@@ -69,7 +69,7 @@ const Timeline = () => {
       const filters = [`row_status == "NORMAL"`];
       const contentSearch: string[] = [];
       if (tagQuery) {
-        contentSearch.push(JSON.stringify(`#${tagQuery}`));
+        filters.push(`tag in [${JSON.stringify(tagQuery)}]`);
       }
       if (textQuery) {
         contentSearch.push(JSON.stringify(textQuery));
@@ -90,7 +90,7 @@ const Timeline = () => {
     const filters = [`creator == "${user.name}"`, `row_status == "NORMAL"`];
     const contentSearch: string[] = [];
     if (tagQuery) {
-      contentSearch.push(JSON.stringify(`#${tagQuery}`));
+      filters.push(`tag in [${JSON.stringify(tagQuery)}]`);
     }
     if (textQuery) {
       contentSearch.push(JSON.stringify(textQuery));
@@ -72,7 +72,7 @@ const UserProfile = () => {
     const filters = [`creator == "${user.name}"`, `row_status == "NORMAL"`, `order_by_pinned == true`];
     const contentSearch: string[] = [];
     if (tagQuery) {
-      contentSearch.push(JSON.stringify(`#${tagQuery}`));
+      filters.push(`tag in [${JSON.stringify(tagQuery)}]`);
     }
     if (textQuery) {
       contentSearch.push(JSON.stringify(textQuery));
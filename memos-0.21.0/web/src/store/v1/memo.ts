@@ -2,6 +2,7 @@ import { create } from "zustand";
 import { combine } from "zustand/middleware";
 import { memoServiceClient } from "@/grpcweb";
 import { CreateMemoRequest, ListMemosRequest, Memo } from "@/types/proto/api/v2/memo_service";
+import { setResourceShareLink } from "@/utils/resource";
 
 interface State {
   memoMapByName: Record<string, Memo>;
@@ -58,6 +59,22 @@ export const useMemoStore = create(
       set({ memoMapByName: memoMap });
       return memos;
     },
+    fetchSharedMemo: async (token: string, password?: string) => {
+      const { memo, shareKey } = await memoServiceClient.getSharedMemo({
+        token,
+        password: password || "",
+      });
+      if (!memo) {
+        throw new Error("Memo not found");
+      }
+
+      // Resources of the memo are only readable along with the share link.
+      setResourceShareLink(token, shareKey);
+      const memoMap = get().memoMapByName;
+      memoMap[memo.name] = memo;
+      set({ memoMapByName: memoMap });
+      return memo;
+    },
     getMemoByUid: (uid: string) => {
       const memoMap = get().memoMapByName;
       return Object.values(memoMap).find((memo) => memo.uid === uid);
@@ -1,11 +1,26 @@
 import { Resource } from "@/types/proto/api/v2/resource_service";
 
+// The share link query appended to resource urls when viewing a memo through a share link.
+let resourceShareQuery = "";
+
+export const setResourceShareLink = (token: string, shareKey: string) => {
+  const params = new URLSearchParams();
+  if (token) {
+    params.set("share", token);
+    if (shareKey) {
+      params.set("share_key", shareKey);
+    }
+  }
+  resourceShareQuery = params.toString();
+};
+
 export const getResourceUrl = (resource: Resource) => {
   if (resource.externalLink) {
     return resource.externalLink;
   }
 
-  return `${import.meta.env.VITE_API_BASE_URL || window.location.origin}/o/r/${resource.uid}`;
+  const url = `${import.meta.env.VITE_API_BASE_URL || window.location.origin}/o/r/${resource.uid}`;
+  return resourceShareQuery ? `${url}?${resourceShareQuery}` : url;
 };
 
 export const getResourceType = (resource: Resource) => {